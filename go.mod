@@ -20,6 +20,7 @@ require (
 	github.com/google/uuid v1.1.1
 	github.com/gorilla/mux v1.7.3
 	github.com/gorilla/sessions v1.2.0
+	github.com/jackc/pgconn v1.3.0
 	github.com/jackc/pgx/v4 v4.3.0
 	github.com/lib/pq v1.2.0
 	github.com/oklog/oklog v0.3.2
@@ -34,5 +35,6 @@ require (
 	gocloud.dev v0.18.0
 	golang.org/x/net v0.0.0-20190813141303-74dc4d7220e7
 	golang.org/x/sys v0.0.0-20191020212454-3e7259c5e7c2 // indirect
+	golang.org/x/text v0.3.2
 	google.golang.org/grpc v1.24.0
 )
@@ -39,11 +39,18 @@ func (c DataConnector) HeatmapPosts(city string, topLeft, botRight data.Point, h
 }
 
 func (c DataConnector) Timeline(city string, start, finish int64) (Timeline, error) {
-	tl, err := c.dsClient.PullTimeline(context.Background(), city, start, finish)
+	// the hour which starts at finish is included
+	tl, err := c.dsClient.PullTimeline(context.Background(), city, start, finish+3600)
 	if err != nil {
 		unilog.Logger().Error("unable to get timeline", zap.Error(err))
 		return nil, err
 	}
+	alerts, err := c.dsClient.PullAlerts(context.Background(), city, start, finish+3600)
+	if err != nil {
+		unilog.Logger().Error("unable to get alerts", zap.Error(err))
+		return nil, err
+	}
+	Timeline(tl).annotate(alerts)
 	return tl, nil
 }
 
@@ -34,6 +34,7 @@ func (c MockConnector) Timeline(city string, start, finish int64) (Timeline, err
 			Time:         t,
 			PostsNumber:  int64(rand.Float64() * 1000),
 			EventsNumber: int64(rand.Float64() * 50),
+			Collected:    true,
 		}
 		res = append(res, ts)
 	}
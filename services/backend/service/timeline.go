@@ -1,5 +1,27 @@
 package service
 
-import data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+import (
+	"sort"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
 
 type Timeline []data.Timestamp
+
+// annotate sets the kind and score of the city-level alert covering each hour of the timeline.
+func (tl Timeline) annotate(alerts []data.Alert) {
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].Start < alerts[j].Start
+	})
+	for i := range tl {
+		t := tl[i].Time
+		k := sort.Search(len(alerts), func(j int) bool { return alerts[j].Start > t })
+		for j := k - 1; j >= 0; j-- {
+			if alerts[j].Finish > t {
+				tl[i].Alert = alerts[j].Kind
+				tl[i].Score = alerts[j].Score
+				break
+			}
+		}
+	}
+}
@@ -293,12 +293,29 @@ func (s *Session) historicStatus() (bool, error) {
 		unilog.Logger().Error("server error", zap.Error(err))
 		return false, err
 	}
+	// grids are built by all event detection nodes, so the progress is taken from the task queue
+	tasks, err := Storage.PullGridTasksStatus(context.Background(), st.SessionID)
+	if err != nil {
+		unilog.Logger().Error("error during grid tasks status checking", zap.Error(err))
+		return false, err
+	}
 	s.Status = status.HistoricBuilding{
 		SessionID: st.SessionID,
 		Status:    resp.Status,
+		Total:     tasks.Pending + tasks.Leased + tasks.Done + tasks.Failed,
+		Pending:   tasks.Pending,
+		Leased:    tasks.Leased,
+		Done:      tasks.Done,
+		Failed:    tasks.Failed,
+		Posts:     tasks.Posts,
 	}
 	unilog.Logger().Info("grids building", zap.String("session", s.ID),
-		zap.String("grid session", st.SessionID), zap.String("status", resp.Status))
+		zap.String("grid session", st.SessionID), zap.String("status", resp.Status),
+		zap.Int64("done", tasks.Done), zap.Int64("failed", tasks.Failed),
+		zap.Int64("total", tasks.Pending+tasks.Leased+tasks.Done+tasks.Failed))
+	if resp.Status == service.FailedStatus.String() || resp.Status == service.CanceledStatus.String() {
+		return false, fmt.Errorf("historic building is %v", resp.Status)
+	}
 	return resp.Finished, nil
 }
 
@@ -1,8 +1,16 @@
 package status
 
+// HistoricBuilding is the status of building historic grids. The numbers of grid keys in every
+// state are taken from the task queue which is shared by all event detection nodes.
 type HistoricBuilding struct {
 	SessionID string
 	Status    string
+	Total     int64
+	Pending   int64
+	Leased    int64
+	Done      int64
+	Failed    int64
+	Posts     int64
 }
 
 func (s HistoricBuilding) Get() Status {
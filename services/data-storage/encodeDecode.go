@@ -286,6 +286,266 @@ func decodeGRPCPullLocationsResponse(_ context.Context, grpcReply interface{}) (
 	return *reply, nil
 }
 
+func encodeGRPCPushBotsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PushBotsRequest)
+	return &req, nil
+}
+
+func decodeGRPCPushBotsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PushBotsRequest)
+	return *req, nil
+}
+
+func encodeGRPCPushBotsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PushBotsReply)
+	return &resp, nil
+}
+
+func decodeGRPCPushBotsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PushBotsReply)
+	return *reply, nil
+}
+
+func encodeGRPCPullBotsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PullBotsRequest)
+	return &req, nil
+}
+
+func decodeGRPCPullBotsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PullBotsRequest)
+	return *req, nil
+}
+
+func encodeGRPCPullBotsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PullBotsReply)
+	return &resp, nil
+}
+
+func decodeGRPCPullBotsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PullBotsReply)
+	return *reply, nil
+}
+
+func encodeGRPCPushAlertsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PushAlertsRequest)
+	return &req, nil
+}
+
+func decodeGRPCPushAlertsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PushAlertsRequest)
+	return *req, nil
+}
+
+func encodeGRPCPushAlertsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PushAlertsReply)
+	return &resp, nil
+}
+
+func decodeGRPCPushAlertsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PushAlertsReply)
+	return *reply, nil
+}
+
+func encodeGRPCPushWatermarkRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PushWatermarkRequest)
+	return &req, nil
+}
+
+func decodeGRPCPushWatermarkRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PushWatermarkRequest)
+	return *req, nil
+}
+
+func encodeGRPCPushWatermarkResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PushWatermarkReply)
+	return &resp, nil
+}
+
+func decodeGRPCPushWatermarkResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PushWatermarkReply)
+	return *reply, nil
+}
+
+func encodeGRPCPullAlertsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PullAlertsRequest)
+	return &req, nil
+}
+
+func decodeGRPCPullAlertsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PullAlertsRequest)
+	return *req, nil
+}
+
+func encodeGRPCPullAlertsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PullAlertsReply)
+	return &resp, nil
+}
+
+func decodeGRPCPullAlertsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PullAlertsReply)
+	return *reply, nil
+}
+
+func encodeGRPCPushGridTasksRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PushGridTasksRequest)
+	return &req, nil
+}
+
+func decodeGRPCPushGridTasksRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PushGridTasksRequest)
+	return *req, nil
+}
+
+func encodeGRPCPushGridTasksResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PushGridTasksReply)
+	return &resp, nil
+}
+
+func decodeGRPCPushGridTasksResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PushGridTasksReply)
+	return *reply, nil
+}
+
+func encodeGRPCLeaseGridTaskRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.LeaseGridTaskRequest)
+	return &req, nil
+}
+
+func decodeGRPCLeaseGridTaskRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.LeaseGridTaskRequest)
+	return *req, nil
+}
+
+func encodeGRPCLeaseGridTaskResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.LeaseGridTaskReply)
+	return &resp, nil
+}
+
+func decodeGRPCLeaseGridTaskResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.LeaseGridTaskReply)
+	return *reply, nil
+}
+
+func encodeGRPCCompleteGridTaskRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.CompleteGridTaskRequest)
+	return &req, nil
+}
+
+func decodeGRPCCompleteGridTaskRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.CompleteGridTaskRequest)
+	return *req, nil
+}
+
+func encodeGRPCCompleteGridTaskResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.CompleteGridTaskReply)
+	return &resp, nil
+}
+
+func decodeGRPCCompleteGridTaskResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.CompleteGridTaskReply)
+	return *reply, nil
+}
+
+func encodeGRPCPullGridTasksStatusRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PullGridTasksStatusRequest)
+	return &req, nil
+}
+
+func decodeGRPCPullGridTasksStatusRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PullGridTasksStatusRequest)
+	return *req, nil
+}
+
+func encodeGRPCPullGridTasksStatusResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PullGridTasksStatusReply)
+	return &resp, nil
+}
+
+func decodeGRPCPullGridTasksStatusResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PullGridTasksStatusReply)
+	return *reply, nil
+}
+
+func encodeGRPCCancelGridTasksRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.CancelGridTasksRequest)
+	return &req, nil
+}
+
+func decodeGRPCCancelGridTasksRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.CancelGridTasksRequest)
+	return *req, nil
+}
+
+func encodeGRPCCancelGridTasksResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.CancelGridTasksReply)
+	return &resp, nil
+}
+
+func decodeGRPCCancelGridTasksResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.CancelGridTasksReply)
+	return *reply, nil
+}
+
+func encodeGRPCRenewGridTaskRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.RenewGridTaskRequest)
+	return &req, nil
+}
+
+func decodeGRPCRenewGridTaskRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.RenewGridTaskRequest)
+	return *req, nil
+}
+
+func encodeGRPCRenewGridTaskResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.RenewGridTaskReply)
+	return &resp, nil
+}
+
+func decodeGRPCRenewGridTaskResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.RenewGridTaskReply)
+	return *reply, nil
+}
+
+func encodeGRPCPublishGridSetRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.PublishGridSetRequest)
+	return &req, nil
+}
+
+func decodeGRPCPublishGridSetRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PublishGridSetRequest)
+	return *req, nil
+}
+
+func encodeGRPCPublishGridSetResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.PublishGridSetReply)
+	return &resp, nil
+}
+
+func decodeGRPCPublishGridSetResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.PublishGridSetReply)
+	return *reply, nil
+}
+
+func encodeGRPCDeleteGridSetRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.DeleteGridSetRequest)
+	return &req, nil
+}
+
+func decodeGRPCDeleteGridSetRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.DeleteGridSetRequest)
+	return *req, nil
+}
+
+func encodeGRPCDeleteGridSetResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(proto.DeleteGridSetReply)
+	return &resp, nil
+}
+
+func decodeGRPCDeleteGridSetResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.DeleteGridSetReply)
+	return *reply, nil
+}
+
 func encodeGRPCPullShortPostInIntervalRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(proto.PullShortPostInIntervalRequest)
 	return &req, nil
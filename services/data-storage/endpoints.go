@@ -92,7 +92,7 @@ func makePullTimelineEndpoint(s Service) endpoint.Endpoint {
 func makePushGridEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(proto.PushGridRequest)
-		err = s.PushGrid(ctx, req.CityId, req.Grids)
+		err = s.PushGrid(ctx, req.CityId, req.Set, req.Grids, req.Versions)
 		var msg string
 		if err != nil {
 			msg = err.Error()
@@ -104,12 +104,12 @@ func makePushGridEndpoint(s Service) endpoint.Endpoint {
 func makePullGridEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(proto.PullGridRequest)
-		grids, err := s.PullGrid(ctx, req.CityId, req.Ids)
+		grids, versions, err := s.PullGrid(ctx, req.CityId, req.Set, req.Ids)
 		var msg string
 		if err != nil {
 			msg = err.Error()
 		}
-		return proto.PullGridReply{Grids: grids, Err: msg}, nil
+		return proto.PullGridReply{Grids: grids, Versions: versions, Err: msg}, nil
 	}
 }
 
@@ -161,6 +161,162 @@ func makePushLocationsEndpoint(s Service) endpoint.Endpoint {
 	}
 }
 
+func makePushBotsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PushBotsRequest)
+		err = s.PushBots(ctx, req.CityId, req.Bots)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PushBotsReply{Err: msg}, nil
+	}
+}
+
+func makePullBotsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PullBotsRequest)
+		bots, err := s.PullBots(ctx, req.CityId)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PullBotsReply{Bots: bots, Err: msg}, nil
+	}
+}
+
+func makePushAlertsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PushAlertsRequest)
+		err = s.PushAlerts(ctx, req.CityId, req.Alerts)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PushAlertsReply{Err: msg}, nil
+	}
+}
+
+func makePushWatermarkEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PushWatermarkRequest)
+		err = s.PushWatermark(ctx, req.CityId, req.Time)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PushWatermarkReply{Err: msg}, nil
+	}
+}
+
+func makePullAlertsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PullAlertsRequest)
+		alerts, err := s.PullAlerts(ctx, req.CityId, req.Start, req.Finish)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PullAlertsReply{Alerts: alerts, Err: msg}, nil
+	}
+}
+
+func makePushGridTasksEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PushGridTasksRequest)
+		err = s.PushGridTasks(ctx, req.Tasks)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PushGridTasksReply{Err: msg}, nil
+	}
+}
+
+func makeLeaseGridTaskEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.LeaseGridTaskRequest)
+		task, err := s.LeaseGridTask(ctx, req.Owner, req.Lease)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.LeaseGridTaskReply{Task: task, Err: msg}, nil
+	}
+}
+
+func makeCompleteGridTaskEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.CompleteGridTaskRequest)
+		err = s.CompleteGridTask(ctx, req.CityId, req.Session, req.Key, req.Owner, req.Status, req.Posts, req.Error, req.Grids)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.CompleteGridTaskReply{Err: msg}, nil
+	}
+}
+
+func makePullGridTasksStatusEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PullGridTasksStatusRequest)
+		status, err := s.PullGridTasksStatus(ctx, req.Session)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PullGridTasksStatusReply{Status: status, Err: msg}, nil
+	}
+}
+
+func makeCancelGridTasksEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.CancelGridTasksRequest)
+		err = s.CancelGridTasks(ctx, req.Session)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.CancelGridTasksReply{Err: msg}, nil
+	}
+}
+
+func makeRenewGridTaskEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.RenewGridTaskRequest)
+		err = s.RenewGridTask(ctx, req.Session, req.Key, req.Owner, req.Lease)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.RenewGridTaskReply{Err: msg}, nil
+	}
+}
+
+func makePublishGridSetEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.PublishGridSetRequest)
+		err = s.PublishGridSet(ctx, req.CityId, req.Set, req.Ids, req.Grids)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.PublishGridSetReply{Err: msg}, nil
+	}
+}
+
+func makeDeleteGridSetEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.DeleteGridSetRequest)
+		err = s.DeleteGridSet(ctx, req.CityId, req.Set)
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.DeleteGridSetReply{Err: msg}, nil
+	}
+}
+
 func makePullLocationsEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(proto.PullLocationsRequest)
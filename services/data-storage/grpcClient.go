@@ -28,6 +28,19 @@ type GrpcService struct {
 	pullEventsTags          endpoint.Endpoint
 	pushLocations           endpoint.Endpoint
 	pullLocations           endpoint.Endpoint
+	pushBots                endpoint.Endpoint
+	pullBots                endpoint.Endpoint
+	pushAlerts              endpoint.Endpoint
+	pullAlerts              endpoint.Endpoint
+	pushWatermark           endpoint.Endpoint
+	pushGridTasks           endpoint.Endpoint
+	leaseGridTask           endpoint.Endpoint
+	completeGridTask        endpoint.Endpoint
+	pullGridTasksStatus     endpoint.Endpoint
+	cancelGridTasks         endpoint.Endpoint
+	renewGridTask           endpoint.Endpoint
+	publishGridSet          endpoint.Endpoint
+	deleteGridSet           endpoint.Endpoint
 	pullShortPostInInterval endpoint.Endpoint
 	pullSingleShortPost     endpoint.Endpoint
 }
@@ -116,8 +129,8 @@ func (svc GrpcService) PullTimeline(ctx context.Context, cityId string, start, f
 	return response.Timeline, nil
 }
 
-func (svc GrpcService) PushGrid(ctx context.Context, cityId string, grids map[int64][]byte) error {
-	resp, err := svc.pushGrid(ctx, proto.PushGridRequest{CityId: cityId, Grids: grids})
+func (svc GrpcService) PushGrid(ctx context.Context, cityId, set string, grids map[int64][]byte, versions map[int64]int64) error {
+	resp, err := svc.pushGrid(ctx, proto.PushGridRequest{CityId: cityId, Set: set, Grids: grids, Versions: versions})
 	if err != nil {
 		return err
 	}
@@ -128,16 +141,16 @@ func (svc GrpcService) PushGrid(ctx context.Context, cityId string, grids map[in
 	return nil
 }
 
-func (svc GrpcService) PullGrid(ctx context.Context, cityId string, ids []int64) (map[int64][]byte, error) {
-	resp, err := svc.pullGrid(ctx, proto.PullGridRequest{CityId: cityId, Ids: ids})
+func (svc GrpcService) PullGrid(ctx context.Context, cityId, set string, ids []int64) (map[int64][]byte, map[int64]int64, error) {
+	resp, err := svc.pullGrid(ctx, proto.PullGridRequest{CityId: cityId, Set: set, Ids: ids})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	response := resp.(proto.PullGridReply)
 	if response.Err != "" {
-		return nil, errors.New(response.Err)
+		return nil, nil, errors.New(response.Err)
 	}
-	return response.Grids, nil
+	return response.Grids, response.Versions, nil
 }
 
 func (svc GrpcService) PushEvents(ctx context.Context, cityId string, events []data.Event) error {
@@ -200,6 +213,164 @@ func (svc GrpcService) PullLocations(ctx context.Context, cityId string) ([]data
 	return response.Locations, nil
 }
 
+func (svc GrpcService) PushBots(ctx context.Context, cityId string, bots []data.Bot) error {
+	resp, err := svc.pushBots(ctx, proto.PushBotsRequest{CityId: cityId, Bots: bots})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.PushBotsReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) PullBots(ctx context.Context, cityId string) ([]data.Bot, error) {
+	resp, err := svc.pullBots(ctx, proto.PullBotsRequest{CityId: cityId})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(proto.PullBotsReply)
+	if response.Err != "" {
+		return nil, errors.New(response.Err)
+	}
+	return response.Bots, nil
+}
+
+func (svc GrpcService) PushAlerts(ctx context.Context, cityId string, alerts []data.Alert) error {
+	resp, err := svc.pushAlerts(ctx, proto.PushAlertsRequest{CityId: cityId, Alerts: alerts})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.PushAlertsReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) PushWatermark(ctx context.Context, cityId string, time int64) error {
+	resp, err := svc.pushWatermark(ctx, proto.PushWatermarkRequest{CityId: cityId, Time: time})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.PushWatermarkReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) PullAlerts(ctx context.Context, cityId string, start, finish int64) ([]data.Alert, error) {
+	resp, err := svc.pullAlerts(ctx, proto.PullAlertsRequest{CityId: cityId, Start: start, Finish: finish})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(proto.PullAlertsReply)
+	if response.Err != "" {
+		return nil, errors.New(response.Err)
+	}
+	return response.Alerts, nil
+}
+
+func (svc GrpcService) PushGridTasks(ctx context.Context, tasks []data.GridTask) error {
+	resp, err := svc.pushGridTasks(ctx, proto.PushGridTasksRequest{Tasks: tasks})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.PushGridTasksReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) LeaseGridTask(ctx context.Context, owner string, lease int64) (*data.GridTask, error) {
+	resp, err := svc.leaseGridTask(ctx, proto.LeaseGridTaskRequest{Owner: owner, Lease: lease})
+	if err != nil {
+		return nil, err
+	}
+	response := resp.(proto.LeaseGridTaskReply)
+	if response.Err != "" {
+		return nil, errors.New(response.Err)
+	}
+	return response.Task, nil
+}
+
+func (svc GrpcService) CompleteGridTask(ctx context.Context, cityId, session string, key int64, owner, status string,
+	posts int64, taskErr string, grids map[int64][]byte) error {
+	resp, err := svc.completeGridTask(ctx, proto.CompleteGridTaskRequest{CityId: cityId, Session: session, Key: key,
+		Owner: owner, Status: status, Posts: posts, Error: taskErr, Grids: grids})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.CompleteGridTaskReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) PullGridTasksStatus(ctx context.Context, session string) (data.GridTasksStatus, error) {
+	resp, err := svc.pullGridTasksStatus(ctx, proto.PullGridTasksStatusRequest{Session: session})
+	if err != nil {
+		return data.GridTasksStatus{}, err
+	}
+	response := resp.(proto.PullGridTasksStatusReply)
+	if response.Err != "" {
+		return data.GridTasksStatus{}, errors.New(response.Err)
+	}
+	return response.Status, nil
+}
+
+func (svc GrpcService) CancelGridTasks(ctx context.Context, session string) error {
+	resp, err := svc.cancelGridTasks(ctx, proto.CancelGridTasksRequest{Session: session})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.CancelGridTasksReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) RenewGridTask(ctx context.Context, session string, key int64, owner string, lease int64) error {
+	resp, err := svc.renewGridTask(ctx, proto.RenewGridTaskRequest{Session: session, Key: key, Owner: owner, Lease: lease})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.RenewGridTaskReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) PublishGridSet(ctx context.Context, cityId, set string, ids []int64, grids map[int64][]byte) error {
+	resp, err := svc.publishGridSet(ctx, proto.PublishGridSetRequest{CityId: cityId, Set: set, Ids: ids, Grids: grids})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.PublishGridSetReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
+func (svc GrpcService) DeleteGridSet(ctx context.Context, cityId, set string) error {
+	resp, err := svc.deleteGridSet(ctx, proto.DeleteGridSetRequest{CityId: cityId, Set: set})
+	if err != nil {
+		return err
+	}
+	response := resp.(proto.DeleteGridSetReply)
+	if response.Err != "" {
+		return errors.New(response.Err)
+	}
+	return nil
+}
+
 func (svc GrpcService) PullShortPostInInterval(ctx context.Context, cityId string, shortCodes []string,
 	startTimestamp int64, endTimestamp int64) ([]data.ShortPost, error) {
 	resp, err := svc.pullShortPostInInterval(ctx, proto.PullShortPostInIntervalRequest{CityId: cityId,
@@ -383,6 +554,149 @@ func NewGRPCClient(conn *grpc.ClientConn) GrpcService {
 		Timeout: TimeWaitingClient,
 	}))(pullLocationsEndpoint)
 
+	pushBotsEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PushBots",
+		encodeGRPCPushBotsRequest,
+		decodeGRPCPushBotsResponse,
+		proto.PushBotsReply{},
+	).Endpoint()
+	svc.pushBots = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PushBots",
+		Timeout: TimeWaitingClient,
+	}))(pushBotsEndpoint)
+
+	pullBotsEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PullBots",
+		encodeGRPCPullBotsRequest,
+		decodeGRPCPullBotsResponse,
+		proto.PullBotsReply{},
+	).Endpoint()
+	svc.pullBots = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PullBots",
+		Timeout: TimeWaitingClient,
+	}))(pullBotsEndpoint)
+
+	pushAlertsEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PushAlerts",
+		encodeGRPCPushAlertsRequest,
+		decodeGRPCPushAlertsResponse,
+		proto.PushAlertsReply{},
+	).Endpoint()
+	svc.pushAlerts = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PushAlerts",
+		Timeout: TimeWaitingClient,
+	}))(pushAlertsEndpoint)
+
+	pullAlertsEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PullAlerts",
+		encodeGRPCPullAlertsRequest,
+		decodeGRPCPullAlertsResponse,
+		proto.PullAlertsReply{},
+	).Endpoint()
+	svc.pullAlerts = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PullAlerts",
+		Timeout: TimeWaitingClient,
+	}))(pullAlertsEndpoint)
+
+	pushWatermarkEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PushWatermark",
+		encodeGRPCPushWatermarkRequest,
+		decodeGRPCPushWatermarkResponse,
+		proto.PushWatermarkReply{},
+	).Endpoint()
+	svc.pushWatermark = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PushWatermark",
+		Timeout: TimeWaitingClient,
+	}))(pushWatermarkEndpoint)
+
+	pushGridTasksEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PushGridTasks",
+		encodeGRPCPushGridTasksRequest,
+		decodeGRPCPushGridTasksResponse,
+		proto.PushGridTasksReply{},
+	).Endpoint()
+	svc.pushGridTasks = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PushGridTasks",
+		Timeout: TimeWaitingClient,
+	}))(pushGridTasksEndpoint)
+
+	leaseGridTaskEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "LeaseGridTask",
+		encodeGRPCLeaseGridTaskRequest,
+		decodeGRPCLeaseGridTaskResponse,
+		proto.LeaseGridTaskReply{},
+	).Endpoint()
+	svc.leaseGridTask = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "LeaseGridTask",
+		Timeout: TimeWaitingClient,
+	}))(leaseGridTaskEndpoint)
+
+	completeGridTaskEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "CompleteGridTask",
+		encodeGRPCCompleteGridTaskRequest,
+		decodeGRPCCompleteGridTaskResponse,
+		proto.CompleteGridTaskReply{},
+	).Endpoint()
+	svc.completeGridTask = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "CompleteGridTask",
+		Timeout: TimeWaitingClient,
+	}))(completeGridTaskEndpoint)
+
+	pullGridTasksStatusEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PullGridTasksStatus",
+		encodeGRPCPullGridTasksStatusRequest,
+		decodeGRPCPullGridTasksStatusResponse,
+		proto.PullGridTasksStatusReply{},
+	).Endpoint()
+	svc.pullGridTasksStatus = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PullGridTasksStatus",
+		Timeout: TimeWaitingClient,
+	}))(pullGridTasksStatusEndpoint)
+
+	cancelGridTasksEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "CancelGridTasks",
+		encodeGRPCCancelGridTasksRequest,
+		decodeGRPCCancelGridTasksResponse,
+		proto.CancelGridTasksReply{},
+	).Endpoint()
+	svc.cancelGridTasks = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "CancelGridTasks",
+		Timeout: TimeWaitingClient,
+	}))(cancelGridTasksEndpoint)
+
+	renewGridTaskEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "RenewGridTask",
+		encodeGRPCRenewGridTaskRequest,
+		decodeGRPCRenewGridTaskResponse,
+		proto.RenewGridTaskReply{},
+	).Endpoint()
+	svc.renewGridTask = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "RenewGridTask",
+		Timeout: TimeWaitingClient,
+	}))(renewGridTaskEndpoint)
+
+	publishGridSetEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "PublishGridSet",
+		encodeGRPCPublishGridSetRequest,
+		decodeGRPCPublishGridSetResponse,
+		proto.PublishGridSetReply{},
+	).Endpoint()
+	svc.publishGridSet = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "PublishGridSet",
+		Timeout: TimeWaitingClient,
+	}))(publishGridSetEndpoint)
+
+	deleteGridSetEndpoint := grpctransport.NewClient(
+		conn, "proto.DataStorage", "DeleteGridSet",
+		encodeGRPCDeleteGridSetRequest,
+		decodeGRPCDeleteGridSetResponse,
+		proto.DeleteGridSetReply{},
+	).Endpoint()
+	svc.deleteGridSet = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "DeleteGridSet",
+		Timeout: TimeWaitingClient,
+	}))(deleteGridSetEndpoint)
+
 	pullShortPostInInterval := grpctransport.NewClient(
 		conn, "proto.DataStorage", "PullShortPostInInterval",
 		encodeGRPCPullShortPostInIntervalRequest,
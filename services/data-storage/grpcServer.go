@@ -21,6 +21,19 @@ type grpcServer struct {
 	pullEventsTags          grpctransport.Handler
 	pushLocations           grpctransport.Handler
 	pullLocations           grpctransport.Handler
+	pushBots                grpctransport.Handler
+	pullBots                grpctransport.Handler
+	pushAlerts              grpctransport.Handler
+	pullAlerts              grpctransport.Handler
+	pushWatermark           grpctransport.Handler
+	pushGridTasks           grpctransport.Handler
+	leaseGridTask           grpctransport.Handler
+	completeGridTask        grpctransport.Handler
+	pullGridTasksStatus     grpctransport.Handler
+	cancelGridTasks         grpctransport.Handler
+	renewGridTask           grpctransport.Handler
+	publishGridSet          grpctransport.Handler
+	deleteGridSet           grpctransport.Handler
 	pullShortPostInInterval grpctransport.Handler
 	pullSingleShortPost     grpctransport.Handler
 }
@@ -97,6 +110,71 @@ func NewGRPCServer(svc Service) proto.DataStorageServer {
 			decodeGRPCPullLocationsRequest,
 			encodeGRPCPullLocationsResponse,
 		),
+		pushBots: grpctransport.NewServer(
+			makePushBotsEndpoint(svc),
+			decodeGRPCPushBotsRequest,
+			encodeGRPCPushBotsResponse,
+		),
+		pullBots: grpctransport.NewServer(
+			makePullBotsEndpoint(svc),
+			decodeGRPCPullBotsRequest,
+			encodeGRPCPullBotsResponse,
+		),
+		pushAlerts: grpctransport.NewServer(
+			makePushAlertsEndpoint(svc),
+			decodeGRPCPushAlertsRequest,
+			encodeGRPCPushAlertsResponse,
+		),
+		pullAlerts: grpctransport.NewServer(
+			makePullAlertsEndpoint(svc),
+			decodeGRPCPullAlertsRequest,
+			encodeGRPCPullAlertsResponse,
+		),
+		pushWatermark: grpctransport.NewServer(
+			makePushWatermarkEndpoint(svc),
+			decodeGRPCPushWatermarkRequest,
+			encodeGRPCPushWatermarkResponse,
+		),
+		pushGridTasks: grpctransport.NewServer(
+			makePushGridTasksEndpoint(svc),
+			decodeGRPCPushGridTasksRequest,
+			encodeGRPCPushGridTasksResponse,
+		),
+		leaseGridTask: grpctransport.NewServer(
+			makeLeaseGridTaskEndpoint(svc),
+			decodeGRPCLeaseGridTaskRequest,
+			encodeGRPCLeaseGridTaskResponse,
+		),
+		completeGridTask: grpctransport.NewServer(
+			makeCompleteGridTaskEndpoint(svc),
+			decodeGRPCCompleteGridTaskRequest,
+			encodeGRPCCompleteGridTaskResponse,
+		),
+		pullGridTasksStatus: grpctransport.NewServer(
+			makePullGridTasksStatusEndpoint(svc),
+			decodeGRPCPullGridTasksStatusRequest,
+			encodeGRPCPullGridTasksStatusResponse,
+		),
+		cancelGridTasks: grpctransport.NewServer(
+			makeCancelGridTasksEndpoint(svc),
+			decodeGRPCCancelGridTasksRequest,
+			encodeGRPCCancelGridTasksResponse,
+		),
+		renewGridTask: grpctransport.NewServer(
+			makeRenewGridTaskEndpoint(svc),
+			decodeGRPCRenewGridTaskRequest,
+			encodeGRPCRenewGridTaskResponse,
+		),
+		publishGridSet: grpctransport.NewServer(
+			makePublishGridSetEndpoint(svc),
+			decodeGRPCPublishGridSetRequest,
+			encodeGRPCPublishGridSetResponse,
+		),
+		deleteGridSet: grpctransport.NewServer(
+			makeDeleteGridSetEndpoint(svc),
+			decodeGRPCDeleteGridSetRequest,
+			encodeGRPCDeleteGridSetResponse,
+		),
 		pullShortPostInInterval: grpctransport.NewServer(
 			makePullShortPostInIntervalEndpoint(svc),
 			decodeGRPCPullShortPostInIntervalRequest,
@@ -227,6 +305,110 @@ func (s *grpcServer) PullLocations(ctx context.Context, req *proto.PullLocations
 	return rep.(*proto.PullLocationsReply), nil
 }
 
+func (s *grpcServer) PushBots(ctx context.Context, req *proto.PushBotsRequest) (*proto.PushBotsReply, error) {
+	_, rep, err := s.pushBots.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PushBotsReply), nil
+}
+
+func (s *grpcServer) PullBots(ctx context.Context, req *proto.PullBotsRequest) (*proto.PullBotsReply, error) {
+	_, rep, err := s.pullBots.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PullBotsReply), nil
+}
+
+func (s *grpcServer) PushAlerts(ctx context.Context, req *proto.PushAlertsRequest) (*proto.PushAlertsReply, error) {
+	_, rep, err := s.pushAlerts.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PushAlertsReply), nil
+}
+
+func (s *grpcServer) PullAlerts(ctx context.Context, req *proto.PullAlertsRequest) (*proto.PullAlertsReply, error) {
+	_, rep, err := s.pullAlerts.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PullAlertsReply), nil
+}
+
+func (s *grpcServer) PushWatermark(ctx context.Context, req *proto.PushWatermarkRequest) (*proto.PushWatermarkReply, error) {
+	_, rep, err := s.pushWatermark.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PushWatermarkReply), nil
+}
+
+func (s *grpcServer) PushGridTasks(ctx context.Context, req *proto.PushGridTasksRequest) (*proto.PushGridTasksReply, error) {
+	_, rep, err := s.pushGridTasks.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PushGridTasksReply), nil
+}
+
+func (s *grpcServer) LeaseGridTask(ctx context.Context, req *proto.LeaseGridTaskRequest) (*proto.LeaseGridTaskReply, error) {
+	_, rep, err := s.leaseGridTask.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.LeaseGridTaskReply), nil
+}
+
+func (s *grpcServer) CompleteGridTask(ctx context.Context, req *proto.CompleteGridTaskRequest) (*proto.CompleteGridTaskReply, error) {
+	_, rep, err := s.completeGridTask.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.CompleteGridTaskReply), nil
+}
+
+func (s *grpcServer) PullGridTasksStatus(ctx context.Context, req *proto.PullGridTasksStatusRequest) (*proto.PullGridTasksStatusReply, error) {
+	_, rep, err := s.pullGridTasksStatus.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PullGridTasksStatusReply), nil
+}
+
+func (s *grpcServer) CancelGridTasks(ctx context.Context, req *proto.CancelGridTasksRequest) (*proto.CancelGridTasksReply, error) {
+	_, rep, err := s.cancelGridTasks.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.CancelGridTasksReply), nil
+}
+
+func (s *grpcServer) RenewGridTask(ctx context.Context, req *proto.RenewGridTaskRequest) (*proto.RenewGridTaskReply, error) {
+	_, rep, err := s.renewGridTask.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.RenewGridTaskReply), nil
+}
+
+func (s *grpcServer) PublishGridSet(ctx context.Context, req *proto.PublishGridSetRequest) (*proto.PublishGridSetReply, error) {
+	_, rep, err := s.publishGridSet.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.PublishGridSetReply), nil
+}
+
+func (s *grpcServer) DeleteGridSet(ctx context.Context, req *proto.DeleteGridSetRequest) (*proto.DeleteGridSetReply, error) {
+	_, rep, err := s.deleteGridSet.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.DeleteGridSetReply), nil
+}
+
 func (s *grpcServer) PullShortPostInInterval(ctx context.Context,
 	req *proto.PullShortPostInIntervalRequest) (*proto.PullShortPostInIntervalReply, error) {
 	_, rep, err := s.pullShortPostInInterval.ServeGRPC(ctx, req)
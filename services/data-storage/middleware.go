@@ -97,26 +97,29 @@ func (mw loggingMiddleware) PullTimeline(ctx context.Context, cityId string, sta
 	return
 }
 
-func (mw loggingMiddleware) PushGrid(ctx context.Context, cityId string, grids map[int64][]byte) (err error) {
+func (mw loggingMiddleware) PushGrid(ctx context.Context, cityId, set string, grids map[int64][]byte, versions map[int64]int64) (err error) {
 	defer func(begin time.Time) {
 		mw.logger.Info("push grid",
+			zap.String("set", set),
 			zap.Int("len grids", len(grids)),
+			zap.Int("len versions", len(versions)),
 			zap.String("city id", cityId),
 			zap.Error(err),
 			zap.String("took", time.Since(begin).String()))
 	}(time.Now())
-	err = mw.next.PushGrid(ctx, cityId, grids)
+	err = mw.next.PushGrid(ctx, cityId, set, grids, versions)
 	return
 }
 
-func (mw loggingMiddleware) PullGrid(ctx context.Context, cityId string, ids []int64) (grids map[int64][]byte, err error) {
+func (mw loggingMiddleware) PullGrid(ctx context.Context, cityId, set string, ids []int64) (grids map[int64][]byte, versions map[int64]int64, err error) {
 	defer func(begin time.Time) {
 		mw.logger.Info("pull grid",
+			zap.String("set", set),
 			zap.String("city id", cityId),
 			zap.Error(err),
 			zap.String("took", time.Since(begin).String()))
 	}(time.Now())
-	grids, err = mw.next.PullGrid(ctx, cityId, ids)
+	grids, versions, err = mw.next.PullGrid(ctx, cityId, set, ids)
 	return
 }
 
@@ -179,6 +182,168 @@ func (mw loggingMiddleware) PullLocations(ctx context.Context, cityId string) (l
 	return
 }
 
+func (mw loggingMiddleware) PushBots(ctx context.Context, cityId string, bots []data.Bot) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("push bots",
+			zap.Int("bots size", len(bots)),
+			zap.String("city id", cityId),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.PushBots(ctx, cityId, bots)
+	return
+}
+
+func (mw loggingMiddleware) PullBots(ctx context.Context, cityId string) (bots []data.Bot, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("pull bots",
+			zap.String("city id", cityId),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	bots, err = mw.next.PullBots(ctx, cityId)
+	return
+}
+
+func (mw loggingMiddleware) PushAlerts(ctx context.Context, cityId string, alerts []data.Alert) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("push alerts",
+			zap.Int("alerts size", len(alerts)),
+			zap.String("city id", cityId),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.PushAlerts(ctx, cityId, alerts)
+	return
+}
+
+func (mw loggingMiddleware) PushWatermark(ctx context.Context, cityId string, t int64) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("push watermark",
+			zap.String("city id", cityId),
+			zap.Int64("time", t),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.PushWatermark(ctx, cityId, t)
+	return
+}
+
+func (mw loggingMiddleware) PullAlerts(ctx context.Context, cityId string, start, finish int64) (alerts []data.Alert, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("pull alerts",
+			zap.String("city id", cityId),
+			zap.Int64("start time", start),
+			zap.Int64("finish time", finish),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	alerts, err = mw.next.PullAlerts(ctx, cityId, start, finish)
+	return
+}
+
+func (mw loggingMiddleware) PushGridTasks(ctx context.Context, tasks []data.GridTask) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("push grid tasks",
+			zap.Int("tasks size", len(tasks)),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.PushGridTasks(ctx, tasks)
+	return
+}
+
+func (mw loggingMiddleware) LeaseGridTask(ctx context.Context, owner string, lease int64) (task *data.GridTask, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("lease grid task",
+			zap.String("owner", owner),
+			zap.Int64("lease", lease),
+			zap.Bool("leased", task != nil),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	task, err = mw.next.LeaseGridTask(ctx, owner, lease)
+	return
+}
+
+func (mw loggingMiddleware) CompleteGridTask(ctx context.Context, cityId, session string, key int64, owner, status string,
+	posts int64, taskErr string, grids map[int64][]byte) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("complete grid task",
+			zap.String("cityId", cityId),
+			zap.String("session", session),
+			zap.Int64("key", key),
+			zap.String("owner", owner),
+			zap.String("status", status),
+			zap.Int("grids", len(grids)),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.CompleteGridTask(ctx, cityId, session, key, owner, status, posts, taskErr, grids)
+	return
+}
+
+func (mw loggingMiddleware) PullGridTasksStatus(ctx context.Context, session string) (status data.GridTasksStatus, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("pull grid tasks status",
+			zap.String("session", session),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	status, err = mw.next.PullGridTasksStatus(ctx, session)
+	return
+}
+
+func (mw loggingMiddleware) CancelGridTasks(ctx context.Context, session string) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("cancel grid tasks",
+			zap.String("session", session),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.CancelGridTasks(ctx, session)
+	return
+}
+
+func (mw loggingMiddleware) RenewGridTask(ctx context.Context, session string, key int64, owner string, lease int64) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("renew grid task",
+			zap.String("session", session),
+			zap.Int64("key", key),
+			zap.String("owner", owner),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.RenewGridTask(ctx, session, key, owner, lease)
+	return
+}
+
+func (mw loggingMiddleware) PublishGridSet(ctx context.Context, cityId, set string, ids []int64, grids map[int64][]byte) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("publish grid set",
+			zap.String("city id", cityId),
+			zap.String("set", set),
+			zap.Int("len ids", len(ids)),
+			zap.Int("len grids", len(grids)),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.PublishGridSet(ctx, cityId, set, ids, grids)
+	return
+}
+
+func (mw loggingMiddleware) DeleteGridSet(ctx context.Context, cityId, set string) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("delete grid set",
+			zap.String("city id", cityId),
+			zap.String("set", set),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	err = mw.next.DeleteGridSet(ctx, cityId, set)
+	return
+}
+
 func (mw loggingMiddleware) PullShortPostInInterval(ctx context.Context, cityId string, shortCodes []string,
 	startTimestamp int64, endTimestamp int64) (posts []data.ShortPost, err error) {
 	defer func(begin time.Time) {
@@ -787,9 +787,14 @@ func (m *PullTimelineReply) GetErr() string {
 }
 
 // messages for pull and push grids
+// Every push of a grid changes its version. Grids with versions are replaced only if their stored
+// versions are still the same, otherwise nothing is pushed. Grids with a set are kept apart from the
+// grids used by event searches until the set is published, they have no versions.
 type PushGridRequest struct {
 	Grids                map[int64][]byte `protobuf:"bytes,1,rep,name=grids,proto3" json:"grids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CityId               string           `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Versions             map[int64]int64  `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Set                  string           `protobuf:"bytes,4,opt,name=set,proto3" json:"set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -842,6 +847,20 @@ func (m *PushGridRequest) GetCityId() string {
 	return ""
 }
 
+func (m *PushGridRequest) GetVersions() map[int64]int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *PushGridRequest) GetSet() string {
+	if m != nil {
+		return m.Set
+	}
+	return ""
+}
+
 type PushGridReply struct {
 	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -892,6 +911,7 @@ func (m *PushGridReply) GetErr() string {
 type PullGridRequest struct {
 	Ids                  []int64  `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	CityId               string   `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Set                  string   `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -944,9 +964,17 @@ func (m *PullGridRequest) GetCityId() string {
 	return ""
 }
 
+func (m *PullGridRequest) GetSet() string {
+	if m != nil {
+		return m.Set
+	}
+	return ""
+}
+
 type PullGridReply struct {
 	Grids                map[int64][]byte `protobuf:"bytes,1,rep,name=grids,proto3" json:"grids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Err                  string           `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	Versions             map[int64]int64  `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -999,6 +1027,13 @@ func (m *PullGridReply) GetErr() string {
 	return ""
 }
 
+func (m *PullGridReply) GetVersions() map[int64]int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 // messages gor pull and push events
 type PushEventsRequest struct {
 	Events               []proto1.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
@@ -1543,28 +1578,27 @@ func (m *PullLocationsReply) GetErr() string {
 	return ""
 }
 
-type PullShortPostInIntervalRequest struct {
-	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
-	StartTimestamp       int64    `protobuf:"varint,2,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
-	EndTimestamp         int64    `protobuf:"varint,3,opt,name=endTimestamp,proto3" json:"endTimestamp,omitempty"`
-	Shortcodes           []string `protobuf:"bytes,4,rep,name=shortcodes,proto3" json:"shortcodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// messages for pull and push bots
+type PushBotsRequest struct {
+	CityId               string       `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Bots                 []proto1.Bot `protobuf:"bytes,2,rep,name=bots,proto3" json:"bots"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *PullShortPostInIntervalRequest) Reset()         { *m = PullShortPostInIntervalRequest{} }
-func (m *PullShortPostInIntervalRequest) String() string { return proto.CompactTextString(m) }
-func (*PullShortPostInIntervalRequest) ProtoMessage()    {}
-func (*PullShortPostInIntervalRequest) Descriptor() ([]byte, []int) {
+func (m *PushBotsRequest) Reset()         { *m = PushBotsRequest{} }
+func (m *PushBotsRequest) String() string { return proto.CompactTextString(m) }
+func (*PushBotsRequest) ProtoMessage()    {}
+func (*PushBotsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8ec0c2fba98f9a4b, []int{28}
 }
-func (m *PullShortPostInIntervalRequest) XXX_Unmarshal(b []byte) error {
+func (m *PushBotsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullShortPostInIntervalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PushBotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullShortPostInIntervalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PushBotsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1574,66 +1608,51 @@ func (m *PullShortPostInIntervalRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *PullShortPostInIntervalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullShortPostInIntervalRequest.Merge(m, src)
+func (m *PushBotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushBotsRequest.Merge(m, src)
 }
-func (m *PullShortPostInIntervalRequest) XXX_Size() int {
+func (m *PushBotsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PullShortPostInIntervalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullShortPostInIntervalRequest.DiscardUnknown(m)
+func (m *PushBotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushBotsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullShortPostInIntervalRequest proto.InternalMessageInfo
+var xxx_messageInfo_PushBotsRequest proto.InternalMessageInfo
 
-func (m *PullShortPostInIntervalRequest) GetCityId() string {
+func (m *PushBotsRequest) GetCityId() string {
 	if m != nil {
 		return m.CityId
 	}
 	return ""
 }
 
-func (m *PullShortPostInIntervalRequest) GetStartTimestamp() int64 {
-	if m != nil {
-		return m.StartTimestamp
-	}
-	return 0
-}
-
-func (m *PullShortPostInIntervalRequest) GetEndTimestamp() int64 {
-	if m != nil {
-		return m.EndTimestamp
-	}
-	return 0
-}
-
-func (m *PullShortPostInIntervalRequest) GetShortcodes() []string {
+func (m *PushBotsRequest) GetBots() []proto1.Bot {
 	if m != nil {
-		return m.Shortcodes
+		return m.Bots
 	}
 	return nil
 }
 
-type PullShortPostInIntervalReply struct {
-	Posts                []proto1.ShortPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
-	Err                  string             `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type PushBotsReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullShortPostInIntervalReply) Reset()         { *m = PullShortPostInIntervalReply{} }
-func (m *PullShortPostInIntervalReply) String() string { return proto.CompactTextString(m) }
-func (*PullShortPostInIntervalReply) ProtoMessage()    {}
-func (*PullShortPostInIntervalReply) Descriptor() ([]byte, []int) {
+func (m *PushBotsReply) Reset()         { *m = PushBotsReply{} }
+func (m *PushBotsReply) String() string { return proto.CompactTextString(m) }
+func (*PushBotsReply) ProtoMessage()    {}
+func (*PushBotsReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8ec0c2fba98f9a4b, []int{29}
 }
-func (m *PullShortPostInIntervalReply) XXX_Unmarshal(b []byte) error {
+func (m *PushBotsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullShortPostInIntervalReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PushBotsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullShortPostInIntervalReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PushBotsReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1643,52 +1662,44 @@ func (m *PullShortPostInIntervalReply) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *PullShortPostInIntervalReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullShortPostInIntervalReply.Merge(m, src)
+func (m *PushBotsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushBotsReply.Merge(m, src)
 }
-func (m *PullShortPostInIntervalReply) XXX_Size() int {
+func (m *PushBotsReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *PullShortPostInIntervalReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullShortPostInIntervalReply.DiscardUnknown(m)
+func (m *PushBotsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushBotsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullShortPostInIntervalReply proto.InternalMessageInfo
-
-func (m *PullShortPostInIntervalReply) GetPosts() []proto1.ShortPost {
-	if m != nil {
-		return m.Posts
-	}
-	return nil
-}
+var xxx_messageInfo_PushBotsReply proto.InternalMessageInfo
 
-func (m *PullShortPostInIntervalReply) GetErr() string {
+func (m *PushBotsReply) GetErr() string {
 	if m != nil {
 		return m.Err
 	}
 	return ""
 }
 
-type PullSingleShortPostRequest struct {
+type PullBotsRequest struct {
 	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
-	Shortcode            string   `protobuf:"bytes,2,opt,name=shortcode,proto3" json:"shortcode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullSingleShortPostRequest) Reset()         { *m = PullSingleShortPostRequest{} }
-func (m *PullSingleShortPostRequest) String() string { return proto.CompactTextString(m) }
-func (*PullSingleShortPostRequest) ProtoMessage()    {}
-func (*PullSingleShortPostRequest) Descriptor() ([]byte, []int) {
+func (m *PullBotsRequest) Reset()         { *m = PullBotsRequest{} }
+func (m *PullBotsRequest) String() string { return proto.CompactTextString(m) }
+func (*PullBotsRequest) ProtoMessage()    {}
+func (*PullBotsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8ec0c2fba98f9a4b, []int{30}
 }
-func (m *PullSingleShortPostRequest) XXX_Unmarshal(b []byte) error {
+func (m *PullBotsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullSingleShortPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PullBotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullSingleShortPostRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PullBotsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1698,52 +1709,45 @@ func (m *PullSingleShortPostRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *PullSingleShortPostRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullSingleShortPostRequest.Merge(m, src)
+func (m *PullBotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullBotsRequest.Merge(m, src)
 }
-func (m *PullSingleShortPostRequest) XXX_Size() int {
+func (m *PullBotsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PullSingleShortPostRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullSingleShortPostRequest.DiscardUnknown(m)
+func (m *PullBotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullBotsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullSingleShortPostRequest proto.InternalMessageInfo
+var xxx_messageInfo_PullBotsRequest proto.InternalMessageInfo
 
-func (m *PullSingleShortPostRequest) GetCityId() string {
+func (m *PullBotsRequest) GetCityId() string {
 	if m != nil {
 		return m.CityId
 	}
 	return ""
 }
 
-func (m *PullSingleShortPostRequest) GetShortcode() string {
-	if m != nil {
-		return m.Shortcode
-	}
-	return ""
-}
-
-type PullSingleShortPostReply struct {
-	Post                 *proto1.ShortPost `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
-	Err                  string            `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type PullBotsReply struct {
+	Bots                 []proto1.Bot `protobuf:"bytes,1,rep,name=bots,proto3" json:"bots"`
+	Err                  string       `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *PullSingleShortPostReply) Reset()         { *m = PullSingleShortPostReply{} }
-func (m *PullSingleShortPostReply) String() string { return proto.CompactTextString(m) }
-func (*PullSingleShortPostReply) ProtoMessage()    {}
-func (*PullSingleShortPostReply) Descriptor() ([]byte, []int) {
+func (m *PullBotsReply) Reset()         { *m = PullBotsReply{} }
+func (m *PullBotsReply) String() string { return proto.CompactTextString(m) }
+func (*PullBotsReply) ProtoMessage()    {}
+func (*PullBotsReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8ec0c2fba98f9a4b, []int{31}
 }
-func (m *PullSingleShortPostReply) XXX_Unmarshal(b []byte) error {
+func (m *PullBotsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PullSingleShortPostReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PullBotsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PullSingleShortPostReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PullBotsReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1753,2877 +1757,9567 @@ func (m *PullSingleShortPostReply) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *PullSingleShortPostReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullSingleShortPostReply.Merge(m, src)
+func (m *PullBotsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullBotsReply.Merge(m, src)
 }
-func (m *PullSingleShortPostReply) XXX_Size() int {
+func (m *PullBotsReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *PullSingleShortPostReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullSingleShortPostReply.DiscardUnknown(m)
+func (m *PullBotsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullBotsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullSingleShortPostReply proto.InternalMessageInfo
+var xxx_messageInfo_PullBotsReply proto.InternalMessageInfo
 
-func (m *PullSingleShortPostReply) GetPost() *proto1.ShortPost {
+func (m *PullBotsReply) GetBots() []proto1.Bot {
 	if m != nil {
-		return m.Post
+		return m.Bots
 	}
 	return nil
 }
 
-func (m *PullSingleShortPostReply) GetErr() string {
+func (m *PullBotsReply) GetErr() string {
 	if m != nil {
 		return m.Err
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*InsertCityRequest)(nil), "proto.InsertCityRequest")
-	proto.RegisterType((*InsertCityReply)(nil), "proto.InsertCityReply")
-	proto.RegisterType((*GetAllCitiesRequest)(nil), "proto.GetAllCitiesRequest")
-	proto.RegisterType((*GetAllCitiesReply)(nil), "proto.GetAllCitiesReply")
-	proto.RegisterType((*GetCityRequest)(nil), "proto.GetCityRequest")
-	proto.RegisterType((*GetCityReply)(nil), "proto.GetCityReply")
-	proto.RegisterType((*PushPostsRequest)(nil), "proto.PushPostsRequest")
-	proto.RegisterType((*PushPostsReply)(nil), "proto.PushPostsReply")
-	proto.RegisterType((*SelectPostsRequest)(nil), "proto.SelectPostsRequest")
-	proto.RegisterType((*SelectPostsReply)(nil), "proto.SelectPostsReply")
-	proto.RegisterType((*SelectAggrPostsRequest)(nil), "proto.SelectAggrPostsRequest")
-	proto.RegisterType((*SelectAggrPostsReply)(nil), "proto.SelectAggrPostsReply")
-	proto.RegisterType((*PullTimelineRequest)(nil), "proto.PullTimelineRequest")
-	proto.RegisterType((*PullTimelineReply)(nil), "proto.PullTimelineReply")
-	proto.RegisterType((*PushGridRequest)(nil), "proto.PushGridRequest")
-	proto.RegisterMapType((map[int64][]byte)(nil), "proto.PushGridRequest.GridsEntry")
-	proto.RegisterType((*PushGridReply)(nil), "proto.PushGridReply")
-	proto.RegisterType((*PullGridRequest)(nil), "proto.PullGridRequest")
-	proto.RegisterType((*PullGridReply)(nil), "proto.PullGridReply")
-	proto.RegisterMapType((map[int64][]byte)(nil), "proto.PullGridReply.GridsEntry")
-	proto.RegisterType((*PushEventsRequest)(nil), "proto.PushEventsRequest")
-	proto.RegisterType((*PushEventsReply)(nil), "proto.PushEventsReply")
-	proto.RegisterType((*PullEventsRequest)(nil), "proto.PullEventsRequest")
-	proto.RegisterType((*PullEventsReply)(nil), "proto.PullEventsReply")
-	proto.RegisterType((*PullEventsTagsRequest)(nil), "proto.PullEventsTagsRequest")
-	proto.RegisterType((*PullEventsTagsReply)(nil), "proto.PullEventsTagsReply")
-	proto.RegisterType((*PushLocationsRequest)(nil), "proto.PushLocationsRequest")
-	proto.RegisterType((*PushLocationsReply)(nil), "proto.PushLocationsReply")
-	proto.RegisterType((*PullLocationsRequest)(nil), "proto.PullLocationsRequest")
-	proto.RegisterType((*PullLocationsReply)(nil), "proto.PullLocationsReply")
-	proto.RegisterType((*PullShortPostInIntervalRequest)(nil), "proto.PullShortPostInIntervalRequest")
-	proto.RegisterType((*PullShortPostInIntervalReply)(nil), "proto.PullShortPostInIntervalReply")
-	proto.RegisterType((*PullSingleShortPostRequest)(nil), "proto.PullSingleShortPostRequest")
-	proto.RegisterType((*PullSingleShortPostReply)(nil), "proto.PullSingleShortPostReply")
+// messages for pull and push city-level alerts
+type PushAlertsRequest struct {
+	CityId               string         `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Alerts               []proto1.Alert `protobuf:"bytes,2,rep,name=alerts,proto3" json:"alerts"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("data-storage/proto/data-storage.proto", fileDescriptor_8ec0c2fba98f9a4b)
+func (m *PushAlertsRequest) Reset()         { *m = PushAlertsRequest{} }
+func (m *PushAlertsRequest) String() string { return proto.CompactTextString(m) }
+func (*PushAlertsRequest) ProtoMessage()    {}
+func (*PushAlertsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{32}
 }
-
-var fileDescriptor_8ec0c2fba98f9a4b = []byte{
-	// 1175 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xf7, 0xe5, 0x9c, 0x10, 0x4f, 0x82, 0xe3, 0x6c, 0x1c, 0xc7, 0xbd, 0xa6, 0x4e, 0xb2, 0xa1,
-	0x91, 0x11, 0xc2, 0x85, 0x20, 0xd4, 0xaa, 0x08, 0xa9, 0x69, 0x08, 0xc1, 0x52, 0x45, 0xc3, 0xa5,
-	0x42, 0xa8, 0x88, 0x87, 0xab, 0xbd, 0xbd, 0x1c, 0x5c, 0xef, 0xcc, 0xdd, 0x3a, 0xc2, 0x8f, 0x7c,
-	0x05, 0x78, 0xe1, 0x81, 0x47, 0x3e, 0x4c, 0x1f, 0xf9, 0x04, 0x08, 0x85, 0x2f, 0x82, 0xf6, 0xcf,
-	0xdd, 0xed, 0xde, 0x9f, 0x38, 0xa8, 0xe2, 0xc9, 0xde, 0x99, 0xdf, 0xce, 0xfc, 0x66, 0x76, 0x6e,
-	0x66, 0xe0, 0xee, 0xd8, 0xa1, 0xce, 0xfb, 0x31, 0x0d, 0x23, 0xc7, 0x25, 0xf7, 0x26, 0x51, 0x48,
-	0xc3, 0x7b, 0xaa, 0x68, 0xc0, 0x45, 0x68, 0x91, 0xff, 0x58, 0x77, 0x4a, 0xd0, 0x6e, 0xe8, 0x86,
-	0x02, 0x65, 0xb5, 0xb2, 0xfb, 0x42, 0x82, 0x1d, 0x58, 0x1f, 0x06, 0x31, 0x89, 0xe8, 0xb1, 0x47,
-	0x67, 0x36, 0xf9, 0x71, 0x4a, 0x62, 0x8a, 0xde, 0x81, 0xfa, 0xc8, 0xa3, 0xb3, 0xae, 0xb1, 0x6b,
-	0xf4, 0x57, 0x0e, 0x61, 0xc0, 0xf1, 0x0c, 0xf0, 0xb8, 0xfe, 0xfa, 0xaf, 0x9d, 0x9a, 0xcd, 0xb5,
-	0xe8, 0x00, 0x9a, 0xd3, 0xc9, 0xd8, 0xa1, 0x64, 0xf8, 0xf2, 0xe4, 0x27, 0x2f, 0xa6, 0x71, 0x77,
-	0x61, 0xd7, 0xe8, 0x2f, 0xdb, 0x39, 0x29, 0xde, 0x87, 0x35, 0xd5, 0xc5, 0xc4, 0x9f, 0xa1, 0x16,
-	0x98, 0x24, 0x8a, 0xb8, 0xfd, 0x86, 0xcd, 0xfe, 0xe2, 0x4d, 0xd8, 0x38, 0x25, 0xf4, 0xc8, 0xf7,
-	0x8f, 0x3d, 0xea, 0x91, 0x58, 0x32, 0xc1, 0x4f, 0x61, 0x5d, 0x17, 0xb3, 0xdb, 0x7d, 0x58, 0x1a,
-	0xf1, 0x63, 0xd7, 0xd8, 0x35, 0x4b, 0x09, 0x4a, 0x7d, 0xe2, 0x67, 0x21, 0xf3, 0xd3, 0x87, 0xe6,
-	0x29, 0xd1, 0x82, 0xed, 0x70, 0x6b, 0xb3, 0xe1, 0x58, 0xd2, 0x91, 0x27, 0xfc, 0x08, 0x56, 0x53,
-	0x24, 0xf3, 0xda, 0xab, 0x4a, 0x8a, 0x4c, 0x47, 0xd1, 0x97, 0x0d, 0xad, 0xb3, 0x69, 0x7c, 0x71,
-	0x16, 0xc6, 0x34, 0x09, 0x08, 0x1d, 0xc0, 0xe2, 0x84, 0x9d, 0x75, 0xea, 0x0c, 0x22, 0xa9, 0x0b,
-	0xb5, 0xc2, 0x6a, 0x41, 0x63, 0x85, 0xa1, 0xa9, 0xd8, 0x2c, 0xcf, 0xe5, 0xf7, 0x80, 0xce, 0x89,
-	0x4f, 0x46, 0x54, 0xf3, 0xbc, 0x0d, 0x8d, 0x98, 0x3a, 0x11, 0x7d, 0xe6, 0xbd, 0x22, 0x1c, 0x6d,
-	0xda, 0x99, 0x00, 0xf5, 0x00, 0x5e, 0x7a, 0x81, 0x17, 0x5f, 0x70, 0xf5, 0x02, 0x57, 0x2b, 0x12,
-	0x85, 0x8f, 0xa9, 0xf1, 0xf1, 0xa1, 0xa5, 0xf9, 0x62, 0x8c, 0x6e, 0x1a, 0x63, 0x0f, 0xea, 0x4e,
-	0x44, 0x1c, 0xee, 0x2d, 0x85, 0x1d, 0x45, 0xc4, 0xb1, 0xb9, 0x3c, 0x89, 0xcc, 0xcc, 0x22, 0xf3,
-	0xa1, 0x23, 0xbc, 0x1d, 0xb9, 0x6e, 0xa4, 0x45, 0xf7, 0x10, 0x96, 0xbd, 0x80, 0x92, 0xe8, 0xd2,
-	0xf1, 0xe5, 0x0b, 0x75, 0x85, 0xbd, 0xf3, 0x89, 0x43, 0xbd, 0xf0, 0x8b, 0x70, 0x1a, 0x0d, 0xa5,
-	0x5e, 0x92, 0x48, 0xf1, 0x95, 0xb9, 0x7e, 0x0e, 0xed, 0x82, 0x37, 0x16, 0xdf, 0x07, 0x7a, 0x7c,
-	0x6d, 0x49, 0xdc, 0x75, 0x23, 0xe2, 0x3a, 0x94, 0x8c, 0x8b, 0x91, 0x16, 0x6b, 0xe3, 0x5b, 0xd8,
-	0x38, 0x9b, 0xfa, 0x3e, 0xcb, 0xad, 0xef, 0x05, 0x64, 0x4e, 0x31, 0xa2, 0x36, 0x2c, 0xf2, 0xb7,
-	0x92, 0x2f, 0x23, 0x0e, 0x0c, 0x2d, 0x9e, 0x88, 0xe7, 0xc8, 0xb4, 0xe5, 0x09, 0x7f, 0x03, 0xeb,
-	0xba, 0x71, 0xc6, 0xfa, 0x43, 0x58, 0xa6, 0x52, 0x20, 0x89, 0xaf, 0x09, 0xe2, 0x0c, 0x16, 0x53,
-	0xe7, 0xd5, 0x24, 0x49, 0x4c, 0x02, 0x2b, 0xa1, 0xfd, 0xbb, 0x01, 0x6b, 0xac, 0xfe, 0x4e, 0x23,
-	0x6f, 0x9c, 0x70, 0xbe, 0x0f, 0x8b, 0x6e, 0xe4, 0x8d, 0x93, 0x74, 0xec, 0x89, 0xce, 0x32, 0xc8,
-	0xc1, 0x06, 0xec, 0x7f, 0x7c, 0x12, 0xd0, 0x68, 0x66, 0x0b, 0x7c, 0x55, 0xde, 0xad, 0x07, 0x00,
-	0x19, 0x98, 0x91, 0xf8, 0x81, 0xcc, 0x64, 0xc5, 0xb2, 0xbf, 0x2c, 0x19, 0x97, 0x8e, 0x3f, 0x15,
-	0x65, 0xba, 0x6a, 0x8b, 0xc3, 0xc3, 0x85, 0x07, 0x06, 0xde, 0x83, 0xb7, 0x33, 0xb7, 0xe5, 0x1f,
-	0xc7, 0x27, 0x2c, 0x00, 0xdf, 0x57, 0x03, 0x68, 0x81, 0x99, 0xd0, 0x37, 0x6d, 0xf3, 0x1a, 0x66,
-	0xf8, 0x17, 0x83, 0x39, 0x48, 0x6e, 0x33, 0x07, 0x1f, 0xeb, 0xc1, 0xef, 0xa4, 0xc1, 0x2b, 0xa0,
-	0x92, 0xd0, 0x0b, 0x99, 0x7d, 0x83, 0xa0, 0xbf, 0x66, 0xaf, 0x1d, 0x5f, 0x9c, 0x5c, 0x92, 0x20,
-	0xfb, 0x1e, 0xde, 0x85, 0x25, 0xc2, 0x05, 0x92, 0xd8, 0x8a, 0x78, 0x6b, 0x0e, 0x4a, 0x9a, 0xa4,
-	0x00, 0x54, 0x06, 0xbb, 0x2f, 0x9e, 0x3a, 0xb1, 0x5b, 0x9e, 0x4e, 0x57, 0x94, 0x9a, 0xee, 0xfc,
-	0xff, 0xf8, 0x18, 0xbf, 0x14, 0xef, 0xa6, 0xb2, 0xf9, 0x0f, 0x31, 0x16, 0x2b, 0xf9, 0x67, 0x03,
-	0x36, 0x33, 0x83, 0xcf, 0x1c, 0x37, 0x9e, 0xf7, 0x0d, 0x22, 0xa8, 0x53, 0xc7, 0x65, 0x53, 0xce,
-	0xec, 0x37, 0x6c, 0xfe, 0x5f, 0x6f, 0xaa, 0xe6, 0xf5, 0x4d, 0xb5, 0x9e, 0x6f, 0xaa, 0xd8, 0x16,
-	0x4d, 0x40, 0xa5, 0xf0, 0xc6, 0x71, 0xbd, 0x80, 0x36, 0x7b, 0xb5, 0x27, 0xe1, 0x88, 0xa5, 0x3a,
-	0x98, 0x1b, 0xd5, 0x21, 0x34, 0xfc, 0x04, 0xcb, 0x43, 0x5b, 0x39, 0x6c, 0x0a, 0x7f, 0x89, 0x09,
-	0xe9, 0x32, 0x83, 0xe1, 0x03, 0x40, 0x39, 0x1f, 0xe5, 0xc5, 0x31, 0x60, 0x5c, 0x7c, 0xff, 0xa6,
-	0x5c, 0xf0, 0x73, 0x66, 0x57, 0xc3, 0x33, 0xbb, 0x1a, 0x43, 0xe3, 0x46, 0x0c, 0x4b, 0xf2, 0xf2,
-	0x87, 0x01, 0x3d, 0x66, 0xfc, 0xfc, 0x22, 0x8c, 0xf8, 0xb0, 0x1a, 0x06, 0x49, 0x0d, 0xce, 0x4b,
-	0xd1, 0x01, 0x34, 0xd3, 0x37, 0xe5, 0x8d, 0x52, 0x76, 0xe1, 0x9c, 0x14, 0x61, 0x58, 0x25, 0xc1,
-	0x38, 0x43, 0x89, 0x7a, 0xd0, 0x64, 0xac, 0x24, 0x62, 0xc6, 0x60, 0x14, 0x8e, 0x49, 0xdc, 0xad,
-	0xf3, 0x52, 0x52, 0x24, 0xf8, 0x3b, 0xd8, 0xae, 0x64, 0xc9, 0x92, 0xf1, 0x9e, 0x3e, 0x7b, 0x64,
-	0x0b, 0x4f, 0xe1, 0xf3, 0xc6, 0x8e, 0x0d, 0x16, 0x37, 0xef, 0x05, 0xae, 0x4f, 0xd2, 0x5b, 0xf3,
-	0x12, 0xc0, 0xaa, 0x3c, 0xa1, 0x28, 0xad, 0x65, 0x02, 0xfc, 0x15, 0x74, 0x4b, 0x6d, 0x32, 0xba,
-	0xfb, 0x50, 0x67, 0x54, 0x64, 0x17, 0xc8, 0xb3, 0xb5, 0xb9, 0xb2, 0x48, 0xf3, 0xf0, 0xd7, 0x06,
-	0xac, 0x7c, 0xe6, 0x50, 0xe7, 0x5c, 0x2c, 0xb2, 0xe8, 0x11, 0x40, 0xb6, 0x42, 0xa2, 0xae, 0x6c,
-	0xb2, 0x85, 0xc5, 0xd5, 0xea, 0x94, 0x68, 0x26, 0xfe, 0x0c, 0xd7, 0xd0, 0xe7, 0x7c, 0x9b, 0x4b,
-	0x17, 0x49, 0x64, 0x49, 0x64, 0xc9, 0xd2, 0x69, 0x75, 0x4b, 0x75, 0xc2, 0xce, 0x7d, 0x78, 0x4b,
-	0x6e, 0x85, 0x68, 0x33, 0x83, 0xa9, 0x1c, 0x36, 0xf2, 0x62, 0x71, 0xf1, 0x53, 0x68, 0xa4, 0x8b,
-	0x1b, 0xda, 0x52, 0x66, 0xa4, 0xba, 0xc6, 0x58, 0x9b, 0x45, 0x85, 0xb8, 0x7e, 0x0c, 0x2b, 0xca,
-	0x9e, 0x85, 0x6e, 0x49, 0x5c, 0x71, 0xcf, 0xb3, 0xb6, 0xca, 0x54, 0xc2, 0xc8, 0x53, 0x58, 0xcb,
-	0x2d, 0x34, 0xe8, 0x8e, 0x86, 0xce, 0xaf, 0x55, 0xd6, 0xed, 0x2a, 0x75, 0x9a, 0x55, 0x75, 0xd1,
-	0x48, 0xb3, 0x5a, 0xb2, 0xda, 0xa4, 0x59, 0x2d, 0x6c, 0x26, 0xb8, 0xc6, 0x06, 0x46, 0x32, 0xb7,
-	0x51, 0xa7, 0x7c, 0x7f, 0xb0, 0xda, 0x05, 0xb9, 0x72, 0x57, 0x4c, 0x5b, 0xe5, 0xae, 0x36, 0xe1,
-	0x95, 0xbb, 0xca, 0x58, 0xc6, 0x35, 0x56, 0x57, 0xd9, 0x88, 0x43, 0x5d, 0xc5, 0x83, 0x36, 0xd0,
-	0xac, 0x4e, 0x89, 0x46, 0xb1, 0x90, 0xb4, 0x70, 0xa4, 0xc6, 0x58, 0x65, 0x41, 0x9b, 0x61, 0xb8,
-	0x86, 0x9e, 0xb0, 0x8d, 0x5e, 0x1d, 0x02, 0x68, 0xbb, 0x80, 0x55, 0xc6, 0x93, 0x65, 0x55, 0x68,
-	0x85, 0xb5, 0xa1, 0xd8, 0x80, 0xd2, 0x16, 0x8a, 0x6e, 0x2b, 0xd4, 0xf3, 0x8d, 0xd8, 0xba, 0x55,
-	0xae, 0x54, 0x4c, 0x29, 0xdd, 0x58, 0x31, 0x55, 0xec, 0xe9, 0x8a, 0xa9, 0x7c, 0x03, 0xc7, 0x35,
-	0xe4, 0xc2, 0x56, 0x45, 0x57, 0x43, 0x77, 0x95, 0x7b, 0xd5, 0xbd, 0xd9, 0xda, 0x9f, 0x07, 0x13,
-	0x8e, 0xe4, 0x5a, 0x9d, 0xeb, 0x45, 0x68, 0x4f, 0xbd, 0x5d, 0xda, 0xfb, 0xac, 0x9d, 0xeb, 0x20,
-	0xdc, 0xf8, 0xe3, 0xd6, 0xeb, 0xab, 0x9e, 0xf1, 0xe7, 0x55, 0xcf, 0xf8, 0xfb, 0xaa, 0x67, 0xfc,
-	0xf6, 0x4f, 0xaf, 0xf6, 0x62, 0x89, 0xdf, 0xf9, 0xe8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x84,
-	0xf2, 0x1b, 0x95, 0xa5, 0x0f, 0x00, 0x00,
+func (m *PushAlertsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DataStorageClient is the client API for DataStorage service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataStorageClient interface {
-	InsertCity(ctx context.Context, in *InsertCityRequest, opts ...grpc.CallOption) (*InsertCityReply, error)
-	GetAllCities(ctx context.Context, in *GetAllCitiesRequest, opts ...grpc.CallOption) (*GetAllCitiesReply, error)
-	GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*GetCityReply, error)
-	PushPosts(ctx context.Context, in *PushPostsRequest, opts ...grpc.CallOption) (*PushPostsReply, error)
-	SelectPosts(ctx context.Context, in *SelectPostsRequest, opts ...grpc.CallOption) (*SelectPostsReply, error)
-	SelectAggrPosts(ctx context.Context, in *SelectAggrPostsRequest, opts ...grpc.CallOption) (*SelectAggrPostsReply, error)
-	PullTimeline(ctx context.Context, in *PullTimelineRequest, opts ...grpc.CallOption) (*PullTimelineReply, error)
-	PushGrid(ctx context.Context, in *PushGridRequest, opts ...grpc.CallOption) (*PushGridReply, error)
-	PullGrid(ctx context.Context, in *PullGridRequest, opts ...grpc.CallOption) (*PullGridReply, error)
-	PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsReply, error)
-	PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (*PullEventsReply, error)
-	PullEventsTags(ctx context.Context, in *PullEventsTagsRequest, opts ...grpc.CallOption) (*PullEventsTagsReply, error)
-	PushLocations(ctx context.Context, in *PushLocationsRequest, opts ...grpc.CallOption) (*PushLocationsReply, error)
-	PullLocations(ctx context.Context, in *PullLocationsRequest, opts ...grpc.CallOption) (*PullLocationsReply, error)
-	PullShortPostInInterval(ctx context.Context, in *PullShortPostInIntervalRequest, opts ...grpc.CallOption) (*PullShortPostInIntervalReply, error)
-	PullSingleShortPost(ctx context.Context, in *PullSingleShortPostRequest, opts ...grpc.CallOption) (*PullSingleShortPostReply, error)
+func (m *PushAlertsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushAlertsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type dataStorageClient struct {
-	cc *grpc.ClientConn
+func (m *PushAlertsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushAlertsRequest.Merge(m, src)
 }
-
-func NewDataStorageClient(cc *grpc.ClientConn) DataStorageClient {
-	return &dataStorageClient{cc}
+func (m *PushAlertsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *dataStorageClient) InsertCity(ctx context.Context, in *InsertCityRequest, opts ...grpc.CallOption) (*InsertCityReply, error) {
-	out := new(InsertCityReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/InsertCity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PushAlertsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushAlertsRequest.DiscardUnknown(m)
 }
 
-func (c *dataStorageClient) GetAllCities(ctx context.Context, in *GetAllCitiesRequest, opts ...grpc.CallOption) (*GetAllCitiesReply, error) {
-	out := new(GetAllCitiesReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/GetAllCities", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PushAlertsRequest proto.InternalMessageInfo
+
+func (m *PushAlertsRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataStorageClient) GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*GetCityReply, error) {
-	out := new(GetCityReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/GetCity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PushAlertsRequest) GetAlerts() []proto1.Alert {
+	if m != nil {
+		return m.Alerts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataStorageClient) PushPosts(ctx context.Context, in *PushPostsRequest, opts ...grpc.CallOption) (*PushPostsReply, error) {
-	out := new(PushPostsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushPosts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PushAlertsReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataStorageClient) SelectPosts(ctx context.Context, in *SelectPostsRequest, opts ...grpc.CallOption) (*SelectPostsReply, error) {
-	out := new(SelectPostsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/SelectPosts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PushAlertsReply) Reset()         { *m = PushAlertsReply{} }
+func (m *PushAlertsReply) String() string { return proto.CompactTextString(m) }
+func (*PushAlertsReply) ProtoMessage()    {}
+func (*PushAlertsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{33}
 }
-
-func (c *dataStorageClient) SelectAggrPosts(ctx context.Context, in *SelectAggrPostsRequest, opts ...grpc.CallOption) (*SelectAggrPostsReply, error) {
-	out := new(SelectAggrPostsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/SelectAggrPosts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PushAlertsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *dataStorageClient) PullTimeline(ctx context.Context, in *PullTimelineRequest, opts ...grpc.CallOption) (*PullTimelineReply, error) {
-	out := new(PullTimelineReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullTimeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PushAlertsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushAlertsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PushAlertsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushAlertsReply.Merge(m, src)
+}
+func (m *PushAlertsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushAlertsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushAlertsReply.DiscardUnknown(m)
 }
 
-func (c *dataStorageClient) PushGrid(ctx context.Context, in *PushGridRequest, opts ...grpc.CallOption) (*PushGridReply, error) {
-	out := new(PushGridReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushGrid", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PushAlertsReply proto.InternalMessageInfo
+
+func (m *PushAlertsReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataStorageClient) PullGrid(ctx context.Context, in *PullGridRequest, opts ...grpc.CallOption) (*PullGridReply, error) {
-	out := new(PullGridReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullGrid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PullAlertsRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Finish               int64    `protobuf:"varint,3,opt,name=finish,proto3" json:"finish,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataStorageClient) PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsReply, error) {
-	out := new(PushEventsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PullAlertsRequest) Reset()         { *m = PullAlertsRequest{} }
+func (m *PullAlertsRequest) String() string { return proto.CompactTextString(m) }
+func (*PullAlertsRequest) ProtoMessage()    {}
+func (*PullAlertsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{34}
+}
+func (m *PullAlertsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullAlertsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullAlertsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PullAlertsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullAlertsRequest.Merge(m, src)
+}
+func (m *PullAlertsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullAlertsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullAlertsRequest.DiscardUnknown(m)
 }
 
-func (c *dataStorageClient) PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (*PullEventsReply, error) {
-	out := new(PullEventsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PullAlertsRequest proto.InternalMessageInfo
+
+func (m *PullAlertsRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataStorageClient) PullEventsTags(ctx context.Context, in *PullEventsTagsRequest, opts ...grpc.CallOption) (*PullEventsTagsReply, error) {
-	out := new(PullEventsTagsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullEventsTags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PullAlertsRequest) GetStart() int64 {
+	if m != nil {
+		return m.Start
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataStorageClient) PushLocations(ctx context.Context, in *PushLocationsRequest, opts ...grpc.CallOption) (*PushLocationsReply, error) {
-	out := new(PushLocationsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushLocations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PullAlertsRequest) GetFinish() int64 {
+	if m != nil {
+		return m.Finish
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataStorageClient) PullLocations(ctx context.Context, in *PullLocationsRequest, opts ...grpc.CallOption) (*PullLocationsReply, error) {
-	out := new(PullLocationsReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullLocations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PullAlertsReply struct {
+	Alerts               []proto1.Alert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts"`
+	Err                  string         `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *dataStorageClient) PullShortPostInInterval(ctx context.Context, in *PullShortPostInIntervalRequest, opts ...grpc.CallOption) (*PullShortPostInIntervalReply, error) {
-	out := new(PullShortPostInIntervalReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullShortPostInInterval", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PullAlertsReply) Reset()         { *m = PullAlertsReply{} }
+func (m *PullAlertsReply) String() string { return proto.CompactTextString(m) }
+func (*PullAlertsReply) ProtoMessage()    {}
+func (*PullAlertsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{35}
+}
+func (m *PullAlertsReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullAlertsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullAlertsReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PullAlertsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullAlertsReply.Merge(m, src)
+}
+func (m *PullAlertsReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullAlertsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullAlertsReply.DiscardUnknown(m)
 }
 
-func (c *dataStorageClient) PullSingleShortPost(ctx context.Context, in *PullSingleShortPostRequest, opts ...grpc.CallOption) (*PullSingleShortPostReply, error) {
-	out := new(PullSingleShortPostReply)
-	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullSingleShortPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PullAlertsReply proto.InternalMessageInfo
+
+func (m *PullAlertsReply) GetAlerts() []proto1.Alert {
+	if m != nil {
+		return m.Alerts
 	}
-	return out, nil
+	return nil
 }
 
-// DataStorageServer is the server API for DataStorage service.
-type DataStorageServer interface {
-	InsertCity(context.Context, *InsertCityRequest) (*InsertCityReply, error)
-	GetAllCities(context.Context, *GetAllCitiesRequest) (*GetAllCitiesReply, error)
-	GetCity(context.Context, *GetCityRequest) (*GetCityReply, error)
-	PushPosts(context.Context, *PushPostsRequest) (*PushPostsReply, error)
-	SelectPosts(context.Context, *SelectPostsRequest) (*SelectPostsReply, error)
-	SelectAggrPosts(context.Context, *SelectAggrPostsRequest) (*SelectAggrPostsReply, error)
-	PullTimeline(context.Context, *PullTimelineRequest) (*PullTimelineReply, error)
-	PushGrid(context.Context, *PushGridRequest) (*PushGridReply, error)
-	PullGrid(context.Context, *PullGridRequest) (*PullGridReply, error)
-	PushEvents(context.Context, *PushEventsRequest) (*PushEventsReply, error)
-	PullEvents(context.Context, *PullEventsRequest) (*PullEventsReply, error)
-	PullEventsTags(context.Context, *PullEventsTagsRequest) (*PullEventsTagsReply, error)
-	PushLocations(context.Context, *PushLocationsRequest) (*PushLocationsReply, error)
-	PullLocations(context.Context, *PullLocationsRequest) (*PullLocationsReply, error)
-	PullShortPostInInterval(context.Context, *PullShortPostInIntervalRequest) (*PullShortPostInIntervalReply, error)
-	PullSingleShortPost(context.Context, *PullSingleShortPostRequest) (*PullSingleShortPostReply, error)
+func (m *PullAlertsReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
 }
 
-// UnimplementedDataStorageServer can be embedded to have forward compatible implementations.
-type UnimplementedDataStorageServer struct {
+// messages for push of the crawler watermark, posts of the city published before the time of the
+// watermark are collected
+type PushWatermarkRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDataStorageServer) InsertCity(ctx context.Context, req *InsertCityRequest) (*InsertCityReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertCity not implemented")
+func (m *PushWatermarkRequest) Reset()         { *m = PushWatermarkRequest{} }
+func (m *PushWatermarkRequest) String() string { return proto.CompactTextString(m) }
+func (*PushWatermarkRequest) ProtoMessage()    {}
+func (*PushWatermarkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{36}
 }
-func (*UnimplementedDataStorageServer) GetAllCities(ctx context.Context, req *GetAllCitiesRequest) (*GetAllCitiesReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCities not implemented")
+func (m *PushWatermarkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDataStorageServer) GetCity(ctx context.Context, req *GetCityRequest) (*GetCityReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCity not implemented")
+func (m *PushWatermarkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushWatermarkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDataStorageServer) PushPosts(ctx context.Context, req *PushPostsRequest) (*PushPostsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushPosts not implemented")
+func (m *PushWatermarkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushWatermarkRequest.Merge(m, src)
 }
-func (*UnimplementedDataStorageServer) SelectPosts(ctx context.Context, req *SelectPostsRequest) (*SelectPostsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectPosts not implemented")
+func (m *PushWatermarkRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDataStorageServer) SelectAggrPosts(ctx context.Context, req *SelectAggrPostsRequest) (*SelectAggrPostsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectAggrPosts not implemented")
+func (m *PushWatermarkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushWatermarkRequest.DiscardUnknown(m)
 }
-func (*UnimplementedDataStorageServer) PullTimeline(ctx context.Context, req *PullTimelineRequest) (*PullTimelineReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullTimeline not implemented")
+
+var xxx_messageInfo_PushWatermarkRequest proto.InternalMessageInfo
+
+func (m *PushWatermarkRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
+	}
+	return ""
 }
-func (*UnimplementedDataStorageServer) PushGrid(ctx context.Context, req *PushGridRequest) (*PushGridReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushGrid not implemented")
+
+func (m *PushWatermarkRequest) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
-func (*UnimplementedDataStorageServer) PullGrid(ctx context.Context, req *PullGridRequest) (*PullGridReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullGrid not implemented")
+
+type PushWatermarkReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDataStorageServer) PushEvents(ctx context.Context, req *PushEventsRequest) (*PushEventsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushEvents not implemented")
+
+func (m *PushWatermarkReply) Reset()         { *m = PushWatermarkReply{} }
+func (m *PushWatermarkReply) String() string { return proto.CompactTextString(m) }
+func (*PushWatermarkReply) ProtoMessage()    {}
+func (*PushWatermarkReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{37}
 }
-func (*UnimplementedDataStorageServer) PullEvents(ctx context.Context, req *PullEventsRequest) (*PullEventsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullEvents not implemented")
+func (m *PushWatermarkReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDataStorageServer) PullEventsTags(ctx context.Context, req *PullEventsTagsRequest) (*PullEventsTagsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullEventsTags not implemented")
+func (m *PushWatermarkReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushWatermarkReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDataStorageServer) PushLocations(ctx context.Context, req *PushLocationsRequest) (*PushLocationsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushLocations not implemented")
+func (m *PushWatermarkReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushWatermarkReply.Merge(m, src)
 }
-func (*UnimplementedDataStorageServer) PullLocations(ctx context.Context, req *PullLocationsRequest) (*PullLocationsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullLocations not implemented")
+func (m *PushWatermarkReply) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDataStorageServer) PullShortPostInInterval(ctx context.Context, req *PullShortPostInIntervalRequest) (*PullShortPostInIntervalReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullShortPostInInterval not implemented")
+func (m *PushWatermarkReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushWatermarkReply.DiscardUnknown(m)
 }
-func (*UnimplementedDataStorageServer) PullSingleShortPost(ctx context.Context, req *PullSingleShortPostRequest) (*PullSingleShortPostReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PullSingleShortPost not implemented")
+
+var xxx_messageInfo_PushWatermarkReply proto.InternalMessageInfo
+
+func (m *PushWatermarkReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
 }
 
-func RegisterDataStorageServer(s *grpc.Server, srv DataStorageServer) {
-	s.RegisterService(&_DataStorage_serviceDesc, srv)
+type PushGridTasksRequest struct {
+	Tasks                []proto1.GridTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func _DataStorage_InsertCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertCityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).InsertCity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/InsertCity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).InsertCity(ctx, req.(*InsertCityRequest))
+func (m *PushGridTasksRequest) Reset()         { *m = PushGridTasksRequest{} }
+func (m *PushGridTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*PushGridTasksRequest) ProtoMessage()    {}
+func (*PushGridTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{38}
+}
+func (m *PushGridTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushGridTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushGridTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PushGridTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushGridTasksRequest.Merge(m, src)
+}
+func (m *PushGridTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushGridTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushGridTasksRequest.DiscardUnknown(m)
 }
 
-func _DataStorage_GetAllCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCitiesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).GetAllCities(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/GetAllCities",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).GetAllCities(ctx, req.(*GetAllCitiesRequest))
+var xxx_messageInfo_PushGridTasksRequest proto.InternalMessageInfo
+
+func (m *PushGridTasksRequest) GetTasks() []proto1.GridTask {
+	if m != nil {
+		return m.Tasks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DataStorage_GetCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).GetCity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/GetCity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).GetCity(ctx, req.(*GetCityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type PushGridTasksReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _DataStorage_PushPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushPostsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PushPosts(ctx, in)
+func (m *PushGridTasksReply) Reset()         { *m = PushGridTasksReply{} }
+func (m *PushGridTasksReply) String() string { return proto.CompactTextString(m) }
+func (*PushGridTasksReply) ProtoMessage()    {}
+func (*PushGridTasksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{39}
+}
+func (m *PushGridTasksReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushGridTasksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushGridTasksReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PushPosts",
+}
+func (m *PushGridTasksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushGridTasksReply.Merge(m, src)
+}
+func (m *PushGridTasksReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushGridTasksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushGridTasksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushGridTasksReply proto.InternalMessageInfo
+
+func (m *PushGridTasksReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PushPosts(ctx, req.(*PushPostsRequest))
+	return ""
+}
+
+type LeaseGridTaskRequest struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Lease                int64    `protobuf:"varint,2,opt,name=lease,proto3" json:"lease,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseGridTaskRequest) Reset()         { *m = LeaseGridTaskRequest{} }
+func (m *LeaseGridTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseGridTaskRequest) ProtoMessage()    {}
+func (*LeaseGridTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{40}
+}
+func (m *LeaseGridTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseGridTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseGridTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *LeaseGridTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseGridTaskRequest.Merge(m, src)
+}
+func (m *LeaseGridTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseGridTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseGridTaskRequest.DiscardUnknown(m)
 }
 
-func _DataStorage_SelectPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SelectPostsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_LeaseGridTaskRequest proto.InternalMessageInfo
+
+func (m *LeaseGridTaskRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).SelectPosts(ctx, in)
+	return ""
+}
+
+func (m *LeaseGridTaskRequest) GetLease() int64 {
+	if m != nil {
+		return m.Lease
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/SelectPosts",
+	return 0
+}
+
+type LeaseGridTaskReply struct {
+	Task                 *proto1.GridTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Err                  string           `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *LeaseGridTaskReply) Reset()         { *m = LeaseGridTaskReply{} }
+func (m *LeaseGridTaskReply) String() string { return proto.CompactTextString(m) }
+func (*LeaseGridTaskReply) ProtoMessage()    {}
+func (*LeaseGridTaskReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{41}
+}
+func (m *LeaseGridTaskReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseGridTaskReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseGridTaskReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).SelectPosts(ctx, req.(*SelectPostsRequest))
+}
+func (m *LeaseGridTaskReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseGridTaskReply.Merge(m, src)
+}
+func (m *LeaseGridTaskReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseGridTaskReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseGridTaskReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseGridTaskReply proto.InternalMessageInfo
+
+func (m *LeaseGridTaskReply) GetTask() *proto1.GridTask {
+	if m != nil {
+		return m.Task
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DataStorage_SelectAggrPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SelectAggrPostsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LeaseGridTaskReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).SelectAggrPosts(ctx, in)
+	return ""
+}
+
+type CompleteGridTaskRequest struct {
+	Session              string           `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Key                  int64            `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Owner                string           `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status               string           `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Posts                int64            `protobuf:"varint,5,opt,name=posts,proto3" json:"posts,omitempty"`
+	Error                string           `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CityId               string           `protobuf:"bytes,7,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Grids                map[int64][]byte `protobuf:"bytes,8,rep,name=grids,proto3" json:"grids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CompleteGridTaskRequest) Reset()         { *m = CompleteGridTaskRequest{} }
+func (m *CompleteGridTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteGridTaskRequest) ProtoMessage()    {}
+func (*CompleteGridTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{42}
+}
+func (m *CompleteGridTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompleteGridTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompleteGridTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/SelectAggrPosts",
+}
+func (m *CompleteGridTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteGridTaskRequest.Merge(m, src)
+}
+func (m *CompleteGridTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompleteGridTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteGridTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteGridTaskRequest proto.InternalMessageInfo
+
+func (m *CompleteGridTaskRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).SelectAggrPosts(ctx, req.(*SelectAggrPostsRequest))
+	return ""
+}
+
+func (m *CompleteGridTaskRequest) GetKey() int64 {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DataStorage_PullTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullTimelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CompleteGridTaskRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullTimeline(ctx, in)
+	return ""
+}
+
+func (m *CompleteGridTaskRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullTimeline",
+	return ""
+}
+
+func (m *CompleteGridTaskRequest) GetPosts() int64 {
+	if m != nil {
+		return m.Posts
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullTimeline(ctx, req.(*PullTimelineRequest))
+	return 0
+}
+
+func (m *CompleteGridTaskRequest) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DataStorage_PushGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushGridRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PushGrid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PushGrid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PushGrid(ctx, req.(*PushGridRequest))
+func (m *CompleteGridTaskRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DataStorage_PullGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullGridRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullGrid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullGrid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullGrid(ctx, req.(*PullGridRequest))
+func (m *CompleteGridTaskRequest) GetGrids() map[int64][]byte {
+	if m != nil {
+		return m.Grids
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DataStorage_PushEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushEventsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PushEvents(ctx, in)
+type CompleteGridTaskReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteGridTaskReply) Reset()         { *m = CompleteGridTaskReply{} }
+func (m *CompleteGridTaskReply) String() string { return proto.CompactTextString(m) }
+func (*CompleteGridTaskReply) ProtoMessage()    {}
+func (*CompleteGridTaskReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{43}
+}
+func (m *CompleteGridTaskReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompleteGridTaskReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompleteGridTaskReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PushEvents",
+}
+func (m *CompleteGridTaskReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteGridTaskReply.Merge(m, src)
+}
+func (m *CompleteGridTaskReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompleteGridTaskReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteGridTaskReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteGridTaskReply proto.InternalMessageInfo
+
+func (m *CompleteGridTaskReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PushEvents(ctx, req.(*PushEventsRequest))
+	return ""
+}
+
+type PullGridTasksStatusRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullGridTasksStatusRequest) Reset()         { *m = PullGridTasksStatusRequest{} }
+func (m *PullGridTasksStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*PullGridTasksStatusRequest) ProtoMessage()    {}
+func (*PullGridTasksStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{44}
+}
+func (m *PullGridTasksStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullGridTasksStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullGridTasksStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PullGridTasksStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullGridTasksStatusRequest.Merge(m, src)
+}
+func (m *PullGridTasksStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullGridTasksStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullGridTasksStatusRequest.DiscardUnknown(m)
 }
 
-func _DataStorage_PullEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullEventsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_PullGridTasksStatusRequest proto.InternalMessageInfo
+
+func (m *PullGridTasksStatusRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullEvents(ctx, in)
+	return ""
+}
+
+type PullGridTasksStatusReply struct {
+	Status               proto1.GridTasksStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	Err                  string                 `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PullGridTasksStatusReply) Reset()         { *m = PullGridTasksStatusReply{} }
+func (m *PullGridTasksStatusReply) String() string { return proto.CompactTextString(m) }
+func (*PullGridTasksStatusReply) ProtoMessage()    {}
+func (*PullGridTasksStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{45}
+}
+func (m *PullGridTasksStatusReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullGridTasksStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullGridTasksStatusReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullEvents",
+}
+func (m *PullGridTasksStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullGridTasksStatusReply.Merge(m, src)
+}
+func (m *PullGridTasksStatusReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullGridTasksStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullGridTasksStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullGridTasksStatusReply proto.InternalMessageInfo
+
+func (m *PullGridTasksStatusReply) GetStatus() proto1.GridTasksStatus {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullEvents(ctx, req.(*PullEventsRequest))
+	return proto1.GridTasksStatus{}
+}
+
+func (m *PullGridTasksStatusReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DataStorage_PullEventsTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullEventsTagsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type CancelGridTasksRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelGridTasksRequest) Reset()         { *m = CancelGridTasksRequest{} }
+func (m *CancelGridTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelGridTasksRequest) ProtoMessage()    {}
+func (*CancelGridTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{46}
+}
+func (m *CancelGridTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGridTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGridTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullEventsTags(ctx, in)
+}
+func (m *CancelGridTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGridTasksRequest.Merge(m, src)
+}
+func (m *CancelGridTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGridTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGridTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGridTasksRequest proto.InternalMessageInfo
+
+func (m *CancelGridTasksRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullEventsTags",
+	return ""
+}
+
+type CancelGridTasksReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelGridTasksReply) Reset()         { *m = CancelGridTasksReply{} }
+func (m *CancelGridTasksReply) String() string { return proto.CompactTextString(m) }
+func (*CancelGridTasksReply) ProtoMessage()    {}
+func (*CancelGridTasksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{47}
+}
+func (m *CancelGridTasksReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGridTasksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGridTasksReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullEventsTags(ctx, req.(*PullEventsTagsRequest))
+}
+func (m *CancelGridTasksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGridTasksReply.Merge(m, src)
+}
+func (m *CancelGridTasksReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGridTasksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGridTasksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGridTasksReply proto.InternalMessageInfo
+
+func (m *CancelGridTasksReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DataStorage_PushLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushLocationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type RenewGridTaskRequest struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Key                  int64    `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Lease                int64    `protobuf:"varint,4,opt,name=lease,proto3" json:"lease,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenewGridTaskRequest) Reset()         { *m = RenewGridTaskRequest{} }
+func (m *RenewGridTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewGridTaskRequest) ProtoMessage()    {}
+func (*RenewGridTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{48}
+}
+func (m *RenewGridTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenewGridTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenewGridTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PushLocations(ctx, in)
+}
+func (m *RenewGridTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewGridTaskRequest.Merge(m, src)
+}
+func (m *RenewGridTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenewGridTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewGridTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewGridTaskRequest proto.InternalMessageInfo
+
+func (m *RenewGridTaskRequest) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PushLocations",
+	return ""
+}
+
+func (m *RenewGridTaskRequest) GetKey() int64 {
+	if m != nil {
+		return m.Key
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PushLocations(ctx, req.(*PushLocationsRequest))
+	return 0
+}
+
+func (m *RenewGridTaskRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DataStorage_PullLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullLocationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RenewGridTaskRequest) GetLease() int64 {
+	if m != nil {
+		return m.Lease
 	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullLocations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullLocations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullLocations(ctx, req.(*PullLocationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _DataStorage_PullShortPostInInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullShortPostInIntervalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullShortPostInInterval(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullShortPostInInterval",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullShortPostInInterval(ctx, req.(*PullShortPostInIntervalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RenewGridTaskReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _DataStorage_PullSingleShortPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PullSingleShortPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataStorageServer).PullSingleShortPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.DataStorage/PullSingleShortPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataStorageServer).PullSingleShortPost(ctx, req.(*PullSingleShortPostRequest))
+func (m *RenewGridTaskReply) Reset()         { *m = RenewGridTaskReply{} }
+func (m *RenewGridTaskReply) String() string { return proto.CompactTextString(m) }
+func (*RenewGridTaskReply) ProtoMessage()    {}
+func (*RenewGridTaskReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{49}
+}
+func (m *RenewGridTaskReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenewGridTaskReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenewGridTaskReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _DataStorage_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.DataStorage",
-	HandlerType: (*DataStorageServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InsertCity",
-			Handler:    _DataStorage_InsertCity_Handler,
-		},
-		{
-			MethodName: "GetAllCities",
-			Handler:    _DataStorage_GetAllCities_Handler,
-		},
-		{
-			MethodName: "GetCity",
-			Handler:    _DataStorage_GetCity_Handler,
-		},
-		{
-			MethodName: "PushPosts",
-			Handler:    _DataStorage_PushPosts_Handler,
-		},
-		{
-			MethodName: "SelectPosts",
-			Handler:    _DataStorage_SelectPosts_Handler,
-		},
-		{
-			MethodName: "SelectAggrPosts",
-			Handler:    _DataStorage_SelectAggrPosts_Handler,
-		},
-		{
-			MethodName: "PullTimeline",
-			Handler:    _DataStorage_PullTimeline_Handler,
-		},
-		{
-			MethodName: "PushGrid",
-			Handler:    _DataStorage_PushGrid_Handler,
-		},
-		{
-			MethodName: "PullGrid",
-			Handler:    _DataStorage_PullGrid_Handler,
-		},
-		{
-			MethodName: "PushEvents",
-			Handler:    _DataStorage_PushEvents_Handler,
-		},
-		{
-			MethodName: "PullEvents",
-			Handler:    _DataStorage_PullEvents_Handler,
-		},
-		{
-			MethodName: "PullEventsTags",
-			Handler:    _DataStorage_PullEventsTags_Handler,
-		},
-		{
-			MethodName: "PushLocations",
-			Handler:    _DataStorage_PushLocations_Handler,
-		},
-		{
-			MethodName: "PullLocations",
-			Handler:    _DataStorage_PullLocations_Handler,
-		},
-		{
-			MethodName: "PullShortPostInInterval",
-			Handler:    _DataStorage_PullShortPostInInterval_Handler,
-		},
-		{
-			MethodName: "PullSingleShortPost",
-			Handler:    _DataStorage_PullSingleShortPost_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "data-storage/proto/data-storage.proto",
+func (m *RenewGridTaskReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewGridTaskReply.Merge(m, src)
+}
+func (m *RenewGridTaskReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenewGridTaskReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewGridTaskReply.DiscardUnknown(m)
 }
 
-func (m *InsertCityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RenewGridTaskReply proto.InternalMessageInfo
+
+func (m *RenewGridTaskReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InsertCityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PublishGridSetRequest replaces all grids of the city with the grids of the set with the ids and
+// the grids, the set is deleted.
+type PublishGridSetRequest struct {
+	CityId               string           `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Set                  string           `protobuf:"bytes,2,opt,name=set,proto3" json:"set,omitempty"`
+	Ids                  []int64          `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Grids                map[int64][]byte `protobuf:"bytes,4,rep,name=grids,proto3" json:"grids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *InsertCityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UpdateIfExists {
-		i--
-		if m.UpdateIfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.City.MarshalToSizedBuffer(dAtA[:i])
+func (m *PublishGridSetRequest) Reset()         { *m = PublishGridSetRequest{} }
+func (m *PublishGridSetRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishGridSetRequest) ProtoMessage()    {}
+func (*PublishGridSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{50}
+}
+func (m *PublishGridSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishGridSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishGridSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PublishGridSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishGridSetRequest.Merge(m, src)
+}
+func (m *PublishGridSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishGridSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishGridSetRequest.DiscardUnknown(m)
 }
 
-func (m *InsertCityReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PublishGridSetRequest proto.InternalMessageInfo
+
+func (m *PublishGridSetRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InsertCityReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PublishGridSetRequest) GetSet() string {
+	if m != nil {
+		return m.Set
+	}
+	return ""
 }
 
-func (m *InsertCityReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0xa
+func (m *PublishGridSetRequest) GetIds() []int64 {
+	if m != nil {
+		return m.Ids
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetAllCitiesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PublishGridSetRequest) GetGrids() map[int64][]byte {
+	if m != nil {
+		return m.Grids
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAllCitiesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PublishGridSetReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAllCitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (m *PublishGridSetReply) Reset()         { *m = PublishGridSetReply{} }
+func (m *PublishGridSetReply) String() string { return proto.CompactTextString(m) }
+func (*PublishGridSetReply) ProtoMessage()    {}
+func (*PublishGridSetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{51}
 }
-
-func (m *GetAllCitiesReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAllCitiesReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PublishGridSetReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetAllCitiesReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Cities) > 0 {
-		for iNdEx := len(m.Cities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PublishGridSetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishGridSetReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetCityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PublishGridSetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishGridSetReply.Merge(m, src)
 }
-
-func (m *GetCityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PublishGridSetReply) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetCityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PublishGridSetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishGridSetReply.DiscardUnknown(m)
 }
 
-func (m *GetCityReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PublishGridSetReply proto.InternalMessageInfo
+
+func (m *PublishGridSetReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetCityReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteGridSetRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Set                  string   `protobuf:"bytes,2,opt,name=set,proto3" json:"set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCityReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.City != nil {
-		{
-			size, err := m.City.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDataStorage(dAtA, i, uint64(size))
+func (m *DeleteGridSetRequest) Reset()         { *m = DeleteGridSetRequest{} }
+func (m *DeleteGridSetRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGridSetRequest) ProtoMessage()    {}
+func (*DeleteGridSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{52}
+}
+func (m *DeleteGridSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteGridSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteGridSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PushPostsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeleteGridSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGridSetRequest.Merge(m, src)
 }
-
-func (m *PushPostsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteGridSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteGridSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGridSetRequest.DiscardUnknown(m)
 }
 
-func (m *PushPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Posts) > 0 {
-		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_DeleteGridSetRequest proto.InternalMessageInfo
+
+func (m *DeleteGridSetRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PushPostsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteGridSetRequest) GetSet() string {
+	if m != nil {
+		return m.Set
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PushPostsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteGridSetReply struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PushPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0xa
+func (m *DeleteGridSetReply) Reset()         { *m = DeleteGridSetReply{} }
+func (m *DeleteGridSetReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteGridSetReply) ProtoMessage()    {}
+func (*DeleteGridSetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{53}
+}
+func (m *DeleteGridSetReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteGridSetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteGridSetReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteGridSetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGridSetReply.Merge(m, src)
+}
+func (m *DeleteGridSetReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteGridSetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGridSetReply.DiscardUnknown(m)
 }
 
-func (m *SelectPostsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteGridSetReply proto.InternalMessageInfo
+
+func (m *DeleteGridSetReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SelectPostsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PullShortPostInIntervalRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	StartTimestamp       int64    `protobuf:"varint,2,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
+	EndTimestamp         int64    `protobuf:"varint,3,opt,name=endTimestamp,proto3" json:"endTimestamp,omitempty"`
+	Shortcodes           []string `protobuf:"bytes,4,rep,name=shortcodes,proto3" json:"shortcodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SelectPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FinishTime != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.FinishTime))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.StartTime != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTime))
-		i--
-		dAtA[i] = 0x8
+func (m *PullShortPostInIntervalRequest) Reset()         { *m = PullShortPostInIntervalRequest{} }
+func (m *PullShortPostInIntervalRequest) String() string { return proto.CompactTextString(m) }
+func (*PullShortPostInIntervalRequest) ProtoMessage()    {}
+func (*PullShortPostInIntervalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{54}
+}
+func (m *PullShortPostInIntervalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullShortPostInIntervalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullShortPostInIntervalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PullShortPostInIntervalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullShortPostInIntervalRequest.Merge(m, src)
+}
+func (m *PullShortPostInIntervalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullShortPostInIntervalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullShortPostInIntervalRequest.DiscardUnknown(m)
 }
 
-func (m *SelectPostsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PullShortPostInIntervalRequest proto.InternalMessageInfo
+
+func (m *PullShortPostInIntervalRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SelectPostsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullShortPostInIntervalRequest) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
 }
 
-func (m *SelectPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Area != nil {
-		{
-			size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDataStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Posts) > 0 {
-		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *PullShortPostInIntervalRequest) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SelectAggrPostsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullShortPostInIntervalRequest) GetShortcodes() []string {
+	if m != nil {
+		return m.Shortcodes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SelectAggrPostsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PullShortPostInIntervalReply struct {
+	Posts                []proto1.ShortPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	Err                  string             `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *SelectAggrPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+func (m *PullShortPostInIntervalReply) Reset()         { *m = PullShortPostInIntervalReply{} }
+func (m *PullShortPostInIntervalReply) String() string { return proto.CompactTextString(m) }
+func (*PullShortPostInIntervalReply) ProtoMessage()    {}
+func (*PullShortPostInIntervalReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{55}
+}
+func (m *PullShortPostInIntervalReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullShortPostInIntervalReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullShortPostInIntervalReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *SelectAggrPostsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PullShortPostInIntervalReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullShortPostInIntervalReply.Merge(m, src)
 }
-
-func (m *SelectAggrPostsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullShortPostInIntervalReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullShortPostInIntervalReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullShortPostInIntervalReply.DiscardUnknown(m)
 }
 
-func (m *SelectAggrPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Posts) > 0 {
-		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_PullShortPostInIntervalReply proto.InternalMessageInfo
+
+func (m *PullShortPostInIntervalReply) GetPosts() []proto1.ShortPost {
+	if m != nil {
+		return m.Posts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PullTimelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullShortPostInIntervalReply) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PullTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PullSingleShortPostRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	Shortcode            string   `protobuf:"bytes,2,opt,name=shortcode,proto3" json:"shortcode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Finish != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.Finish))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Start != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.Start))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PullSingleShortPostRequest) Reset()         { *m = PullSingleShortPostRequest{} }
+func (m *PullSingleShortPostRequest) String() string { return proto.CompactTextString(m) }
+func (*PullSingleShortPostRequest) ProtoMessage()    {}
+func (*PullSingleShortPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{56}
 }
-
-func (m *PullTimelineReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullSingleShortPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullSingleShortPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullSingleShortPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PullTimelineReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullSingleShortPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullSingleShortPostRequest.Merge(m, src)
+}
+func (m *PullSingleShortPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullSingleShortPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullSingleShortPostRequest.DiscardUnknown(m)
 }
 
-func (m *PullTimelineReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Timeline) > 0 {
-		for iNdEx := len(m.Timeline) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Timeline[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_PullSingleShortPostRequest proto.InternalMessageInfo
+
+func (m *PullSingleShortPostRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PushGridRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PullSingleShortPostRequest) GetShortcode() string {
+	if m != nil {
+		return m.Shortcode
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PushGridRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PullSingleShortPostReply struct {
+	Post                 *proto1.ShortPost `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Err                  string            `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PushGridRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grids) > 0 {
-		for k := range m.Grids {
-			v := m.Grids[k]
-			baseI := i
-			if len(v) > 0 {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintDataStorage(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *PullSingleShortPostReply) Reset()         { *m = PullSingleShortPostReply{} }
+func (m *PullSingleShortPostReply) String() string { return proto.CompactTextString(m) }
+func (*PullSingleShortPostReply) ProtoMessage()    {}
+func (*PullSingleShortPostReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec0c2fba98f9a4b, []int{57}
+}
+func (m *PullSingleShortPostReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullSingleShortPostReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullSingleShortPostReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PushGridReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PullSingleShortPostReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullSingleShortPostReply.Merge(m, src)
 }
-
-func (m *PushGridReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullSingleShortPostReply) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PushGridReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PullSingleShortPostReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullSingleShortPostReply.DiscardUnknown(m)
 }
 
-func (m *PullGridRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PullSingleShortPostReply proto.InternalMessageInfo
+
+func (m *PullSingleShortPostReply) GetPost() *proto1.ShortPost {
+	if m != nil {
+		return m.Post
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PullGridRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PullSingleShortPostReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
 }
 
-func (m *PullGridRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Ids) > 0 {
-		dAtA6 := make([]byte, len(m.Ids)*10)
-		var j5 int
-		for _, num1 := range m.Ids {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintDataStorage(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*InsertCityRequest)(nil), "proto.InsertCityRequest")
+	proto.RegisterType((*InsertCityReply)(nil), "proto.InsertCityReply")
+	proto.RegisterType((*GetAllCitiesRequest)(nil), "proto.GetAllCitiesRequest")
+	proto.RegisterType((*GetAllCitiesReply)(nil), "proto.GetAllCitiesReply")
+	proto.RegisterType((*GetCityRequest)(nil), "proto.GetCityRequest")
+	proto.RegisterType((*GetCityReply)(nil), "proto.GetCityReply")
+	proto.RegisterType((*PushPostsRequest)(nil), "proto.PushPostsRequest")
+	proto.RegisterType((*PushPostsReply)(nil), "proto.PushPostsReply")
+	proto.RegisterType((*SelectPostsRequest)(nil), "proto.SelectPostsRequest")
+	proto.RegisterType((*SelectPostsReply)(nil), "proto.SelectPostsReply")
+	proto.RegisterType((*SelectAggrPostsRequest)(nil), "proto.SelectAggrPostsRequest")
+	proto.RegisterType((*SelectAggrPostsReply)(nil), "proto.SelectAggrPostsReply")
+	proto.RegisterType((*PullTimelineRequest)(nil), "proto.PullTimelineRequest")
+	proto.RegisterType((*PullTimelineReply)(nil), "proto.PullTimelineReply")
+	proto.RegisterType((*PushGridRequest)(nil), "proto.PushGridRequest")
+	proto.RegisterMapType((map[int64][]byte)(nil), "proto.PushGridRequest.GridsEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "proto.PushGridRequest.VersionsEntry")
+	proto.RegisterType((*PushGridReply)(nil), "proto.PushGridReply")
+	proto.RegisterType((*PullGridRequest)(nil), "proto.PullGridRequest")
+	proto.RegisterType((*PullGridReply)(nil), "proto.PullGridReply")
+	proto.RegisterMapType((map[int64][]byte)(nil), "proto.PullGridReply.GridsEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "proto.PullGridReply.VersionsEntry")
+	proto.RegisterType((*PushEventsRequest)(nil), "proto.PushEventsRequest")
+	proto.RegisterType((*PushEventsReply)(nil), "proto.PushEventsReply")
+	proto.RegisterType((*PullEventsRequest)(nil), "proto.PullEventsRequest")
+	proto.RegisterType((*PullEventsReply)(nil), "proto.PullEventsReply")
+	proto.RegisterType((*PullEventsTagsRequest)(nil), "proto.PullEventsTagsRequest")
+	proto.RegisterType((*PullEventsTagsReply)(nil), "proto.PullEventsTagsReply")
+	proto.RegisterType((*PushLocationsRequest)(nil), "proto.PushLocationsRequest")
+	proto.RegisterType((*PushLocationsReply)(nil), "proto.PushLocationsReply")
+	proto.RegisterType((*PullLocationsRequest)(nil), "proto.PullLocationsRequest")
+	proto.RegisterType((*PullLocationsReply)(nil), "proto.PullLocationsReply")
+	proto.RegisterType((*PushBotsRequest)(nil), "proto.PushBotsRequest")
+	proto.RegisterType((*PushBotsReply)(nil), "proto.PushBotsReply")
+	proto.RegisterType((*PullBotsRequest)(nil), "proto.PullBotsRequest")
+	proto.RegisterType((*PullBotsReply)(nil), "proto.PullBotsReply")
+	proto.RegisterType((*PushAlertsRequest)(nil), "proto.PushAlertsRequest")
+	proto.RegisterType((*PushAlertsReply)(nil), "proto.PushAlertsReply")
+	proto.RegisterType((*PullAlertsRequest)(nil), "proto.PullAlertsRequest")
+	proto.RegisterType((*PullAlertsReply)(nil), "proto.PullAlertsReply")
+	proto.RegisterType((*PushWatermarkRequest)(nil), "proto.PushWatermarkRequest")
+	proto.RegisterType((*PushWatermarkReply)(nil), "proto.PushWatermarkReply")
+	proto.RegisterType((*PushGridTasksRequest)(nil), "proto.PushGridTasksRequest")
+	proto.RegisterType((*PushGridTasksReply)(nil), "proto.PushGridTasksReply")
+	proto.RegisterType((*LeaseGridTaskRequest)(nil), "proto.LeaseGridTaskRequest")
+	proto.RegisterType((*LeaseGridTaskReply)(nil), "proto.LeaseGridTaskReply")
+	proto.RegisterType((*CompleteGridTaskRequest)(nil), "proto.CompleteGridTaskRequest")
+	proto.RegisterMapType((map[int64][]byte)(nil), "proto.CompleteGridTaskRequest.GridsEntry")
+	proto.RegisterType((*CompleteGridTaskReply)(nil), "proto.CompleteGridTaskReply")
+	proto.RegisterType((*PullGridTasksStatusRequest)(nil), "proto.PullGridTasksStatusRequest")
+	proto.RegisterType((*PullGridTasksStatusReply)(nil), "proto.PullGridTasksStatusReply")
+	proto.RegisterType((*CancelGridTasksRequest)(nil), "proto.CancelGridTasksRequest")
+	proto.RegisterType((*CancelGridTasksReply)(nil), "proto.CancelGridTasksReply")
+	proto.RegisterType((*RenewGridTaskRequest)(nil), "proto.RenewGridTaskRequest")
+	proto.RegisterType((*RenewGridTaskReply)(nil), "proto.RenewGridTaskReply")
+	proto.RegisterType((*PublishGridSetRequest)(nil), "proto.PublishGridSetRequest")
+	proto.RegisterMapType((map[int64][]byte)(nil), "proto.PublishGridSetRequest.GridsEntry")
+	proto.RegisterType((*PublishGridSetReply)(nil), "proto.PublishGridSetReply")
+	proto.RegisterType((*DeleteGridSetRequest)(nil), "proto.DeleteGridSetRequest")
+	proto.RegisterType((*DeleteGridSetReply)(nil), "proto.DeleteGridSetReply")
+	proto.RegisterType((*PullShortPostInIntervalRequest)(nil), "proto.PullShortPostInIntervalRequest")
+	proto.RegisterType((*PullShortPostInIntervalReply)(nil), "proto.PullShortPostInIntervalReply")
+	proto.RegisterType((*PullSingleShortPostRequest)(nil), "proto.PullSingleShortPostRequest")
+	proto.RegisterType((*PullSingleShortPostReply)(nil), "proto.PullSingleShortPostReply")
 }
 
-func (m *PullGridReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("data-storage/proto/data-storage.proto", fileDescriptor_8ec0c2fba98f9a4b)
+}
+
+var fileDescriptor_8ec0c2fba98f9a4b = []byte{
+	// 1837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x52, 0x1c, 0x47,
+	0x12, 0x66, 0xa6, 0x07, 0x04, 0xc9, 0xdf, 0xa8, 0x19, 0x86, 0xa1, 0x24, 0x0d, 0x52, 0x23, 0x21,
+	0xd8, 0x8d, 0x45, 0xbb, 0x28, 0x76, 0xa5, 0xd0, 0x86, 0x76, 0xf9, 0xd1, 0xcf, 0xb2, 0xa1, 0x95,
+	0xb4, 0x0d, 0xa1, 0x5d, 0xcb, 0xe1, 0x43, 0xc3, 0x94, 0x86, 0x36, 0xc5, 0xf4, 0xb8, 0xbb, 0x40,
+	0xe6, 0xe8, 0x27, 0xf0, 0xd5, 0x0f, 0xe0, 0xab, 0x2f, 0x7e, 0x0a, 0x1d, 0x7c, 0xf0, 0xc9, 0x47,
+	0x87, 0x43, 0x7e, 0x11, 0x47, 0xfd, 0x75, 0x57, 0x75, 0x57, 0x33, 0x48, 0x96, 0xc3, 0xa7, 0x99,
+	0xaa, 0xcc, 0xfa, 0xf2, 0xcb, 0xcc, 0xaa, 0xea, 0xcc, 0x82, 0x1b, 0x9d, 0x80, 0x06, 0x7f, 0x4a,
+	0x68, 0x14, 0x07, 0x5d, 0x7c, 0xab, 0x1f, 0x47, 0x34, 0xba, 0xa5, 0x4f, 0xad, 0xf2, 0x29, 0x77,
+	0x98, 0xff, 0xa0, 0x2b, 0x16, 0xed, 0x6e, 0xd4, 0x8d, 0x84, 0x16, 0xaa, 0x67, 0xeb, 0xc5, 0x8c,
+	0x17, 0xc0, 0xc5, 0xed, 0x5e, 0x82, 0x63, 0xba, 0x15, 0xd2, 0x53, 0x1f, 0x7f, 0x76, 0x8c, 0x13,
+	0xea, 0x5e, 0x87, 0xda, 0x7e, 0x48, 0x4f, 0x5b, 0x95, 0xab, 0x95, 0xe5, 0xf1, 0x35, 0x58, 0xe5,
+	0xfa, 0x4c, 0x61, 0xb3, 0xf6, 0xe6, 0xc7, 0x85, 0x21, 0x9f, 0x4b, 0xdd, 0x25, 0x98, 0x3a, 0xee,
+	0x77, 0x02, 0x8a, 0xb7, 0x5f, 0x3d, 0xfc, 0x3c, 0x4c, 0x68, 0xd2, 0xaa, 0x5e, 0xad, 0x2c, 0x8f,
+	0xfa, 0xb9, 0x59, 0x6f, 0x11, 0xa6, 0x75, 0x13, 0x7d, 0x72, 0xea, 0xd6, 0xc1, 0xc1, 0x71, 0xcc,
+	0xf1, 0xc7, 0x7c, 0xf6, 0xd7, 0x9b, 0x85, 0x99, 0xc7, 0x98, 0x6e, 0x10, 0xb2, 0x15, 0xd2, 0x10,
+	0x27, 0x92, 0x89, 0xf7, 0x0c, 0x2e, 0x9a, 0xd3, 0x6c, 0xf5, 0x32, 0x8c, 0xec, 0xf3, 0x61, 0xab,
+	0x72, 0xd5, 0xb1, 0x12, 0x94, 0x72, 0x65, 0xa7, 0x9a, 0xd9, 0x59, 0x86, 0xa9, 0xc7, 0xd8, 0x70,
+	0xb6, 0xc9, 0xd1, 0x4e, 0xb7, 0x3b, 0x92, 0x8e, 0x1c, 0x79, 0xeb, 0x30, 0x91, 0x6a, 0x32, 0xab,
+	0xed, 0xb2, 0xa0, 0xc8, 0x70, 0x14, 0x6d, 0xf9, 0x50, 0x7f, 0x7e, 0x9c, 0x1c, 0x3c, 0x8f, 0x12,
+	0xaa, 0x1c, 0x72, 0x97, 0x60, 0xb8, 0xcf, 0xc6, 0x26, 0x75, 0xa6, 0x22, 0xa9, 0x0b, 0xb1, 0xc6,
+	0xaa, 0x6a, 0xb0, 0xf2, 0x60, 0x4a, 0xc3, 0xb4, 0xc7, 0xf2, 0x53, 0x70, 0x77, 0x30, 0xc1, 0xfb,
+	0xd4, 0xb0, 0x7c, 0x19, 0xc6, 0x12, 0x1a, 0xc4, 0x74, 0x37, 0x3c, 0xc2, 0x5c, 0xdb, 0xf1, 0xb3,
+	0x09, 0xb7, 0x0d, 0xf0, 0x2a, 0xec, 0x85, 0xc9, 0x01, 0x17, 0x57, 0xb9, 0x58, 0x9b, 0xd1, 0xf8,
+	0x38, 0x06, 0x1f, 0x02, 0x75, 0xc3, 0x16, 0x63, 0x74, 0x5e, 0x1f, 0xdb, 0x50, 0x0b, 0x62, 0x1c,
+	0x70, 0x6b, 0xa9, 0xda, 0x46, 0x8c, 0x03, 0x9f, 0xcf, 0x2b, 0xcf, 0x9c, 0xcc, 0x33, 0x02, 0x4d,
+	0x61, 0x6d, 0xa3, 0xdb, 0x8d, 0x0d, 0xef, 0xee, 0xc1, 0x68, 0xd8, 0xa3, 0x38, 0x3e, 0x09, 0x88,
+	0xcc, 0x50, 0x4b, 0xe0, 0xed, 0xf4, 0x03, 0x1a, 0x46, 0xff, 0x8a, 0x8e, 0xe3, 0x6d, 0x29, 0x97,
+	0x24, 0x52, 0xfd, 0xd2, 0x58, 0xbf, 0x84, 0x46, 0xc1, 0x1a, 0xf3, 0xef, 0xcf, 0xa6, 0x7f, 0x0d,
+	0x49, 0xbc, 0xdb, 0x8d, 0x71, 0x37, 0xa0, 0xb8, 0x53, 0xf4, 0xb4, 0xb8, 0x37, 0x3e, 0x86, 0x99,
+	0xe7, 0xc7, 0x84, 0xb0, 0xd8, 0x92, 0xb0, 0x87, 0x07, 0x6c, 0x46, 0xb7, 0x01, 0xc3, 0x3c, 0x57,
+	0x32, 0x33, 0x62, 0xc0, 0xb4, 0x45, 0x8a, 0x78, 0x8c, 0x1c, 0x5f, 0x8e, 0xbc, 0xff, 0xc3, 0x45,
+	0x13, 0x9c, 0xb1, 0xfe, 0x0b, 0x8c, 0x52, 0x39, 0x21, 0x89, 0x4f, 0x0b, 0xe2, 0x4c, 0x2d, 0xa1,
+	0xc1, 0x51, 0x5f, 0x05, 0x46, 0xa9, 0x59, 0x68, 0x7f, 0x53, 0x85, 0x69, 0xb6, 0xff, 0x1e, 0xc7,
+	0x61, 0x47, 0x71, 0xbe, 0x03, 0xc3, 0xdd, 0x38, 0xec, 0xa8, 0x70, 0x5c, 0x13, 0x37, 0xcb, 0x6a,
+	0x4e, 0x6d, 0x95, 0xfd, 0x4f, 0x1e, 0xf6, 0x68, 0x7c, 0xea, 0x0b, 0xfd, 0xb2, 0xb8, 0xbb, 0xeb,
+	0x30, 0x7a, 0x82, 0xe3, 0x24, 0x8c, 0x7a, 0x49, 0xcb, 0xe1, 0x98, 0xd7, 0x4b, 0x30, 0x5f, 0x48,
+	0x35, 0x01, 0x9b, 0xae, 0x62, 0xc4, 0x13, 0x4c, 0x5b, 0x35, 0x41, 0x3c, 0xc1, 0x14, 0xdd, 0x05,
+	0xc8, 0x08, 0x30, 0xf9, 0x21, 0x3e, 0x95, 0xa7, 0x80, 0xfd, 0x65, 0x01, 0x3e, 0x09, 0xc8, 0xb1,
+	0xd8, 0xfa, 0x13, 0xbe, 0x18, 0xdc, 0xab, 0xde, 0xad, 0xa0, 0xbf, 0xc3, 0xa4, 0x61, 0x66, 0xd0,
+	0x62, 0x47, 0x5b, 0xec, 0x5d, 0x83, 0xc9, 0x8c, 0xb3, 0xfd, 0xb4, 0xfe, 0x87, 0x45, 0x94, 0x10,
+	0x3d, 0xa2, 0x75, 0x70, 0x54, 0x3c, 0x1d, 0xdf, 0x39, 0x2b, 0x54, 0xd2, 0x51, 0x27, 0x75, 0xd4,
+	0xfb, 0xb2, 0x0a, 0x93, 0x19, 0x1e, 0x33, 0xf9, 0x57, 0x33, 0x3f, 0x0b, 0x69, 0x2c, 0x35, 0x25,
+	0x4b, 0x76, 0x0a, 0xc9, 0x77, 0xff, 0x51, 0xc8, 0x8b, 0x67, 0xc5, 0x2a, 0xc9, 0xca, 0xef, 0x95,
+	0x83, 0x17, 0xec, 0x34, 0x24, 0x07, 0x0f, 0x4f, 0x70, 0x2f, 0xbb, 0x2f, 0x56, 0x60, 0x04, 0xf3,
+	0x09, 0x19, 0x95, 0x71, 0x71, 0x16, 0xb8, 0x92, 0xfa, 0x88, 0x08, 0x85, 0xd2, 0xeb, 0x61, 0x51,
+	0x1c, 0x05, 0x85, 0x6b, 0xcf, 0x6e, 0x57, 0x1c, 0x45, 0xd3, 0xf8, 0x6f, 0x71, 0x59, 0x3d, 0x85,
+	0x69, 0xdd, 0x10, 0x63, 0xf3, 0x0e, 0x3e, 0x16, 0x4f, 0xfa, 0x17, 0x15, 0x98, 0xcd, 0x00, 0x77,
+	0x83, 0x6e, 0x32, 0xe8, 0x8e, 0x72, 0xa1, 0x46, 0x83, 0x2e, 0xab, 0x02, 0x9c, 0xe5, 0x31, 0x9f,
+	0xff, 0x37, 0x3f, 0x3a, 0xce, 0xd9, 0x1f, 0x9d, 0x5a, 0xfe, 0xa3, 0xe3, 0xf9, 0x30, 0x93, 0xa7,
+	0xf0, 0xab, 0xfd, 0xda, 0x83, 0x06, 0xcb, 0xda, 0x93, 0x68, 0x9f, 0x85, 0xba, 0x37, 0xd0, 0xab,
+	0x35, 0x18, 0x23, 0x4a, 0x97, 0xbb, 0x36, 0xbe, 0x36, 0x25, 0xec, 0x29, 0x08, 0x69, 0x32, 0x53,
+	0xf3, 0x96, 0xc0, 0xcd, 0xd9, 0xb0, 0x6f, 0x8e, 0x55, 0xc6, 0x85, 0x90, 0xf3, 0x72, 0xf1, 0x5e,
+	0x82, 0x9b, 0xd3, 0x67, 0xb8, 0x06, 0xc3, 0xca, 0xb9, 0x18, 0x5a, 0xe2, 0xf2, 0x54, 0xec, 0xe6,
+	0xcd, 0x88, 0x0e, 0x0c, 0xc9, 0x22, 0xd4, 0xf6, 0x22, 0xaa, 0xa2, 0x31, 0x26, 0x6c, 0x6d, 0x46,
+	0x2a, 0xf6, 0x5c, 0xa8, 0x6e, 0x3e, 0x81, 0x67, 0x77, 0x7f, 0x45, 0x6c, 0xd9, 0x73, 0x98, 0xf4,
+	0x1e, 0xc1, 0x64, 0xa6, 0xca, 0xd0, 0x14, 0x87, 0xca, 0x19, 0x1c, 0x2c, 0x5e, 0xca, 0xbb, 0x60,
+	0x83, 0xe0, 0x78, 0xb0, 0x9f, 0x2b, 0x30, 0x12, 0x70, 0xc5, 0x56, 0x55, 0xdf, 0x67, 0x7c, 0xb1,
+	0xda, 0x67, 0x42, 0x41, 0xdd, 0x05, 0x0a, 0xd7, 0xee, 0xef, 0x47, 0xe2, 0x2e, 0x38, 0x9f, 0xf1,
+	0x77, 0xfb, 0xe2, 0xcb, 0xd3, 0xaf, 0xdb, 0xcf, 0xd8, 0x57, 0x06, 0xb0, 0xb7, 0xc4, 0x69, 0x53,
+	0x9c, 0x92, 0xff, 0x05, 0x14, 0xc7, 0x47, 0x41, 0x7c, 0x78, 0x9e, 0xb3, 0x9f, 0x15, 0x8e, 0xfc,
+	0xbf, 0x3a, 0x05, 0x1a, 0x86, 0x3d, 0x2c, 0xd2, 0x16, 0xfb, 0x34, 0xec, 0x06, 0xc9, 0x61, 0x1a,
+	0x99, 0x3f, 0xc0, 0x30, 0x65, 0x63, 0x73, 0x4f, 0x2b, 0x35, 0x55, 0x60, 0x71, 0x15, 0x65, 0x4b,
+	0xc3, 0x28, 0xb5, 0xf5, 0x04, 0x07, 0x09, 0x56, 0x8a, 0xca, 0x56, 0x03, 0x86, 0xa3, 0xd7, 0x3d,
+	0xac, 0x74, 0xc5, 0x80, 0xcd, 0x12, 0xa6, 0xad, 0x72, 0xc0, 0x07, 0xde, 0xbf, 0xc1, 0xcd, 0x61,
+	0x30, 0x5b, 0x1e, 0xbb, 0xfd, 0x92, 0x43, 0x79, 0x9f, 0xe7, 0xc8, 0xfa, 0x5c, 0x66, 0x89, 0xf3,
+	0xb7, 0x55, 0x98, 0xdb, 0x8a, 0x8e, 0xfa, 0x04, 0xd3, 0x02, 0xa7, 0x16, 0x5c, 0x48, 0x70, 0xc2,
+	0x3e, 0x7a, 0x92, 0x95, 0x1a, 0xaa, 0xaf, 0x5f, 0xd5, 0xf8, 0xfa, 0x09, 0xfe, 0x8e, 0xce, 0xbf,
+	0x09, 0x23, 0x09, 0x0d, 0xe8, 0x71, 0x22, 0x2b, 0x21, 0x39, 0x62, 0xda, 0xa2, 0x80, 0x1d, 0x16,
+	0x7e, 0xf1, 0x01, 0x9b, 0xc5, 0x71, 0x1c, 0xc5, 0xad, 0x11, 0x81, 0xc1, 0x07, 0x5a, 0xc6, 0x2f,
+	0x18, 0x19, 0xff, 0xa7, 0xaa, 0x2a, 0x46, 0x79, 0x76, 0x56, 0x64, 0x25, 0x50, 0xe2, 0x4c, 0xb1,
+	0xbe, 0x78, 0xff, 0x6a, 0xc0, 0x5b, 0x81, 0xd9, 0xa2, 0x19, 0x7b, 0xbe, 0xff, 0x06, 0x48, 0xd5,
+	0x26, 0x4c, 0x2d, 0xd9, 0xe1, 0x01, 0x18, 0x18, 0x61, 0x2f, 0x80, 0x96, 0x75, 0x1d, 0xb3, 0x72,
+	0x3b, 0x8d, 0xaa, 0xc8, 0xf5, 0xac, 0x99, 0x6b, 0xa9, 0xab, 0x8e, 0x98, 0x0c, 0x79, 0x31, 0xf5,
+	0x6b, 0xd0, 0xdc, 0x0a, 0x7a, 0xfb, 0x98, 0x14, 0x36, 0x7e, 0x39, 0xad, 0x65, 0x68, 0x14, 0xd6,
+	0xd8, 0x1d, 0xef, 0x41, 0xc3, 0xc7, 0x3d, 0xfc, 0xfa, 0xc3, 0x6f, 0xaa, 0xf4, 0x50, 0xd4, 0xf4,
+	0x43, 0xb1, 0x04, 0x6e, 0xce, 0x9e, 0x9d, 0xd7, 0x77, 0xbc, 0xac, 0xd8, 0x23, 0xa1, 0x38, 0xac,
+	0x3b, 0x98, 0x0e, 0xba, 0x5a, 0x64, 0x89, 0x5b, 0x4d, 0x4b, 0x5c, 0x55, 0x1e, 0x3b, 0x59, 0x79,
+	0x7c, 0x5f, 0x6d, 0xc6, 0x1a, 0xdf, 0x8c, 0x37, 0xd3, 0xb2, 0xd4, 0x62, 0xe8, 0x83, 0x6e, 0xc5,
+	0x9b, 0x30, 0x93, 0x37, 0x62, 0xf7, 0x7b, 0x1d, 0x1a, 0x0f, 0xb0, 0xda, 0xb1, 0xef, 0xe3, 0x35,
+	0x8b, 0x70, 0x0e, 0xc1, 0x6e, 0xe9, 0xeb, 0x0a, 0xb4, 0xd9, 0xde, 0xdd, 0x39, 0x88, 0x62, 0xde,
+	0x95, 0x6f, 0xf7, 0x54, 0x31, 0x39, 0xc8, 0xe8, 0x12, 0x4c, 0xa5, 0xc5, 0x19, 0xef, 0x08, 0xe5,
+	0x6e, 0xc8, 0xcd, 0xba, 0x1e, 0x4c, 0xe0, 0x5e, 0x27, 0xd3, 0x12, 0xdf, 0x22, 0x63, 0x8e, 0xd5,
+	0x76, 0x09, 0x63, 0xb0, 0x1f, 0x75, 0xb0, 0xc8, 0xcb, 0x98, 0xaf, 0xcd, 0x78, 0x9f, 0xc0, 0xe5,
+	0x52, 0x96, 0xcc, 0xb1, 0x3f, 0x9a, 0x4d, 0xb6, 0xec, 0x55, 0x53, 0xf5, 0x41, 0xfd, 0xb5, 0x2f,
+	0x0e, 0xfe, 0x4e, 0xd8, 0xeb, 0x12, 0x9c, 0xae, 0x1a, 0x14, 0x00, 0x56, 0xae, 0x2a, 0x8a, 0x12,
+	0x2d, 0x9b, 0xf0, 0xfe, 0x0b, 0x2d, 0x2b, 0xa6, 0xac, 0x47, 0x18, 0x15, 0x79, 0x25, 0xe4, 0xd9,
+	0xfa, 0x5c, 0x58, 0xa4, 0xb9, 0xf6, 0x43, 0x1d, 0xc6, 0x1f, 0x04, 0x34, 0xd8, 0x11, 0x2f, 0x76,
+	0xee, 0x3a, 0x40, 0xf6, 0x56, 0xe6, 0xb6, 0xe4, 0x3e, 0x2e, 0xbc, 0xd0, 0xa1, 0xa6, 0x45, 0xd2,
+	0x27, 0xa7, 0xde, 0x90, 0xfb, 0x08, 0x26, 0xf4, 0x17, 0x33, 0x17, 0x49, 0x4d, 0xcb, 0xeb, 0x1a,
+	0x6a, 0x59, 0x65, 0x02, 0xe7, 0x0e, 0x5c, 0x90, 0xcf, 0x5f, 0xee, 0x6c, 0xa6, 0xa6, 0x73, 0x98,
+	0xc9, 0x4f, 0x8b, 0x85, 0xf7, 0x61, 0x2c, 0x7d, 0xa1, 0x72, 0xe7, 0xb4, 0xc6, 0x5d, 0x7f, 0xaf,
+	0x41, 0xb3, 0x45, 0x81, 0x58, 0xbe, 0x05, 0xe3, 0xda, 0x83, 0x92, 0x3b, 0x2f, 0xf5, 0x8a, 0x0f,
+	0x5a, 0x68, 0xce, 0x26, 0x12, 0x20, 0xcf, 0x60, 0x3a, 0xf7, 0x72, 0xe3, 0x5e, 0x31, 0xb4, 0xf3,
+	0xef, 0x47, 0xe8, 0x52, 0x99, 0x38, 0x8d, 0xaa, 0xfe, 0xa2, 0x92, 0x46, 0xd5, 0xf2, 0x86, 0x83,
+	0x5a, 0x56, 0x99, 0xc0, 0xb9, 0x07, 0xa3, 0xaa, 0x4e, 0x71, 0x9b, 0xf6, 0x47, 0x0d, 0xd4, 0x28,
+	0xcc, 0x6b, 0x6b, 0x09, 0xc9, 0xad, 0x25, 0xc4, 0xbe, 0x56, 0x6b, 0xc8, 0xbd, 0x21, 0xb6, 0xaf,
+	0xb2, 0x5e, 0xd5, 0x6d, 0x69, 0x16, 0x8c, 0xce, 0x14, 0x35, 0x2d, 0x12, 0x0d, 0x81, 0x90, 0x02,
+	0x02, 0x21, 0x65, 0x08, 0x46, 0x33, 0xea, 0x0d, 0xb9, 0x4f, 0x60, 0x2a, 0x9b, 0xdc, 0xe5, 0xdd,
+	0x61, 0x41, 0x57, 0xeb, 0x33, 0x11, 0x2a, 0x91, 0x0a, 0xb4, 0x6d, 0xd1, 0x5f, 0xa4, 0xbd, 0x90,
+	0x7b, 0x49, 0xa3, 0x9e, 0xef, 0xa8, 0xd0, 0xbc, 0x5d, 0xa8, 0x41, 0x11, 0x62, 0x83, 0x22, 0xe4,
+	0x0c, 0xa8, 0x7c, 0x27, 0x96, 0xe5, 0x97, 0xf5, 0x29, 0x46, 0x7e, 0xb5, 0x1e, 0x07, 0x35, 0x0a,
+	0xf3, 0x46, 0x7e, 0x73, 0x6b, 0x09, 0xb1, 0xaf, 0xd5, 0x9a, 0xa1, 0x2c, 0xbf, 0xa2, 0xfe, 0x37,
+	0xf2, 0x6b, 0x74, 0x1b, 0xa8, 0x69, 0x91, 0x18, 0xf9, 0x2d, 0x20, 0x10, 0x52, 0x86, 0x60, 0xb4,
+	0x1b, 0x59, 0x46, 0xd2, 0x7a, 0xdf, 0xc8, 0x48, 0xbe, 0x93, 0x40, 0xf3, 0x76, 0xa1, 0x01, 0x95,
+	0x56, 0x39, 0x06, 0x54, 0xbe, 0x5e, 0x42, 0xf3, 0x76, 0x61, 0x0a, 0x65, 0x54, 0xeb, 0x29, 0x94,
+	0xad, 0x0f, 0x40, 0xf3, 0x76, 0xa1, 0x80, 0xf2, 0xa1, 0x9e, 0xaf, 0x3b, 0xdd, 0xf6, 0xd9, 0x75,
+	0x2f, 0xba, 0x5c, 0x2a, 0x17, 0x98, 0xf2, 0x1d, 0x38, 0x57, 0x3c, 0xba, 0xd7, 0x72, 0xe7, 0xb8,
+	0x58, 0xbc, 0xa2, 0x85, 0xb3, 0x54, 0xd2, 0x6b, 0x30, 0x57, 0x2e, 0xa6, 0xd7, 0xa0, 0xbd, 0xf4,
+	0x44, 0x97, 0xca, 0xc4, 0x69, 0x30, 0x8d, 0x2a, 0x2f, 0x0d, 0xa6, 0xad, 0xd6, 0x44, 0xf3, 0x76,
+	0xa1, 0x76, 0x1b, 0xe8, 0x95, 0x93, 0x76, 0x1b, 0x58, 0xaa, 0x36, 0x84, 0x4a, 0xa4, 0x29, 0x31,
+	0xa3, 0x38, 0x4a, 0x89, 0xd9, 0x8a, 0x2e, 0x34, 0x6f, 0x17, 0x0a, 0xa8, 0x2e, 0xcc, 0x95, 0x14,
+	0x26, 0xee, 0x0d, 0x2d, 0xe4, 0xe5, 0xe5, 0x15, 0x5a, 0x1c, 0xa4, 0x66, 0xa4, 0x3e, 0x57, 0x4e,
+	0x18, 0xa9, 0xb7, 0x97, 0x2f, 0x68, 0xe1, 0x2c, 0x15, 0x0e, 0xbe, 0x59, 0x7f, 0xf3, 0xb6, 0x5d,
+	0xf9, 0xfe, 0x6d, 0xbb, 0xf2, 0xd3, 0xdb, 0x76, 0xe5, 0xab, 0x9f, 0xdb, 0x43, 0x7b, 0x23, 0x7c,
+	0xcd, 0xed, 0x5f, 0x06, 0x00, 0x79, 0xd6, 0x2d, 0xea, 0x51, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataStorageClient is the client API for DataStorage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataStorageClient interface {
+	InsertCity(ctx context.Context, in *InsertCityRequest, opts ...grpc.CallOption) (*InsertCityReply, error)
+	GetAllCities(ctx context.Context, in *GetAllCitiesRequest, opts ...grpc.CallOption) (*GetAllCitiesReply, error)
+	GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*GetCityReply, error)
+	PushPosts(ctx context.Context, in *PushPostsRequest, opts ...grpc.CallOption) (*PushPostsReply, error)
+	SelectPosts(ctx context.Context, in *SelectPostsRequest, opts ...grpc.CallOption) (*SelectPostsReply, error)
+	SelectAggrPosts(ctx context.Context, in *SelectAggrPostsRequest, opts ...grpc.CallOption) (*SelectAggrPostsReply, error)
+	PullTimeline(ctx context.Context, in *PullTimelineRequest, opts ...grpc.CallOption) (*PullTimelineReply, error)
+	PushGrid(ctx context.Context, in *PushGridRequest, opts ...grpc.CallOption) (*PushGridReply, error)
+	PullGrid(ctx context.Context, in *PullGridRequest, opts ...grpc.CallOption) (*PullGridReply, error)
+	PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsReply, error)
+	PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (*PullEventsReply, error)
+	PullEventsTags(ctx context.Context, in *PullEventsTagsRequest, opts ...grpc.CallOption) (*PullEventsTagsReply, error)
+	PushLocations(ctx context.Context, in *PushLocationsRequest, opts ...grpc.CallOption) (*PushLocationsReply, error)
+	PullLocations(ctx context.Context, in *PullLocationsRequest, opts ...grpc.CallOption) (*PullLocationsReply, error)
+	PushBots(ctx context.Context, in *PushBotsRequest, opts ...grpc.CallOption) (*PushBotsReply, error)
+	PullBots(ctx context.Context, in *PullBotsRequest, opts ...grpc.CallOption) (*PullBotsReply, error)
+	PushAlerts(ctx context.Context, in *PushAlertsRequest, opts ...grpc.CallOption) (*PushAlertsReply, error)
+	PullAlerts(ctx context.Context, in *PullAlertsRequest, opts ...grpc.CallOption) (*PullAlertsReply, error)
+	PushWatermark(ctx context.Context, in *PushWatermarkRequest, opts ...grpc.CallOption) (*PushWatermarkReply, error)
+	PushGridTasks(ctx context.Context, in *PushGridTasksRequest, opts ...grpc.CallOption) (*PushGridTasksReply, error)
+	LeaseGridTask(ctx context.Context, in *LeaseGridTaskRequest, opts ...grpc.CallOption) (*LeaseGridTaskReply, error)
+	CompleteGridTask(ctx context.Context, in *CompleteGridTaskRequest, opts ...grpc.CallOption) (*CompleteGridTaskReply, error)
+	PullGridTasksStatus(ctx context.Context, in *PullGridTasksStatusRequest, opts ...grpc.CallOption) (*PullGridTasksStatusReply, error)
+	CancelGridTasks(ctx context.Context, in *CancelGridTasksRequest, opts ...grpc.CallOption) (*CancelGridTasksReply, error)
+	RenewGridTask(ctx context.Context, in *RenewGridTaskRequest, opts ...grpc.CallOption) (*RenewGridTaskReply, error)
+	PublishGridSet(ctx context.Context, in *PublishGridSetRequest, opts ...grpc.CallOption) (*PublishGridSetReply, error)
+	DeleteGridSet(ctx context.Context, in *DeleteGridSetRequest, opts ...grpc.CallOption) (*DeleteGridSetReply, error)
+	PullShortPostInInterval(ctx context.Context, in *PullShortPostInIntervalRequest, opts ...grpc.CallOption) (*PullShortPostInIntervalReply, error)
+	PullSingleShortPost(ctx context.Context, in *PullSingleShortPostRequest, opts ...grpc.CallOption) (*PullSingleShortPostReply, error)
+}
+
+type dataStorageClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataStorageClient(cc *grpc.ClientConn) DataStorageClient {
+	return &dataStorageClient{cc}
+}
+
+func (c *dataStorageClient) InsertCity(ctx context.Context, in *InsertCityRequest, opts ...grpc.CallOption) (*InsertCityReply, error) {
+	out := new(InsertCityReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/InsertCity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullGridReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) GetAllCities(ctx context.Context, in *GetAllCitiesRequest, opts ...grpc.CallOption) (*GetAllCitiesReply, error) {
+	out := new(GetAllCitiesReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/GetAllCities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PullGridReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grids) > 0 {
-		for k := range m.Grids {
-			v := m.Grids[k]
-			baseI := i
-			if len(v) > 0 {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintDataStorage(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataStorageClient) GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*GetCityReply, error) {
+	out := new(GetCityReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/GetCity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushPosts(ctx context.Context, in *PushPostsRequest, opts ...grpc.CallOption) (*PushPostsReply, error) {
+	out := new(PushPostsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushPosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) SelectPosts(ctx context.Context, in *SelectPostsRequest, opts ...grpc.CallOption) (*SelectPostsReply, error) {
+	out := new(SelectPostsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/SelectPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PushEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataStorageClient) SelectAggrPosts(ctx context.Context, in *SelectAggrPostsRequest, opts ...grpc.CallOption) (*SelectAggrPostsReply, error) {
+	out := new(SelectAggrPostsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/SelectAggrPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushEventsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PullTimeline(ctx context.Context, in *PullTimelineRequest, opts ...grpc.CallOption) (*PullTimelineReply, error) {
+	out := new(PullTimelineReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullTimeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushEventsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushGrid(ctx context.Context, in *PushGridRequest, opts ...grpc.CallOption) (*PushGridReply, error) {
+	out := new(PushGridReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushGrid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PushEventsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0xa
+func (c *dataStorageClient) PullGrid(ctx context.Context, in *PullGridRequest, opts ...grpc.CallOption) (*PullGridReply, error) {
+	out := new(PullGridReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullGrid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PullEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsReply, error) {
+	out := new(PushEventsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushEvents", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+func (c *dataStorageClient) PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (*PullEventsReply, error) {
+	out := new(PullEventsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PullEventsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PullEventsTags(ctx context.Context, in *PullEventsTagsRequest, opts ...grpc.CallOption) (*PullEventsTagsReply, error) {
+	out := new(PullEventsTagsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullEventsTags", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullEventsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushLocations(ctx context.Context, in *PushLocationsRequest, opts ...grpc.CallOption) (*PushLocationsReply, error) {
+	out := new(PushLocationsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PullEventsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataStorageClient) PullLocations(ctx context.Context, in *PullLocationsRequest, opts ...grpc.CallOption) (*PullLocationsReply, error) {
+	out := new(PullLocationsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PullEventsTagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushBots(ctx context.Context, in *PushBotsRequest, opts ...grpc.CallOption) (*PushBotsReply, error) {
+	out := new(PushBotsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushBots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullEventsTagsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PullBots(ctx context.Context, in *PullBotsRequest, opts ...grpc.CallOption) (*PullBotsReply, error) {
+	out := new(PullBotsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullBots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PullEventsTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.FinishTime != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.FinishTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartTime != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTime))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tags[iNdEx])
-			copy(dAtA[i:], m.Tags[iNdEx])
-			i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Tags[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
+func (c *dataStorageClient) PushAlerts(ctx context.Context, in *PushAlertsRequest, opts ...grpc.CallOption) (*PushAlertsReply, error) {
+	out := new(PushAlertsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PullEventsTagsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PullAlerts(ctx context.Context, in *PullAlertsRequest, opts ...grpc.CallOption) (*PullAlertsReply, error) {
+	out := new(PullAlertsReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullAlerts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullEventsTagsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PushWatermark(ctx context.Context, in *PushWatermarkRequest, opts ...grpc.CallOption) (*PushWatermarkReply, error) {
+	out := new(PushWatermarkReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushWatermark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PullEventsTagsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataStorageClient) PushGridTasks(ctx context.Context, in *PushGridTasksRequest, opts ...grpc.CallOption) (*PushGridTasksReply, error) {
+	out := new(PushGridTasksReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PushGridTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushLocationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) LeaseGridTask(ctx context.Context, in *LeaseGridTaskRequest, opts ...grpc.CallOption) (*LeaseGridTaskReply, error) {
+	out := new(LeaseGridTaskReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/LeaseGridTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushLocationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) CompleteGridTask(ctx context.Context, in *CompleteGridTaskRequest, opts ...grpc.CallOption) (*CompleteGridTaskReply, error) {
+	out := new(CompleteGridTaskReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/CompleteGridTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PushLocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *dataStorageClient) PullGridTasksStatus(ctx context.Context, in *PullGridTasksStatusRequest, opts ...grpc.CallOption) (*PullGridTasksStatusReply, error) {
+	out := new(PullGridTasksStatusReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullGridTasksStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return out, nil
+}
+
+func (c *dataStorageClient) CancelGridTasks(ctx context.Context, in *CancelGridTasksRequest, opts ...grpc.CallOption) (*CancelGridTasksReply, error) {
+	out := new(CancelGridTasksReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/CancelGridTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *dataStorageClient) RenewGridTask(ctx context.Context, in *RenewGridTaskRequest, opts ...grpc.CallOption) (*RenewGridTaskReply, error) {
+	out := new(RenewGridTaskReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/RenewGridTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PushLocationsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PublishGridSet(ctx context.Context, in *PublishGridSetRequest, opts ...grpc.CallOption) (*PublishGridSetReply, error) {
+	out := new(PublishGridSetReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PublishGridSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PushLocationsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) DeleteGridSet(ctx context.Context, in *DeleteGridSetRequest, opts ...grpc.CallOption) (*DeleteGridSetReply, error) {
+	out := new(DeleteGridSetReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/DeleteGridSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PushLocationsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0xa
+func (c *dataStorageClient) PullShortPostInInterval(ctx context.Context, in *PullShortPostInIntervalRequest, opts ...grpc.CallOption) (*PullShortPostInIntervalReply, error) {
+	out := new(PullShortPostInIntervalReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullShortPostInInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PullLocationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataStorageClient) PullSingleShortPost(ctx context.Context, in *PullSingleShortPostRequest, opts ...grpc.CallOption) (*PullSingleShortPostReply, error) {
+	out := new(PullSingleShortPostReply)
+	err := c.cc.Invoke(ctx, "/proto.DataStorage/PullSingleShortPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PullLocationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DataStorageServer is the server API for DataStorage service.
+type DataStorageServer interface {
+	InsertCity(context.Context, *InsertCityRequest) (*InsertCityReply, error)
+	GetAllCities(context.Context, *GetAllCitiesRequest) (*GetAllCitiesReply, error)
+	GetCity(context.Context, *GetCityRequest) (*GetCityReply, error)
+	PushPosts(context.Context, *PushPostsRequest) (*PushPostsReply, error)
+	SelectPosts(context.Context, *SelectPostsRequest) (*SelectPostsReply, error)
+	SelectAggrPosts(context.Context, *SelectAggrPostsRequest) (*SelectAggrPostsReply, error)
+	PullTimeline(context.Context, *PullTimelineRequest) (*PullTimelineReply, error)
+	PushGrid(context.Context, *PushGridRequest) (*PushGridReply, error)
+	PullGrid(context.Context, *PullGridRequest) (*PullGridReply, error)
+	PushEvents(context.Context, *PushEventsRequest) (*PushEventsReply, error)
+	PullEvents(context.Context, *PullEventsRequest) (*PullEventsReply, error)
+	PullEventsTags(context.Context, *PullEventsTagsRequest) (*PullEventsTagsReply, error)
+	PushLocations(context.Context, *PushLocationsRequest) (*PushLocationsReply, error)
+	PullLocations(context.Context, *PullLocationsRequest) (*PullLocationsReply, error)
+	PushBots(context.Context, *PushBotsRequest) (*PushBotsReply, error)
+	PullBots(context.Context, *PullBotsRequest) (*PullBotsReply, error)
+	PushAlerts(context.Context, *PushAlertsRequest) (*PushAlertsReply, error)
+	PullAlerts(context.Context, *PullAlertsRequest) (*PullAlertsReply, error)
+	PushWatermark(context.Context, *PushWatermarkRequest) (*PushWatermarkReply, error)
+	PushGridTasks(context.Context, *PushGridTasksRequest) (*PushGridTasksReply, error)
+	LeaseGridTask(context.Context, *LeaseGridTaskRequest) (*LeaseGridTaskReply, error)
+	CompleteGridTask(context.Context, *CompleteGridTaskRequest) (*CompleteGridTaskReply, error)
+	PullGridTasksStatus(context.Context, *PullGridTasksStatusRequest) (*PullGridTasksStatusReply, error)
+	CancelGridTasks(context.Context, *CancelGridTasksRequest) (*CancelGridTasksReply, error)
+	RenewGridTask(context.Context, *RenewGridTaskRequest) (*RenewGridTaskReply, error)
+	PublishGridSet(context.Context, *PublishGridSetRequest) (*PublishGridSetReply, error)
+	DeleteGridSet(context.Context, *DeleteGridSetRequest) (*DeleteGridSetReply, error)
+	PullShortPostInInterval(context.Context, *PullShortPostInIntervalRequest) (*PullShortPostInIntervalReply, error)
+	PullSingleShortPost(context.Context, *PullSingleShortPostRequest) (*PullSingleShortPostReply, error)
 }
 
-func (m *PullLocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedDataStorageServer can be embedded to have forward compatible implementations.
+type UnimplementedDataStorageServer struct {
 }
 
-func (m *PullLocationsReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedDataStorageServer) InsertCity(ctx context.Context, req *InsertCityRequest) (*InsertCityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertCity not implemented")
+}
+func (*UnimplementedDataStorageServer) GetAllCities(ctx context.Context, req *GetAllCitiesRequest) (*GetAllCitiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCities not implemented")
+}
+func (*UnimplementedDataStorageServer) GetCity(ctx context.Context, req *GetCityRequest) (*GetCityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCity not implemented")
+}
+func (*UnimplementedDataStorageServer) PushPosts(ctx context.Context, req *PushPostsRequest) (*PushPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPosts not implemented")
+}
+func (*UnimplementedDataStorageServer) SelectPosts(ctx context.Context, req *SelectPostsRequest) (*SelectPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectPosts not implemented")
+}
+func (*UnimplementedDataStorageServer) SelectAggrPosts(ctx context.Context, req *SelectAggrPostsRequest) (*SelectAggrPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectAggrPosts not implemented")
+}
+func (*UnimplementedDataStorageServer) PullTimeline(ctx context.Context, req *PullTimelineRequest) (*PullTimelineReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullTimeline not implemented")
+}
+func (*UnimplementedDataStorageServer) PushGrid(ctx context.Context, req *PushGridRequest) (*PushGridReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushGrid not implemented")
+}
+func (*UnimplementedDataStorageServer) PullGrid(ctx context.Context, req *PullGridRequest) (*PullGridReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullGrid not implemented")
+}
+func (*UnimplementedDataStorageServer) PushEvents(ctx context.Context, req *PushEventsRequest) (*PushEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushEvents not implemented")
+}
+func (*UnimplementedDataStorageServer) PullEvents(ctx context.Context, req *PullEventsRequest) (*PullEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullEvents not implemented")
+}
+func (*UnimplementedDataStorageServer) PullEventsTags(ctx context.Context, req *PullEventsTagsRequest) (*PullEventsTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullEventsTags not implemented")
+}
+func (*UnimplementedDataStorageServer) PushLocations(ctx context.Context, req *PushLocationsRequest) (*PushLocationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushLocations not implemented")
+}
+func (*UnimplementedDataStorageServer) PullLocations(ctx context.Context, req *PullLocationsRequest) (*PullLocationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullLocations not implemented")
+}
+func (*UnimplementedDataStorageServer) PushBots(ctx context.Context, req *PushBotsRequest) (*PushBotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushBots not implemented")
+}
+func (*UnimplementedDataStorageServer) PullBots(ctx context.Context, req *PullBotsRequest) (*PullBotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullBots not implemented")
+}
+func (*UnimplementedDataStorageServer) PushAlerts(ctx context.Context, req *PushAlertsRequest) (*PushAlertsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushAlerts not implemented")
+}
+func (*UnimplementedDataStorageServer) PullAlerts(ctx context.Context, req *PullAlertsRequest) (*PullAlertsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullAlerts not implemented")
+}
+func (*UnimplementedDataStorageServer) PushWatermark(ctx context.Context, req *PushWatermarkRequest) (*PushWatermarkReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushWatermark not implemented")
+}
+func (*UnimplementedDataStorageServer) PushGridTasks(ctx context.Context, req *PushGridTasksRequest) (*PushGridTasksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushGridTasks not implemented")
+}
+func (*UnimplementedDataStorageServer) LeaseGridTask(ctx context.Context, req *LeaseGridTaskRequest) (*LeaseGridTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseGridTask not implemented")
+}
+func (*UnimplementedDataStorageServer) CompleteGridTask(ctx context.Context, req *CompleteGridTaskRequest) (*CompleteGridTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteGridTask not implemented")
+}
+func (*UnimplementedDataStorageServer) PullGridTasksStatus(ctx context.Context, req *PullGridTasksStatusRequest) (*PullGridTasksStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullGridTasksStatus not implemented")
+}
+func (*UnimplementedDataStorageServer) CancelGridTasks(ctx context.Context, req *CancelGridTasksRequest) (*CancelGridTasksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGridTasks not implemented")
+}
+func (*UnimplementedDataStorageServer) RenewGridTask(ctx context.Context, req *RenewGridTaskRequest) (*RenewGridTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewGridTask not implemented")
+}
+func (*UnimplementedDataStorageServer) PublishGridSet(ctx context.Context, req *PublishGridSetRequest) (*PublishGridSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishGridSet not implemented")
+}
+func (*UnimplementedDataStorageServer) DeleteGridSet(ctx context.Context, req *DeleteGridSetRequest) (*DeleteGridSetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGridSet not implemented")
+}
+func (*UnimplementedDataStorageServer) PullShortPostInInterval(ctx context.Context, req *PullShortPostInIntervalRequest) (*PullShortPostInIntervalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullShortPostInInterval not implemented")
+}
+func (*UnimplementedDataStorageServer) PullSingleShortPost(ctx context.Context, req *PullSingleShortPostRequest) (*PullSingleShortPostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullSingleShortPost not implemented")
 }
 
-func (m *PullLocationsReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterDataStorageServer(s *grpc.Server, srv DataStorageServer) {
+	s.RegisterService(&_DataStorage_serviceDesc, srv)
 }
 
-func (m *PullLocationsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _DataStorage_InsertCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(DataStorageServer).InsertCity(ctx, in)
 	}
-	if len(m.Locations) > 0 {
-		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/InsertCity",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).InsertCity(ctx, req.(*InsertCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullShortPostInIntervalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataStorage_GetAllCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCitiesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullShortPostInIntervalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(DataStorageServer).GetAllCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/GetAllCities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).GetAllCities(ctx, req.(*GetAllCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullShortPostInIntervalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Shortcodes) > 0 {
-		for iNdEx := len(m.Shortcodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Shortcodes[iNdEx])
-			copy(dAtA[i:], m.Shortcodes[iNdEx])
-			i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Shortcodes[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _DataStorage_GetCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EndTimestamp != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.EndTimestamp))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(DataStorageServer).GetCity(ctx, in)
 	}
-	if m.StartTimestamp != 0 {
-		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTimestamp))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/GetCity",
 	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).GetCity(ctx, req.(*GetCityRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullShortPostInIntervalReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _DataStorage_PushPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullShortPostInIntervalReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullShortPostInIntervalReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushPosts(ctx, in)
 	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushPosts",
 	}
-	if len(m.Posts) > 0 {
-		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDataStorage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushPosts(ctx, req.(*PushPostsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullSingleShortPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataStorage_SelectPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectPostsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullSingleShortPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullSingleShortPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DataStorageServer).SelectPosts(ctx, in)
 	}
-	if len(m.Shortcode) > 0 {
-		i -= len(m.Shortcode)
-		copy(dAtA[i:], m.Shortcode)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Shortcode)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/SelectPosts",
 	}
-	if len(m.CityId) > 0 {
-		i -= len(m.CityId)
-		copy(dAtA[i:], m.CityId)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).SelectPosts(ctx, req.(*SelectPostsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullSingleShortPostReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataStorage_SelectAggrPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectAggrPostsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PullSingleShortPostReply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PullSingleShortPostReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DataStorageServer).SelectAggrPosts(ctx, in)
 	}
-	if len(m.Err) > 0 {
-		i -= len(m.Err)
-		copy(dAtA[i:], m.Err)
-		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/SelectAggrPosts",
 	}
-	if m.Post != nil {
-		{
-			size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDataStorage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).SelectAggrPosts(ctx, req.(*SelectAggrPostsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintDataStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDataStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _DataStorage_PullTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *InsertCityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullTimeline(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.City.Size()
-	n += 1 + l + sovDataStorage(uint64(l))
-	if m.UpdateIfExists {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullTimeline",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullTimeline(ctx, req.(*PullTimelineRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InsertCityReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PushGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushGridRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushGrid(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushGrid",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushGrid(ctx, req.(*PushGridRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllCitiesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullGridRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullGrid(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullGrid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullGrid(ctx, req.(*PullGridRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAllCitiesReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PushEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Cities) > 0 {
-		for _, e := range m.Cities {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushEvents(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushEvents",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushEvents(ctx, req.(*PushEventsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+func _DataStorage_PullEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullEvents(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullEvents(ctx, req.(*PullEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCityReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullEventsTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullEventsTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.City != nil {
-		l = m.City.Size()
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullEventsTags(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullEventsTags",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullEventsTags(ctx, req.(*PullEventsTagsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PushLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushLocations(ctx, in)
 	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushLocations",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushLocations(ctx, req.(*PushLocationsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPostsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullLocations(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullLocations",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullLocations(ctx, req.(*PullLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SelectPostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartTime != 0 {
-		n += 1 + sovDataStorage(uint64(m.StartTime))
+func _DataStorage_PushBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FinishTime != 0 {
-		n += 1 + sovDataStorage(uint64(m.FinishTime))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushBots(ctx, in)
 	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushBots",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushBots(ctx, req.(*PushBotsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SelectPostsReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+func _DataStorage_PullBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Area != nil {
-		l = m.Area.Size()
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullBots(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullBots",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullBots(ctx, req.(*PullBotsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SelectAggrPostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PushAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Interval.Size()
-	n += 1 + l + sovDataStorage(uint64(l))
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushAlerts(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushAlerts",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushAlerts(ctx, req.(*PushAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SelectAggrPostsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullAlerts(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullAlerts",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullAlerts(ctx, req.(*PullAlertsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullTimelineRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PushWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushWatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushWatermark(ctx, in)
 	}
-	if m.Start != 0 {
-		n += 1 + sovDataStorage(uint64(m.Start))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushWatermark",
 	}
-	if m.Finish != 0 {
-		n += 1 + sovDataStorage(uint64(m.Finish))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushWatermark(ctx, req.(*PushWatermarkRequest))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStorage_PushGridTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushGridTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(DataStorageServer).PushGridTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PushGridTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PushGridTasks(ctx, req.(*PushGridTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullTimelineReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_LeaseGridTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseGridTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Timeline) > 0 {
-		for _, e := range m.Timeline {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).LeaseGridTask(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/LeaseGridTask",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).LeaseGridTask(ctx, req.(*LeaseGridTaskRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushGridRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_CompleteGridTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteGridTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Grids) > 0 {
-		for k, v := range m.Grids {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
-			}
-			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
-			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *PushGridReply) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DataStorageServer).CompleteGridTask(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/CompleteGridTask",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).CompleteGridTask(ctx, req.(*CompleteGridTaskRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullGridRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullGridTasksStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullGridTasksStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovDataStorage(uint64(e))
-		}
-		n += 1 + sovDataStorage(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullGridTasksStatus(ctx, in)
 	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullGridTasksStatus",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullGridTasksStatus(ctx, req.(*PullGridTasksStatusRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullGridReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_CancelGridTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGridTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Grids) > 0 {
-		for k, v := range m.Grids {
-			_ = k
-			_ = v
-			l = 0
-			if len(v) > 0 {
-				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
-			}
-			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
-			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).CancelGridTasks(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/CancelGridTasks",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).CancelGridTasks(ctx, req.(*CancelGridTasksRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_RenewGridTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewGridTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).RenewGridTask(ctx, in)
 	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/RenewGridTask",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).RenewGridTask(ctx, req.(*RenewGridTaskRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushEventsReply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _DataStorage_PublishGridSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishGridSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *PullEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(DataStorageServer).PublishGridSet(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Interval.Size()
-	n += 1 + l + sovDataStorage(uint64(l))
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PublishGridSet",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PublishGridSet(ctx, req.(*PublishGridSetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullEventsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_DeleteGridSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGridSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).DeleteGridSet(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/DeleteGridSet",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).DeleteGridSet(ctx, req.(*DeleteGridSetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullEventsTagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+func _DataStorage_PullShortPostInInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullShortPostInIntervalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StartTime != 0 {
-		n += 1 + sovDataStorage(uint64(m.StartTime))
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullShortPostInInterval(ctx, in)
 	}
-	if m.FinishTime != 0 {
-		n += 1 + sovDataStorage(uint64(m.FinishTime))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullShortPostInInterval",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullShortPostInInterval(ctx, req.(*PullShortPostInIntervalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PullEventsTagsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataStorage_PullSingleShortPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullSingleShortPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(DataStorageServer).PullSingleShortPost(ctx, in)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DataStorage/PullSingleShortPost",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStorageServer).PullSingleShortPost(ctx, req.(*PullSingleShortPostRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushLocationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+var _DataStorage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DataStorage",
+	HandlerType: (*DataStorageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertCity",
+			Handler:    _DataStorage_InsertCity_Handler,
+		},
+		{
+			MethodName: "GetAllCities",
+			Handler:    _DataStorage_GetAllCities_Handler,
+		},
+		{
+			MethodName: "GetCity",
+			Handler:    _DataStorage_GetCity_Handler,
+		},
+		{
+			MethodName: "PushPosts",
+			Handler:    _DataStorage_PushPosts_Handler,
+		},
+		{
+			MethodName: "SelectPosts",
+			Handler:    _DataStorage_SelectPosts_Handler,
+		},
+		{
+			MethodName: "SelectAggrPosts",
+			Handler:    _DataStorage_SelectAggrPosts_Handler,
+		},
+		{
+			MethodName: "PullTimeline",
+			Handler:    _DataStorage_PullTimeline_Handler,
+		},
+		{
+			MethodName: "PushGrid",
+			Handler:    _DataStorage_PushGrid_Handler,
+		},
+		{
+			MethodName: "PullGrid",
+			Handler:    _DataStorage_PullGrid_Handler,
+		},
+		{
+			MethodName: "PushEvents",
+			Handler:    _DataStorage_PushEvents_Handler,
+		},
+		{
+			MethodName: "PullEvents",
+			Handler:    _DataStorage_PullEvents_Handler,
+		},
+		{
+			MethodName: "PullEventsTags",
+			Handler:    _DataStorage_PullEventsTags_Handler,
+		},
+		{
+			MethodName: "PushLocations",
+			Handler:    _DataStorage_PushLocations_Handler,
+		},
+		{
+			MethodName: "PullLocations",
+			Handler:    _DataStorage_PullLocations_Handler,
+		},
+		{
+			MethodName: "PushBots",
+			Handler:    _DataStorage_PushBots_Handler,
+		},
+		{
+			MethodName: "PullBots",
+			Handler:    _DataStorage_PullBots_Handler,
+		},
+		{
+			MethodName: "PushAlerts",
+			Handler:    _DataStorage_PushAlerts_Handler,
+		},
+		{
+			MethodName: "PullAlerts",
+			Handler:    _DataStorage_PullAlerts_Handler,
+		},
+		{
+			MethodName: "PushWatermark",
+			Handler:    _DataStorage_PushWatermark_Handler,
+		},
+		{
+			MethodName: "PushGridTasks",
+			Handler:    _DataStorage_PushGridTasks_Handler,
+		},
+		{
+			MethodName: "LeaseGridTask",
+			Handler:    _DataStorage_LeaseGridTask_Handler,
+		},
+		{
+			MethodName: "CompleteGridTask",
+			Handler:    _DataStorage_CompleteGridTask_Handler,
+		},
+		{
+			MethodName: "PullGridTasksStatus",
+			Handler:    _DataStorage_PullGridTasksStatus_Handler,
+		},
+		{
+			MethodName: "CancelGridTasks",
+			Handler:    _DataStorage_CancelGridTasks_Handler,
+		},
+		{
+			MethodName: "RenewGridTask",
+			Handler:    _DataStorage_RenewGridTask_Handler,
+		},
+		{
+			MethodName: "PublishGridSet",
+			Handler:    _DataStorage_PublishGridSet_Handler,
+		},
+		{
+			MethodName: "DeleteGridSet",
+			Handler:    _DataStorage_DeleteGridSet_Handler,
+		},
+		{
+			MethodName: "PullShortPostInInterval",
+			Handler:    _DataStorage_PullShortPostInInterval_Handler,
+		},
+		{
+			MethodName: "PullSingleShortPost",
+			Handler:    _DataStorage_PullSingleShortPost_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "data-storage/proto/data-storage.proto",
+}
 
-func (m *PushLocationsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InsertCityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertCityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertCityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.UpdateIfExists {
+		i--
+		if m.UpdateIfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.City.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PullLocationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InsertCityReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertCityReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertCityReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PullLocationsReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAllCitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Locations) > 0 {
-		for _, e := range m.Locations {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
-	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PullShortPostInIntervalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAllCitiesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCitiesReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCitiesReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
-	}
-	if m.StartTimestamp != 0 {
-		n += 1 + sovDataStorage(uint64(m.StartTimestamp))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EndTimestamp != 0 {
-		n += 1 + sovDataStorage(uint64(m.EndTimestamp))
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Shortcodes) > 0 {
-		for _, s := range m.Shortcodes {
-			l = len(s)
-			n += 1 + l + sovDataStorage(uint64(l))
+	if len(m.Cities) > 0 {
+		for iNdEx := len(m.Cities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PullShortPostInIntervalReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCityReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCityReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCityReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Posts) > 0 {
-		for _, e := range m.Posts {
-			l = e.Size()
-			n += 1 + l + sovDataStorage(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.City != nil {
+		{
+			size, err := m.City.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PullSingleShortPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PushPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Shortcode)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PullSingleShortPostReply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PushPostsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPostsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Post != nil {
-		l = m.Post.Size()
-		n += 1 + l + sovDataStorage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovDataStorage(uint64(l))
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SelectPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FinishTime != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.FinishTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovDataStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SelectPostsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozDataStorage(x uint64) (n int) {
-	return sovDataStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SelectPostsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Area != nil {
+		{
+			size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SelectAggrPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectAggrPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectAggrPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SelectAggrPostsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectAggrPostsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectAggrPostsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Finish != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Finish))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullTimelineReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullTimelineReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullTimelineReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Timeline) > 0 {
+		for iNdEx := len(m.Timeline) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timeline[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushGridRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushGridRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushGridRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		i -= len(m.Set)
+		copy(dAtA[i:], m.Set)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Set)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Versions) > 0 {
+		for k := range m.Versions {
+			v := m.Versions[k]
+			baseI := i
+			i = encodeVarintDataStorage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grids) > 0 {
+		for k := range m.Grids {
+			v := m.Grids[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushGridReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushGridReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushGridReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullGridRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullGridRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullGridRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		i -= len(m.Set)
+		copy(dAtA[i:], m.Set)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Set)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num1 := range m.Ids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintDataStorage(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullGridReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullGridReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullGridReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Versions) > 0 {
+		for k := range m.Versions {
+			v := m.Versions[k]
+			baseI := i
+			i = encodeVarintDataStorage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grids) > 0 {
+		for k := range m.Grids {
+			v := m.Grids[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushEventsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushEventsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushEventsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PullEventsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullEventsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullEventsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullEventsTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullEventsTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullEventsTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinishTime != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.FinishTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullEventsTagsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullEventsTagsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullEventsTagsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushLocationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushLocationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushLocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushLocationsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushLocationsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushLocationsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullLocationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullLocationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullLocationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullLocationsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullLocationsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullLocationsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locations) > 0 {
+		for iNdEx := len(m.Locations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushBotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushBotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushBotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bots) > 0 {
+		for iNdEx := len(m.Bots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushBotsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushBotsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushBotsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullBotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullBotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullBotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullBotsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullBotsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullBotsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bots) > 0 {
+		for iNdEx := len(m.Bots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushAlertsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushAlertsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushAlertsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Alerts) > 0 {
+		for iNdEx := len(m.Alerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Alerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushAlertsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushAlertsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushAlertsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullAlertsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullAlertsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullAlertsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Finish != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Finish))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullAlertsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullAlertsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullAlertsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alerts) > 0 {
+		for iNdEx := len(m.Alerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Alerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushWatermarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushWatermarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushWatermarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Time != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushWatermarkReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushWatermarkReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushWatermarkReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushGridTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushGridTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushGridTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushGridTasksReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushGridTasksReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushGridTasksReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseGridTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseGridTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseGridTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lease != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseGridTaskReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseGridTaskReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseGridTaskReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompleteGridTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteGridTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteGridTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Grids) > 0 {
+		for k := range m.Grids {
+			v := m.Grids[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Posts != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Key != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompleteGridTaskReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteGridTaskReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteGridTaskReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullGridTasksStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullGridTasksStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullGridTasksStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullGridTasksStatusReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullGridTasksStatusReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullGridTasksStatusReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDataStorage(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGridTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGridTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGridTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGridTasksReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGridTasksReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGridTasksReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewGridTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewGridTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewGridTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lease != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Key != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewGridTaskReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewGridTaskReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewGridTaskReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishGridSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishGridSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishGridSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Grids) > 0 {
+		for k := range m.Grids {
+			v := m.Grids[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintDataStorage(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintDataStorage(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDataStorage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num1 := range m.Ids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintDataStorage(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Set) > 0 {
+		i -= len(m.Set)
+		copy(dAtA[i:], m.Set)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Set)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishGridSetReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishGridSetReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishGridSetReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteGridSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteGridSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteGridSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Set) > 0 {
+		i -= len(m.Set)
+		copy(dAtA[i:], m.Set)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Set)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteGridSetReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteGridSetReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteGridSetReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullShortPostInIntervalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullShortPostInIntervalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullShortPostInIntervalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shortcodes) > 0 {
+		for iNdEx := len(m.Shortcodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shortcodes[iNdEx])
+			copy(dAtA[i:], m.Shortcodes[iNdEx])
+			i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Shortcodes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EndTimestamp != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintDataStorage(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullShortPostInIntervalReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullShortPostInIntervalReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullShortPostInIntervalReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDataStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullSingleShortPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullSingleShortPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullSingleShortPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shortcode) > 0 {
+		i -= len(m.Shortcode)
+		copy(dAtA[i:], m.Shortcode)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Shortcode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullSingleShortPostReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullSingleShortPostReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullSingleShortPostReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintDataStorage(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Post != nil {
+		{
+			size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataStorage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDataStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDataStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InsertCityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.City.Size()
+	n += 1 + l + sovDataStorage(uint64(l))
+	if m.UpdateIfExists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsertCityReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllCitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAllCitiesReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cities) > 0 {
+		for _, e := range m.Cities {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCityReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.City != nil {
+		l = m.City.Size()
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPostsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SelectPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovDataStorage(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovDataStorage(uint64(m.FinishTime))
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SelectPostsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.Area != nil {
+		l = m.Area.Size()
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SelectAggrPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Interval.Size()
+	n += 1 + l + sovDataStorage(uint64(l))
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SelectAggrPostsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovDataStorage(uint64(m.Start))
+	}
+	if m.Finish != 0 {
+		n += 1 + sovDataStorage(uint64(m.Finish))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullTimelineReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Timeline) > 0 {
+		for _, e := range m.Timeline {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushGridRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grids) > 0 {
+		for k, v := range m.Grids {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for k, v := range m.Versions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + 1 + sovDataStorage(uint64(v))
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Set)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushGridReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullGridRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovDataStorage(uint64(e))
+		}
+		n += 1 + sovDataStorage(uint64(l)) + l
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Set)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullGridReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grids) > 0 {
+		for k, v := range m.Grids {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for k, v := range m.Versions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + 1 + sovDataStorage(uint64(v))
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushEventsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Interval.Size()
+	n += 1 + l + sovDataStorage(uint64(l))
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullEventsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullEventsTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovDataStorage(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovDataStorage(uint64(m.FinishTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullEventsTagsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushLocationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushLocationsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullLocationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullLocationsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locations) > 0 {
+		for _, e := range m.Locations {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushBotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Bots) > 0 {
+		for _, e := range m.Bots {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushBotsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullBotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullBotsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bots) > 0 {
+		for _, e := range m.Bots {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushAlertsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Alerts) > 0 {
+		for _, e := range m.Alerts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushAlertsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullAlertsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovDataStorage(uint64(m.Start))
+	}
+	if m.Finish != 0 {
+		n += 1 + sovDataStorage(uint64(m.Finish))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullAlertsReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Alerts) > 0 {
+		for _, e := range m.Alerts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushWatermarkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovDataStorage(uint64(m.Time))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushWatermarkReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushGridTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushGridTasksReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaseGridTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovDataStorage(uint64(m.Lease))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaseGridTaskReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompleteGridTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Key != 0 {
+		n += 1 + sovDataStorage(uint64(m.Key))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovDataStorage(uint64(m.Posts))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Grids) > 0 {
+		for k, v := range m.Grids {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompleteGridTaskReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullGridTasksStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullGridTasksStatusReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovDataStorage(uint64(l))
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelGridTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelGridTasksReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewGridTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Key != 0 {
+		n += 1 + sovDataStorage(uint64(m.Key))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovDataStorage(uint64(m.Lease))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewGridTaskReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishGridSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Set)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovDataStorage(uint64(e))
+		}
+		n += 1 + sovDataStorage(uint64(l)) + l
+	}
+	if len(m.Grids) > 0 {
+		for k, v := range m.Grids {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovDataStorage(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovDataStorage(uint64(k)) + l
+			n += mapEntrySize + 1 + sovDataStorage(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishGridSetReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteGridSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Set)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteGridSetReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullShortPostInIntervalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.StartTimestamp != 0 {
+		n += 1 + sovDataStorage(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovDataStorage(uint64(m.EndTimestamp))
+	}
+	if len(m.Shortcodes) > 0 {
+		for _, s := range m.Shortcodes {
+			l = len(s)
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullShortPostInIntervalReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovDataStorage(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullSingleShortPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Shortcode)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullSingleShortPostReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Post != nil {
+		l = m.Post.Size()
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovDataStorage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDataStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDataStorage(x uint64) (n int) {
+	return sovDataStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertCityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertCityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.City.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateIfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateIfExists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertCityReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertCityReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertCityReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCitiesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCitiesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCitiesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cities = append(m.Cities, proto1.City{})
+			if err := m.Cities[len(m.Cities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCityReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCityReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCityReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.City == nil {
+				m.City = &proto1.City{}
+			}
+			if err := m.City.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, proto1.Post{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushPostsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushPostsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			m.FinishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectPostsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, proto1.Post{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Area == nil {
+				m.Area = &proto1.Area{}
+			}
+			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectAggrPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectAggrPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectAggrPostsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectAggrPostsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectAggrPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, proto1.AggregatedPost{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
+			}
+			m.Finish = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finish |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullTimelineReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullTimelineReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullTimelineReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeline = append(m.Timeline, proto1.Timestamp{})
+			if err := m.Timeline[len(m.Timeline)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushGridRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushGridRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushGridRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grids == nil {
+				m.Grids = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDataStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Grids[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Versions == nil {
+				m.Versions = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDataStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Versions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushGridReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushGridReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushGridReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullGridRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullGridRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullGridRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDataStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDataStorage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullGridReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullGridReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullGridReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grids == nil {
+				m.Grids = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDataStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Grids[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Versions == nil {
+				m.Versions = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDataStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Versions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, proto1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushEventsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushEventsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushEventsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullEventsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullEventsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullEventsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, proto1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullEventsTagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullEventsTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			m.FinishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
+func (m *PullEventsTagsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4646,15 +11340,15 @@ func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertCityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullEventsTagsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertCityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullEventsTagsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4681,15 +11375,16 @@ func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.City.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, proto1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateIfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -4699,12 +11394,24 @@ func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UpdateIfExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -4727,7 +11434,7 @@ func (m *InsertCityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertCityReply) Unmarshal(dAtA []byte) error {
+func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4750,15 +11457,15 @@ func (m *InsertCityReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertCityReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushLocationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertCityReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushLocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4786,59 +11493,42 @@ func (m *InsertCityReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDataStorage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDataStorage
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAllCitiesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDataStorage
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Locations = append(m.Locations, proto1.Location{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCitiesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -4861,7 +11551,7 @@ func (m *GetAllCitiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCitiesReply) Unmarshal(dAtA []byte) error {
+func (m *PushLocationsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4884,47 +11574,13 @@ func (m *GetAllCitiesReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCitiesReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushLocationsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCitiesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushLocationsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cities = append(m.Cities, proto1.City{})
-			if err := m.Cities[len(m.Cities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -4978,7 +11634,7 @@ func (m *GetAllCitiesReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCityRequest) Unmarshal(dAtA []byte) error {
+func (m *PullLocationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5001,10 +11657,10 @@ func (m *GetCityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullLocationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullLocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5061,7 +11717,7 @@ func (m *GetCityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCityReply) Unmarshal(dAtA []byte) error {
+func (m *PullLocationsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5084,15 +11740,15 @@ func (m *GetCityReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCityReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullLocationsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCityReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullLocationsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5119,10 +11775,8 @@ func (m *GetCityReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.City == nil {
-				m.City = &proto1.City{}
-			}
-			if err := m.City.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locations = append(m.Locations, proto1.Location{})
+			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5180,7 +11834,7 @@ func (m *GetCityReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
+func (m *PushBotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5203,15 +11857,47 @@ func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPostsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushBotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushBotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5238,14 +11924,65 @@ func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Posts = append(m.Posts, proto1.Post{})
-			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bots = append(m.Bots, proto1.Bot{})
+			if err := m.Bots[len(m.Bots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushBotsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushBotsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushBotsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5273,7 +12010,7 @@ func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5297,7 +12034,7 @@ func (m *PushPostsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPostsReply) Unmarshal(dAtA []byte) error {
+func (m *PullBotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5320,15 +12057,15 @@ func (m *PushPostsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPostsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullBotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullBotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5356,7 +12093,7 @@ func (m *PushPostsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5380,7 +12117,7 @@ func (m *PushPostsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
+func (m *PullBotsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +12140,17 @@ func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SelectPostsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullBotsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelectPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullBotsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bots", wireType)
 			}
-			m.StartTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5423,33 +12160,29 @@ func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
 			}
-			m.FinishTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinishTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bots = append(m.Bots, proto1.Bot{})
+			if err := m.Bots[len(m.Bots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5477,7 +12210,7 @@ func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5501,7 +12234,7 @@ func (m *SelectPostsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
+func (m *PushAlertsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5524,17 +12257,17 @@ func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SelectPostsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushAlertsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelectPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushAlertsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5544,29 +12277,27 @@ func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDataStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDataStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Posts = append(m.Posts, proto1.Post{})
-			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5593,14 +12324,63 @@ func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Area == nil {
-				m.Area = &proto1.Area{}
-			}
-			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alerts = append(m.Alerts, proto1.Alert{})
+			if err := m.Alerts[len(m.Alerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushAlertsReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushAlertsReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushAlertsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -5654,7 +12434,7 @@ func (m *SelectPostsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
+func (m *PullAlertsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5677,17 +12457,17 @@ func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SelectAggrPostsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullAlertsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelectAggrPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullAlertsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5697,30 +12477,29 @@ func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDataStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDataStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5730,24 +12509,30 @@ func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Start |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Finish = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finish |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -5770,7 +12555,7 @@ func (m *SelectAggrPostsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SelectAggrPostsReply) Unmarshal(dAtA []byte) error {
+func (m *PullAlertsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5793,15 +12578,15 @@ func (m *SelectAggrPostsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SelectAggrPostsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullAlertsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SelectAggrPostsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullAlertsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5828,8 +12613,8 @@ func (m *SelectAggrPostsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Posts = append(m.Posts, proto1.AggregatedPost{})
-			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alerts = append(m.Alerts, proto1.Alert{})
+			if err := m.Alerts[len(m.Alerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5887,7 +12672,7 @@ func (m *SelectAggrPostsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
+func (m *PushWatermarkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5910,10 +12695,10 @@ func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullTimelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushWatermarkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushWatermarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5950,9 +12735,9 @@ func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.Start = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5962,16 +12747,67 @@ func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= int64(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushWatermarkReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushWatermarkReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushWatermarkReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			m.Finish = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -5981,11 +12817,24 @@ func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Finish |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -6008,7 +12857,7 @@ func (m *PullTimelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullTimelineReply) Unmarshal(dAtA []byte) error {
+func (m *PushGridTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6031,15 +12880,15 @@ func (m *PullTimelineReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullTimelineReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushGridTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullTimelineReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushGridTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6066,43 +12915,11 @@ func (m *PullTimelineReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timeline = append(m.Timeline, proto1.Timestamp{})
-			if err := m.Timeline[len(m.Timeline)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, proto1.GridTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Err = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -6125,7 +12942,7 @@ func (m *PullTimelineReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushGridRequest) Unmarshal(dAtA []byte) error {
+func (m *PushGridTasksReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6148,129 +12965,15 @@ func (m *PushGridRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushGridRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushGridTasksReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushGridRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushGridTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grids", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Grids == nil {
-				m.Grids = make(map[int64][]byte)
-			}
-			var mapkey int64
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDataStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDataStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDataStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthDataStorage
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthDataStorage
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDataStorage(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthDataStorage
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Grids[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6298,7 +13001,7 @@ func (m *PushGridRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6322,7 +13025,7 @@ func (m *PushGridRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushGridReply) Unmarshal(dAtA []byte) error {
+func (m *LeaseGridTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6345,15 +13048,15 @@ func (m *PushGridReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushGridReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseGridTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushGridReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseGridTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6381,8 +13084,27 @@ func (m *PushGridReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -6405,7 +13127,7 @@ func (m *PushGridReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullGridRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaseGridTaskReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6428,91 +13150,51 @@ func (m *PullGridRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullGridRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaseGridTaskReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullGridRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaseGridTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDataStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDataStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthDataStorage
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthDataStorage
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]int64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDataStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &proto1.GridTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6540,7 +13222,7 @@ func (m *PullGridRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6564,7 +13246,7 @@ func (m *PullGridRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullGridReply) Unmarshal(dAtA []byte) error {
+func (m *CompleteGridTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6587,13 +13269,211 @@ func (m *PullGridReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullGridReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteGridTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullGridReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteGridTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Session = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			m.Posts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Posts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grids", wireType)
 			}
@@ -6700,44 +13580,12 @@ func (m *PullGridReply) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthDataStorage
 					}
 					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Grids[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Grids[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6761,7 +13609,7 @@ func (m *PullGridReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *CompleteGridTaskReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6784,49 +13632,15 @@ func (m *PushEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteGridTaskReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteGridTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, proto1.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6854,7 +13668,7 @@ func (m *PushEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6878,7 +13692,7 @@ func (m *PushEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushEventsReply) Unmarshal(dAtA []byte) error {
+func (m *PullGridTasksStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6901,15 +13715,15 @@ func (m *PushEventsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushEventsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullGridTasksStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushEventsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullGridTasksStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6937,7 +13751,7 @@ func (m *PushEventsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Session = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6961,7 +13775,7 @@ func (m *PushEventsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *PullGridTasksStatusReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6984,15 +13798,15 @@ func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullGridTasksStatusReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullGridTasksStatusReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7019,13 +13833,13 @@ func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7053,7 +13867,7 @@ func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7077,7 +13891,7 @@ func (m *PullEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullEventsReply) Unmarshal(dAtA []byte) error {
+func (m *CancelGridTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7100,17 +13914,17 @@ func (m *PullEventsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullEventsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelGridTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullEventsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelGridTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -7120,27 +13934,76 @@ func (m *PullEventsReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDataStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDataStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, proto1.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Session = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataStorage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelGridTasksReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelGridTasksReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelGridTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -7194,7 +14057,7 @@ func (m *PullEventsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
+func (m *RenewGridTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7217,15 +14080,15 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullEventsTagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenewGridTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullEventsTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenewGridTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7253,13 +14116,13 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Session = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -7269,29 +14132,16 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Key |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.StartTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -7301,16 +14151,29 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
-			m.FinishTime = 0
+			m.Lease = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDataStorage
@@ -7320,7 +14183,7 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishTime |= int64(b&0x7F) << shift
+				m.Lease |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7347,7 +14210,7 @@ func (m *PullEventsTagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullEventsTagsReply) Unmarshal(dAtA []byte) error {
+func (m *RenewGridTaskReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7370,47 +14233,13 @@ func (m *PullEventsTagsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullEventsTagsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenewGridTaskReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullEventsTagsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenewGridTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, proto1.Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -7464,7 +14293,7 @@ func (m *PullEventsTagsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
+func (m *PublishGridSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7483,19 +14312,51 @@ func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PushLocationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushLocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishGridSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishGridSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7523,11 +14384,87 @@ func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Set = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDataStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDataStorage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7554,10 +14491,90 @@ func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locations = append(m.Locations, proto1.Location{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Grids == nil {
+				m.Grids = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDataStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDataStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDataStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDataStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Grids[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7581,7 +14598,7 @@ func (m *PushLocationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushLocationsReply) Unmarshal(dAtA []byte) error {
+func (m *PublishGridSetReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7604,10 +14621,10 @@ func (m *PushLocationsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushLocationsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishGridSetReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushLocationsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishGridSetReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7664,7 +14681,7 @@ func (m *PushLocationsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullLocationsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteGridSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7687,10 +14704,10 @@ func (m *PullLocationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullLocationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteGridSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullLocationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteGridSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7725,6 +14742,38 @@ func (m *PullLocationsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataStorage(dAtA[iNdEx:])
@@ -7747,7 +14796,7 @@ func (m *PullLocationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullLocationsReply) Unmarshal(dAtA []byte) error {
+func (m *DeleteGridSetReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7770,47 +14819,13 @@ func (m *PullLocationsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullLocationsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteGridSetReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullLocationsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteGridSetReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDataStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDataStorage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locations = append(m.Locations, proto1.Location{})
-			if err := m.Locations[len(m.Locations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -50,9 +50,10 @@ type Service interface {
 	// result: if request was successfully finished will return aggregated posts and nil error, otherwise empty array and some error
 	SelectAggrPosts(ctx context.Context, cityId string, interval data.SpatioHourInterval) ([]data.AggregatedPost, error)
 
-	// input: context, id of the city, start hour and finish hour UTC-time in seconds, both are beginning of needed hours
+	// input: context, id of the city, start and finish UTC-time in seconds
 	// output: array of timestamps and error
-	// result: if request was successfully finished, will return timeline - amount of posts and events in this city between start and finish hour
+	// result: if request was successfully finished, will return timeline - amount of posts and events in this city in every hour of [start, finish)
+	// Hours without posts are returned with zero amounts, hours which are not collected by the crawler yet are not marked as collected.
 	PullTimeline(ctx context.Context, cityId string, start, finish int64) ([]data.Timestamp, error)
 
 	// input: context, id of the city, map of grids, keys of this map are ids and value is byte array - historic grid.
@@ -61,14 +62,18 @@ type Service interface {
 	// output: error
 	// if all grids were successfully added to the city's db, will return nil error, otherwise statuses and some error
 	// Either all grids will be added or not a single one.
-	PushGrid(ctx context.Context, cityId string, grids map[int64][]byte) error
+	// Every push of a grid changes its version. Grids whose ids are in versions are replaced only if
+	// their stored versions are still the same, otherwise ErrGridVersion is returned and nothing is added.
+	// If the set is not empty, grids are added to the set and are not used until the set is published,
+	// grids of sets have no versions.
+	PushGrid(ctx context.Context, cityId, set string, grids map[int64][]byte, versions map[int64]int64) error
 
-	// input: context, id of the city, start and finish ids
+	// input: context, id of the city, id of the set (empty for published grids), start and finish ids
 	// 		ids description: first two digit: month; 3th: 0 - work day,  1 - holiday; 4th and 5th: hour.
 	//		Example: 02013 - 02 March, 0 work day, 13 o'clock
-	// output: map of map of grids, keys of this map are ids and value is byte array
+	// output: map of map of grids, keys of this map are ids and value is byte array, and map of versions of the grids
 	// result: if request was successfully finished, will return grids and nil error otherwise return nil map and some error
-	PullGrid(ctx context.Context, cityId string, ids []int64) (map[int64][]byte, error)
+	PullGrid(ctx context.Context, cityId, set string, ids []int64) (map[int64][]byte, map[int64]int64, error)
 
 	// input: context, id of the city, array od events
 	// output: error
@@ -99,6 +104,86 @@ type Service interface {
 	// result: if request was successfully finished, return all locations of this city and nil error, otherwise return some error
 	PullLocations(ctx context.Context, cityId string) ([]data.Location, error)
 
+	// input: context, id of the city, array of bots - authors whose posts are excluded from event detection
+	// output: error
+	// result: if all bots were successfully added to the city's db, will return nil error, otherwise some error
+	// Existing bots are updated. Either all bots will be added or not a single one.
+	PushBots(ctx context.Context, cityId string, bots []data.Bot) error
+
+	// input: context, id of the city
+	// output: array of bots
+	// result: if request was successfully finished, return all bots of this city and nil error, otherwise return some error
+	PullBots(ctx context.Context, cityId string) ([]data.Bot, error)
+
+	// input: context, id of the city, array of city-level alerts
+	// output: error
+	// result: if all alerts were successfully added to the city's db, will return nil error, otherwise some error
+	// Alerts of the same kind which overlap new alerts are replaced. Either all alerts will be added or not a single one.
+	PushAlerts(ctx context.Context, cityId string, alerts []data.Alert) error
+
+	// input: context, id of the city, timestamp
+	// output: error
+	// result: if the watermark was successfully saved, will return nil error, otherwise some error
+	// Posts of the city published before the watermark are collected. The watermark never moves back.
+	PushWatermark(ctx context.Context, cityId string, time int64) error
+
+	// input: context, id of the city, start and finish timestamps
+	// output: array of alerts
+	// result: if request was successfully finished, return alerts of this city overlapping [start, finish) and nil error,
+	// otherwise return some error
+	PullAlerts(ctx context.Context, cityId string, start, finish int64) ([]data.Alert, error)
+
+	// input: context, array of grid tasks
+	// output: error
+	// result: if request was successfully finished, return nil, otherwise return some error.
+	// Tasks which are already in the queue are not changed. Either all tasks will be added or not a single one.
+	PushGridTasks(ctx context.Context, tasks []data.GridTask) error
+
+	// input: context, name of the worker, lease duration in seconds
+	// output: grid task, error
+	// result: if request was successfully finished, return the pending task or the task with the expired lease
+	// leased to the owner, nil is returned if there are no such tasks, otherwise return some error
+	LeaseGridTask(ctx context.Context, owner string, lease int64) (*data.GridTask, error)
+
+	// input: context, id of the city, session and key of the task, name of the worker, new status, number of posts,
+	// 		error of the task, grids of the task
+	// output: error
+	// result: if the task is still leased by the owner, set its status, add the grids to the grid set of the session
+	// 		and return nil, otherwise return some error. The task can't be canceled until the grids are added, so grids
+	// 		of canceled tasks never appear in the set after it is deleted.
+	CompleteGridTask(ctx context.Context, cityId, session string, key int64, owner, status string, posts int64,
+		taskErr string, grids map[int64][]byte) error
+
+	// input: context, id of the session
+	// output: status of the tasks, error
+	// result: if request was successfully finished, return numbers of the session tasks in every status and nil error,
+	// otherwise return some error
+	PullGridTasksStatus(ctx context.Context, session string) (data.GridTasksStatus, error)
+
+	// input: context, id of the session
+	// output: error
+	// result: if request was successfully finished, mark pending and leased tasks of the session as failed and
+	// return nil, otherwise return some error
+	CancelGridTasks(ctx context.Context, session string) error
+
+	// input: context, session and key of the task, name of the worker, lease duration in seconds
+	// output: error
+	// result: if the task is still leased by the owner, extend its lease by lease seconds from now and return nil,
+	// otherwise return some error
+	RenewGridTask(ctx context.Context, session string, key int64, owner string, lease int64) error
+
+	// input: context, id of the city, id of the set, ids of grids of the set, map of grids
+	// output: error
+	// result: if request was successfully finished, replace all grids of the city with the grids of the set with
+	// the ids and the given grids, delete the set and return nil, otherwise return some error.
+	// Either all grids will be replaced or not a single one.
+	PublishGridSet(ctx context.Context, cityId, set string, ids []int64, grids map[int64][]byte) error
+
+	// input: context, id of the city, id of the set
+	// output: error
+	// result: if request was successfully finished, delete grids of the set and return nil, otherwise return some error
+	DeleteGridSet(ctx context.Context, cityId, set string) error
+
 	// input: contex, id of the city, shortcodes of needed posts, start and end timestamps of the timeinterval (for increaseing time of request)
 	// output: array of short posts, error
 	PullShortPostInInterval(ctx context.Context, cityId string, shortCodes []string, startTimestamp int64, endTimestamp int64) ([]data.ShortPost, error)
@@ -138,12 +223,12 @@ func (s basicService) PullTimeline(ctx context.Context, cityId string, start, fi
 	return s.db.PullTimeline(ctx, cityId, start, finish)
 }
 
-func (s basicService) PushGrid(ctx context.Context, cityId string, grids map[int64][]byte) error {
-	return s.db.PushGrid(ctx, cityId, grids)
+func (s basicService) PushGrid(ctx context.Context, cityId, set string, grids map[int64][]byte, versions map[int64]int64) error {
+	return s.db.PushGrid(ctx, cityId, set, grids, versions)
 }
 
-func (s basicService) PullGrid(ctx context.Context, cityId string, ids []int64) (map[int64][]byte, error) {
-	return s.db.PullGrid(ctx, cityId, ids)
+func (s basicService) PullGrid(ctx context.Context, cityId, set string, ids []int64) (map[int64][]byte, map[int64]int64, error) {
+	return s.db.PullGrid(ctx, cityId, set, ids)
 }
 
 func (s basicService) PushEvents(ctx context.Context, cityId string, events []data.Event) error {
@@ -166,6 +251,59 @@ func (s basicService) PullLocations(ctx context.Context, cityId string) ([]data.
 	return s.db.PullLocations(ctx, cityId)
 }
 
+func (s basicService) PushBots(ctx context.Context, cityId string, bots []data.Bot) error {
+	return s.db.PushBots(ctx, cityId, bots)
+}
+
+func (s basicService) PullBots(ctx context.Context, cityId string) ([]data.Bot, error) {
+	return s.db.PullBots(ctx, cityId)
+}
+
+func (s basicService) PushAlerts(ctx context.Context, cityId string, alerts []data.Alert) error {
+	return s.db.PushAlerts(ctx, cityId, alerts)
+}
+
+func (s basicService) PushWatermark(ctx context.Context, cityId string, time int64) error {
+	return s.db.PushWatermark(ctx, cityId, time)
+}
+
+func (s basicService) PullAlerts(ctx context.Context, cityId string, start, finish int64) ([]data.Alert, error) {
+	return s.db.PullAlerts(ctx, cityId, start, finish)
+}
+
+func (s basicService) PushGridTasks(ctx context.Context, tasks []data.GridTask) error {
+	return s.db.PushGridTasks(ctx, tasks)
+}
+
+func (s basicService) LeaseGridTask(ctx context.Context, owner string, lease int64) (*data.GridTask, error) {
+	return s.db.LeaseGridTask(ctx, owner, lease)
+}
+
+func (s basicService) CompleteGridTask(ctx context.Context, cityId, session string, key int64, owner, status string,
+	posts int64, taskErr string, grids map[int64][]byte) error {
+	return s.db.CompleteGridTask(ctx, cityId, session, key, owner, status, posts, taskErr, grids)
+}
+
+func (s basicService) PullGridTasksStatus(ctx context.Context, session string) (data.GridTasksStatus, error) {
+	return s.db.PullGridTasksStatus(ctx, session)
+}
+
+func (s basicService) CancelGridTasks(ctx context.Context, session string) error {
+	return s.db.CancelGridTasks(ctx, session)
+}
+
+func (s basicService) RenewGridTask(ctx context.Context, session string, key int64, owner string, lease int64) error {
+	return s.db.RenewGridTask(ctx, session, key, owner, lease)
+}
+
+func (s basicService) PublishGridSet(ctx context.Context, cityId, set string, ids []int64, grids map[int64][]byte) error {
+	return s.db.PublishGridSet(ctx, cityId, set, ids, grids)
+}
+
+func (s basicService) DeleteGridSet(ctx context.Context, cityId, set string) error {
+	return s.db.DeleteGridSet(ctx, cityId, set)
+}
+
 func (s basicService) PullShortPostInInterval(ctx context.Context, cityId string, shortCodes []string, startTimestamp int64, endTimestamp int64) ([]data.ShortPost, error) {
 	return s.db.PullShortPostInInterval(ctx, cityId, shortCodes, startTimestamp, endTimestamp)
 }
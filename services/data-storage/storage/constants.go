@@ -77,6 +77,74 @@ func makeSelectCitySQL(cityCode string) string {
 	return statement
 }
 
+const CreateGridTasksTableSQL = `
+	CREATE TABLE IF NOT EXISTS grid_tasks(
+		Session VARCHAR(50) NOT NULL,
+		Key BIGINT NOT NULL,
+		Spec BYTEA NOT NULL,
+		Status VARCHAR(10) NOT NULL,
+		Owner TEXT NOT NULL DEFAULT '',
+		LeaseUntil BIGINT NOT NULL DEFAULT 0,
+		Attempts INT NOT NULL DEFAULT 0,
+		Posts BIGINT NOT NULL DEFAULT 0,
+		Error TEXT NOT NULL DEFAULT '',
+		Created BIGINT NOT NULL,
+		PRIMARY KEY (Session, Key)
+	);
+`
+const CreateGridTasksIndexSQL = "CREATE INDEX IF NOT EXISTS grid_tasks_queue ON grid_tasks (Status, Created, Key);"
+
+// InsertGridTaskSQL doesn't touch existing tasks, so a session can enqueue its tasks again after a restart.
+const InsertGridTaskSQL = `
+	INSERT INTO grid_tasks
+		(Session, Key, Spec, Status, Created)
+	VALUES
+		($1, $2, $3, 'pending', $4)
+	ON CONFLICT (Session, Key) DO NOTHING;
+`
+
+// LeaseGridTaskSQL takes the oldest pending task or the oldest task with the expired lease. Tasks locked by
+// concurrent leases are skipped, so every task is leased by one worker at a time.
+const LeaseGridTaskSQL = `
+	UPDATE grid_tasks
+	SET Status = 'leased', Owner = $1, LeaseUntil = $2 + $3, Attempts = Attempts + 1
+	WHERE (Session, Key) = (
+		SELECT Session, Key
+		FROM grid_tasks
+		WHERE Status = 'pending' OR (Status = 'leased' AND LeaseUntil < $2)
+		ORDER BY Created, Key
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING Session, Key, Spec, Status, Owner, LeaseUntil, Attempts, Posts, Error;
+`
+
+// CompleteGridTaskSQL changes the task only if it is still leased by the owner.
+const CompleteGridTaskSQL = `
+	UPDATE grid_tasks
+	SET Status = $4, Posts = $5, Error = $6, Owner = CASE WHEN $4 = 'pending' THEN '' ELSE Owner END
+	WHERE Session = $1 AND Key = $2 AND Owner = $3 AND Status = 'leased';
+`
+const SelectGridTasksStatusSQL = `
+	SELECT Status, COUNT(*), COALESCE(SUM(Posts), 0)
+	FROM grid_tasks
+	WHERE Session = $1
+	GROUP BY Status;
+`
+const SelectDoneGridTasksSQL = "SELECT Key FROM grid_tasks WHERE Session = $1 AND Status = 'done' ORDER BY Key;"
+const CancelGridTasksSQL = `
+	UPDATE grid_tasks
+	SET Status = 'failed', Error = 'canceled'
+	WHERE Session = $1 AND Status IN ('pending', 'leased');
+`
+
+// RenewGridTaskSQL extends the lease only if the task is still leased by the owner.
+const RenewGridTaskSQL = `
+	UPDATE grid_tasks
+	SET LeaseUntil = $4 + $5
+	WHERE Session = $1 AND Key = $2 AND Owner = $3 AND Status = 'leased';
+`
+
 const CreateHyperTablePostsSQL = "SELECT create_hypertable('posts', 'timestamp', chunk_time_interval => 86400, if_not_exists => TRUE);"
 const SetTimeFunctionForPostsSQL = "SELECT set_integer_now_func('posts', 'unix_now', replace_if_exists => true);"
 const CreatePostsTableSQL = `
@@ -183,11 +251,51 @@ func makeCreateEventsTableSQL(eventTableName string) string {
 	return statement
 }
 
+// events tables created by older versions don't have the counters of removed posts, detection parameters,
+// summaries and footprints
+const AlterEventsTableTemplate = `
+	ALTER TABLE %v
+		ADD COLUMN IF NOT EXISTS FilteredBots BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS FilteredAds BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS FilteredRateLimited BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS FilteredDuplicates BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS Params JSONB,
+		ADD COLUMN IF NOT EXISTS TopPosts VARCHAR(15)[],
+		ADD COLUMN IF NOT EXISTS Authors BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS Likes BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS Comments BIGINT DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS Location TEXT,
+		ADD COLUMN IF NOT EXISTS Footprint geometry,
+		ADD COLUMN IF NOT EXISTS Radius DOUBLE PRECISION DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS Cell geometry;
+`
+
+func makeAlterEventsTableSQL(eventTableName string) string {
+	statement := fmt.Sprintf(AlterEventsTableTemplate, eventTableName)
+	return statement
+}
+
+const CreateEventsFootprintIndexTemplate = "CREATE INDEX IF NOT EXISTS %v_footprint ON %v USING GIST (Footprint);"
+
+func makeCreateEventsFootprintIndexSQL(eventTableName string) string {
+	statement := fmt.Sprintf(CreateEventsFootprintIndexTemplate, eventTableName, eventTableName)
+	return statement
+}
+
+const CreateEventsCenterIndexTemplate = "CREATE INDEX IF NOT EXISTS %v_center ON %v USING GIST (Center);"
+
+func makeCreateEventsCenterIndexSQL(eventTableName string) string {
+	statement := fmt.Sprintf(CreateEventsCenterIndexTemplate, eventTableName, eventTableName)
+	return statement
+}
+
 const InsertEventTemplate = `
 	INSERT INTO %v
-		(Title, Start, Finish, Center, PostCodes, Tags)
+		(Title, Start, Finish, Center, PostCodes, Tags, FilteredBots, FilteredAds, FilteredRateLimited, FilteredDuplicates, Params,
+		TopPosts, Authors, Likes, Comments, Location, Footprint, Radius, Cell)
 	VALUES
-		($1, $2, $3, ST_SetSRID( ST_Point($4, $5), 4326), $6, $7)
+		($1, $2, $3, ST_SetSRID( ST_Point($4, $5), 4326), $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+		ST_SetSRID( ST_GeomFromGeoJSON(NULLIF($18::text, '')), 4326), $19, ST_MakeEnvelope($20, $21, $22, $23, 4326))
 `
 
 func makeInsertEventSQL(eventTableName string) string {
@@ -198,23 +306,38 @@ func makeInsertEventSQL(eventTableName string) string {
 const SelectEventsTemplate = `
 	SELECT 
 		Title, Start, Finish, PostCodes, Tags,  
+		COALESCE(FilteredBots, 0), COALESCE(FilteredAds, 0), COALESCE(FilteredRateLimited, 0), COALESCE(FilteredDuplicates, 0),
+		COALESCE(Params::text, '{}'),
+		COALESCE(TopPosts, '{}'), COALESCE(Authors, 0), COALESCE(Likes, 0), COALESCE(Comments, 0), COALESCE(Location, ''),
+		COALESCE(ST_AsGeoJSON(Footprint), ''), COALESCE(Radius, 0),
+		ST_XMin(Footprint), ST_YMax(Footprint), ST_XMax(Footprint), ST_YMin(Footprint),
+		ST_XMin(Cell), ST_YMax(Cell), ST_XMax(Cell), ST_YMin(Cell),
 		ST_X(Center) as Lon, 
 		ST_Y(Center) as Lat
 	FROM %v
 	WHERE 
-		ST_Covers(%v, Center) 
+		((Footprint IS NOT NULL AND ST_Intersects(%v, Footprint)) OR (Footprint IS NULL AND ST_Covers(%v, Center)))
 		AND (Start BETWEEN %v AND (%v - 1))
 `
 
+// makeSelectEventsSQL selects events of the hour which footprints intersect the area, events without
+// a footprint are selected by their centers. Both conditions are checked on the columns themselves,
+// so that GiST indexes of footprints and centers are used.
 func makeSelectEventsSQL(eventTableName string, interval data.SpatioHourInterval) string {
 	poly := makePoly(interval.Area)
-	statement := fmt.Sprintf(SelectEventsTemplate, eventTableName, poly, interval.Hour, interval.Hour+Hour)
+	statement := fmt.Sprintf(SelectEventsTemplate, eventTableName, poly, poly, interval.Hour, interval.Hour+Hour)
 	return statement
 }
 
 const SelectEventsTagsTemplate = `
 	SELECT
 		Title, Start, Finish, PostCodes, Tags,
+		COALESCE(FilteredBots, 0), COALESCE(FilteredAds, 0), COALESCE(FilteredRateLimited, 0), COALESCE(FilteredDuplicates, 0),
+		COALESCE(Params::text, '{}'),
+		COALESCE(TopPosts, '{}'), COALESCE(Authors, 0), COALESCE(Likes, 0), COALESCE(Comments, 0), COALESCE(Location, ''),
+		COALESCE(ST_AsGeoJSON(Footprint), ''), COALESCE(Radius, 0),
+		ST_XMin(Footprint), ST_YMax(Footprint), ST_XMax(Footprint), ST_YMin(Footprint),
+		ST_XMin(Cell), ST_YMax(Cell), ST_XMax(Cell), ST_YMin(Cell),
 		ST_X(Center) as Lon, 
 		ST_Y(Center) as Lat
 	FROM %v
@@ -258,12 +381,21 @@ func makeCreateEventsTimelineViewSQL(eventTableName string) string {
 	return statement
 }
 
+// SelectTimelineTemplate returns every hour of the interval, hours without posts are returned with
+// zero numbers instead of being skipped, because the view has no rows for them. An hour is collected
+// if it ends before the watermark of the crawler. Cities without a watermark, e.g. with posts loaded
+// before the crawler pushed watermarks, are collected up to the last hour with posts.
 const SelectTimelineTemplate = `
 	SELECT
-		SUM(posts) as posts,
-		SUM(events) as events,
-		time
-	FROM (
+		COALESCE(SUM(tmp.posts), 0) as posts,
+		COALESCE(SUM(tmp.events), 0) as events,
+		hours.time,
+		COALESCE(hours.time + 3600 <= COALESCE(
+			(SELECT Time FROM watermark),
+			(SELECT MAX(time) + 3600 FROM posts_timeline)
+		), FALSE) as collected
+	FROM generate_series(%v, %v, 3600) as hours(time)
+	LEFT JOIN (
  		SELECT count as posts, 0 as events, time
  		FROM posts_timeline
  		WHERE time BETWEEN %v AND %v
@@ -271,13 +403,17 @@ const SelectTimelineTemplate = `
  		SELECT 0 as posts, count as events, time
  		FROM %v_timeline
 		WHERE time BETWEEN %v AND %v
-	) as tmp
-	GROUP BY time;
+	) as tmp ON tmp.time = hours.time
+	GROUP BY hours.time
+	ORDER BY hours.time;
 `
 
+// makeSelectTimelineSQL returns the statement which selects the hours of [startTimestamp,
+// finishTimestamp), the hours containing the timestamps are the first and the last ones.
 func makeSelectTimelineSQL(startTimestamp, finishTimestamp int64, eventTableName string) string {
-	statement := fmt.Sprintf(SelectTimelineTemplate, startTimestamp, finishTimestamp, eventTableName,
-		startTimestamp, finishTimestamp)
+	first := startTimestamp - startTimestamp%3600
+	last := finishTimestamp - 1 - (finishTimestamp-1)%3600
+	statement := fmt.Sprintf(SelectTimelineTemplate, first, last, first, last, eventTableName, first, last)
 	return statement
 }
 
@@ -304,17 +440,114 @@ const SelectLocationsSQL = `
 	FROM locations;
 `
 
+const CreateBotsTableSQL = `
+	CREATE TABLE IF NOT EXISTS bots (
+		AuthorID VARCHAR(15) NOT NULL PRIMARY KEY,
+		Reason TEXT
+	);
+`
+const InsertBotSQL = `
+	INSERT INTO bots
+		(AuthorID, Reason)
+	VALUES
+		($1, $2)
+	ON CONFLICT (AuthorID) DO UPDATE SET Reason = EXCLUDED.Reason;
+`
+const SelectBotsSQL = `
+	SELECT AuthorID, Reason
+	FROM bots;
+`
+
+const CreateAlertsTableSQL = `
+	CREATE TABLE IF NOT EXISTS alerts (
+		Start BIGINT NOT NULL,
+		Finish BIGINT NOT NULL,
+		Kind TEXT NOT NULL,
+		Observed BIGINT,
+		Expected DOUBLE PRECISION,
+		Score DOUBLE PRECISION,
+		PRIMARY KEY (Start, Kind)
+	);
+`
+const InsertAlertSQL = `
+	INSERT INTO alerts
+		(Start, Finish, Kind, Observed, Expected, Score)
+	VALUES
+		($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (Start, Kind) DO UPDATE SET
+		Finish = EXCLUDED.Finish, Observed = EXCLUDED.Observed,
+		Expected = EXCLUDED.Expected, Score = EXCLUDED.Score;
+`
+
+// DeleteOverlappingAlertsSQL removes alerts of the kind which overlap the new one, so that a search
+// over the same period with shifted hours replaces earlier alerts instead of duplicating them.
+const DeleteOverlappingAlertsSQL = `
+	DELETE FROM alerts
+	WHERE Kind = $3 AND Start < $2 AND Finish > $1;
+`
+const SelectAlertsSQL = `
+	SELECT Start, Finish, Kind, Observed, Expected, Score
+	FROM alerts
+	WHERE Start < $2 AND Finish > $1
+	ORDER BY Start;
+`
+
+// CreateWatermarkTableSQL creates the table of the crawler watermark, it has one row at most.
+const CreateWatermarkTableSQL = `
+	CREATE TABLE IF NOT EXISTS watermark (
+		ID BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (ID),
+		Time BIGINT NOT NULL
+	);
+`
+const UpsertWatermarkSQL = `
+	INSERT INTO watermark (Time)
+	VALUES ($1)
+	ON CONFLICT (ID) DO UPDATE SET Time = GREATEST(watermark.Time, EXCLUDED.Time);
+`
+
+// versions of grids are taken from a sequence, so a grid never gets a version which it had before
+const CreateGridVersionsSequenceSQL = "CREATE SEQUENCE IF NOT EXISTS grid_versions;"
 const CreateGridsTableSQL = `
 	CREATE TABLE IF NOT EXISTS grids(
 		ID BIGINT PRIMARY KEY,
-		Blob BYTEA NOT NULL
+		Blob BYTEA NOT NULL,
+		Version BIGINT NOT NULL DEFAULT nextval('grid_versions')
 	);
 `
+
+// grids tables created by older versions don't have versions
+const AlterGridsTableSQL = "ALTER TABLE grids ADD COLUMN IF NOT EXISTS Version BIGINT NOT NULL DEFAULT nextval('grid_versions');"
 const InsertGridSQL = `
 	INSERT INTO grids(id, blob)
 	VALUES ($1, $2)
-	ON CONFLICT (id) DO UPDATE SET blob = EXCLUDED.blob;
+	ON CONFLICT (id) DO UPDATE SET blob = EXCLUDED.blob, version = EXCLUDED.version;
+`
+
+// SwapGridSQL replaces the grid only if its version is $3.
+const SwapGridSQL = "UPDATE grids SET blob = $2, version = nextval('grid_versions') WHERE id = $1 AND version = $3;"
+
+// grid sets hold grids which are being built until they replace the grids of the city together
+const CreateGridSetsTableSQL = `
+	CREATE TABLE IF NOT EXISTS grid_sets(
+		SetID VARCHAR(50) NOT NULL,
+		ID BIGINT NOT NULL,
+		Blob BYTEA NOT NULL,
+		PRIMARY KEY (SetID, ID)
+	);
+`
+const InsertGridSetSQL = `
+	INSERT INTO grid_sets(SetID, ID, Blob)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (SetID, ID) DO UPDATE SET Blob = EXCLUDED.Blob;
+`
+const SelectGridSetSQL = "SELECT ID, Blob FROM grid_sets WHERE SetID = $1 AND ID = ANY($2);"
+const DeleteGridsSQL = "DELETE FROM grids;"
+const PublishGridSetSQL = `
+	INSERT INTO grids(ID, Blob)
+	SELECT ID, Blob FROM grid_sets WHERE SetID = $1 AND ID = ANY($2)
+	ON CONFLICT (id) DO UPDATE SET blob = EXCLUDED.blob, version = EXCLUDED.version;
 `
+const DeleteGridSetSQL = "DELETE FROM grid_sets WHERE SetID = $1;"
 
 const SelectShortPostsInIntervalTemplate = `
 	SELECT 
@@ -2,11 +2,14 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/lib/pq"
@@ -23,6 +26,7 @@ type Storage struct {
 var (
 	ErrDBTransaction   = errors.New("error with transaction")
 	ErrPushPosts       = errors.New("one or more posts wasn't pushed")
+	ErrInvalidPosts    = errors.New("one or more posts were rejected by the database")
 	ErrSelectPosts     = errors.New("don't be able to return posts")
 	ErrPullGrid        = errors.New("don't be able to return grid")
 	ErrDuplicatedKey   = errors.New("duplicated id, object hadn't saved to db")
@@ -30,6 +34,17 @@ var (
 	ErrSelectEvents    = errors.New("don't be able to return events")
 	ErrPushLocations   = errors.New("do not be able to insert locations")
 	ErrSelectLocations = errors.New("don't be able to return locations")
+	ErrPushBots        = errors.New("do not be able to insert bots")
+	ErrSelectBots      = errors.New("don't be able to return bots")
+	ErrPushAlerts      = errors.New("do not be able to insert alerts")
+	ErrSelectAlerts    = errors.New("don't be able to return alerts")
+	ErrPushWatermark   = errors.New("do not be able to save watermark")
+	ErrPushGridTasks   = errors.New("do not be able to insert grid tasks")
+	ErrLeaseGridTask   = errors.New("don't be able to lease grid task")
+	ErrGridTaskLease   = errors.New("grid task isn't leased by the owner")
+	ErrGridVersion     = errors.New("grid has been changed since it was pulled")
+	ErrPublishGridSet  = errors.New("do not be able to publish grid set")
+	ErrSelectGridTasks = errors.New("don't be able to return grid tasks status")
 )
 
 func New(ctx context.Context, confPath string) (*Storage, error) {
@@ -91,6 +106,14 @@ func (s *Storage) initGeneral(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	_, err = conn.Exec(ctx, CreateGridTasksTableSQL)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, CreateGridTasksIndexSQL)
+	if err != nil {
+		return err
+	}
 
 	s.general = conn
 	return nil
@@ -214,6 +237,18 @@ func (s *Storage) setCityEnvironment(ctx context.Context, cityId string) (err er
 	if err != nil {
 		return
 	}
+	_, err = conn.Exec(ctx, makeAlterEventsTableSQL(s.config.EventsTableName))
+	if err != nil {
+		return
+	}
+	_, err = conn.Exec(ctx, makeCreateEventsFootprintIndexSQL(s.config.EventsTableName))
+	if err != nil {
+		return
+	}
+	_, err = conn.Exec(ctx, makeCreateEventsCenterIndexSQL(s.config.EventsTableName))
+	if err != nil {
+		return
+	}
 	_, err = conn.Exec(ctx, CreatePostsTimelineViewSQL)
 	if err != nil && isNotAlreadyExistsError(err) {
 		return
@@ -229,11 +264,39 @@ func (s *Storage) setCityEnvironment(ctx context.Context, cityId string) (err er
 		return
 	}
 
+	// create table for bots
+	_, err = conn.Exec(ctx, CreateBotsTableSQL)
+	if err != nil {
+		return
+	}
+
+	// create table for city-level alerts
+	_, err = conn.Exec(ctx, CreateAlertsTableSQL)
+	if err != nil {
+		return
+	}
+	_, err = conn.Exec(ctx, CreateWatermarkTableSQL)
+	if err != nil {
+		return
+	}
+
 	// create table for grids
+	_, err = conn.Exec(ctx, CreateGridVersionsSequenceSQL)
+	if err != nil {
+		return
+	}
 	_, err = conn.Exec(ctx, CreateGridsTableSQL)
 	if err != nil {
 		return
 	}
+	_, err = conn.Exec(ctx, AlterGridsTableSQL)
+	if err != nil {
+		return
+	}
+	_, err = conn.Exec(ctx, CreateGridSetsTableSQL)
+	if err != nil {
+		return
+	}
 	return nil
 }
 
@@ -308,6 +371,9 @@ func (s *Storage) PushPosts(ctx context.Context, cityId string, posts []data.Pos
 		_, err = tx.Exec(ctx, InsertPostSQL, v.ID, v.Shortcode, v.ImageURL, v.IsVideo, v.Caption, v.CommentsCount, v.Timestamp, v.LikesCount, v.IsAd, v.AuthorID, v.LocationID, v.Lon, v.Lat)
 		if err != nil {
 			unilog.Logger().Error("is not able to exec event", zap.Error(err))
+			if invalidData(err) {
+				return ErrInvalidPosts
+			}
 			return ErrPushPosts
 		}
 	}
@@ -318,6 +384,13 @@ func (s *Storage) PushPosts(ctx context.Context, cityId string, posts []data.Pos
 	return
 }
 
+// invalidData checks if the statement failed because of its data, i.e. with a data exception or
+// an integrity constraint violation, so it fails again if it is repeated.
+func invalidData(err error) bool {
+	pgErr, ok := err.(*pgconn.PgError)
+	return ok && (strings.HasPrefix(pgErr.Code, "22") || strings.HasPrefix(pgErr.Code, "23"))
+}
+
 func (s Storage) SelectPosts(ctx context.Context, cityId string, startTime, finishTime int64) (posts []data.Post, cityArea *data.Area, err error) {
 	conn, err := s.getCityConn(ctx, cityId)
 	if err != nil {
@@ -395,7 +468,7 @@ func (s *Storage) PullTimeline(ctx context.Context, cityId string, start, finish
 	}
 	for rows.Next() {
 		var timestamp data.Timestamp
-		err = rows.Scan(&timestamp.PostsNumber, &timestamp.EventsNumber, &timestamp.Time)
+		err = rows.Scan(&timestamp.PostsNumber, &timestamp.EventsNumber, &timestamp.Time, &timestamp.Collected)
 		if err != nil {
 			unilog.Logger().Error("error in pull timeline", zap.Error(err))
 			return nil, err
@@ -405,7 +478,7 @@ func (s *Storage) PullTimeline(ctx context.Context, cityId string, start, finish
 	return
 }
 
-func (s *Storage) PushGrid(ctx context.Context, cityId string, grids map[int64][]byte) (err error) {
+func (s *Storage) PushGrid(ctx context.Context, cityId, set string, grids map[int64][]byte, versions map[int64]int64) (err error) {
 	conn, err := s.getCityConn(ctx, cityId)
 	if err != nil {
 		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
@@ -419,6 +492,25 @@ func (s *Storage) PushGrid(ctx context.Context, cityId string, grids map[int64][
 	defer tx.Rollback(ctx)
 
 	for id, blob := range grids {
+		if set != "" {
+			_, err = tx.Exec(ctx, InsertGridSetSQL, set, id, blob)
+			if err != nil {
+				unilog.Logger().Error("don't be able to push grid", zap.String("set", set), zap.Int64("id", id), zap.Error(err))
+				return err
+			}
+			continue
+		}
+		if version, ok := versions[id]; ok {
+			tag, err := tx.Exec(ctx, SwapGridSQL, id, blob, version)
+			if err != nil {
+				unilog.Logger().Error("don't be able to push grid", zap.Int64("id", id), zap.Error(err))
+				return err
+			}
+			if tag.RowsAffected() == 0 {
+				return ErrGridVersion
+			}
+			continue
+		}
 		_, err = tx.Exec(ctx, InsertGridSQL, id, blob)
 		if err != nil {
 			if strings.Contains(err.Error(), "duplicate key") {
@@ -436,17 +528,45 @@ func (s *Storage) PushGrid(ctx context.Context, cityId string, grids map[int64][
 	return err
 }
 
-func (s *Storage) PullGrid(ctx context.Context, cityId string, ids []int64) (grids map[int64][]byte, err error) {
+func (s *Storage) PullGrid(ctx context.Context, cityId, set string, ids []int64) (grids map[int64][]byte, versions map[int64]int64, err error) {
 	conn, err := s.getCityConn(ctx, cityId)
 	if err != nil {
 		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
-		return nil, err
+		return nil, nil, err
+	}
+	if set != "" {
+		grids, err = s.pullGridSet(ctx, conn, set, ids)
+		return grids, map[int64]int64{}, err
 	}
 	grids = make(map[int64][]byte)
+	versions = make(map[int64]int64)
 	statement := formSelectGrids(ids)
 	rows, err := conn.Query(ctx, statement)
 	if err != nil {
 		unilog.Logger().Error("error in pull grid", zap.Error(err))
+		return nil, nil, ErrPullGrid
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, version int64
+		var blob []byte
+		err = rows.Scan(&id, &blob, &version)
+		if err != nil {
+			unilog.Logger().Error("error in pull grid", zap.Error(err))
+			return nil, nil, ErrPullGrid
+		}
+		grids[id] = blob
+		versions[id] = version
+	}
+	return
+}
+
+func (s *Storage) pullGridSet(ctx context.Context, conn *pgxpool.Pool, set string, ids []int64) (map[int64][]byte, error) {
+	grids := make(map[int64][]byte)
+	rows, err := conn.Query(ctx, SelectGridSetSQL, set, ids)
+	if err != nil {
+		unilog.Logger().Error("error in pull grid set", zap.Error(err))
 		return nil, ErrPullGrid
 	}
 	defer rows.Close()
@@ -456,16 +576,75 @@ func (s *Storage) PullGrid(ctx context.Context, cityId string, ids []int64) (gri
 		var blob []byte
 		err = rows.Scan(&id, &blob)
 		if err != nil {
-			unilog.Logger().Error("error in pull grid", zap.Error(err))
+			unilog.Logger().Error("error in pull grid set", zap.Error(err))
 			return nil, ErrPullGrid
 		}
 		grids[id] = blob
 	}
+	return grids, nil
+}
+
+// PublishGridSet replaces all grids of the city with the grids of the set with the ids and the given
+// grids in one transaction, so event searches never see grids of different builds. The set is deleted
+// together with its grids which are not published.
+func (s *Storage) PublishGridSet(ctx context.Context, cityId, set string, ids []int64, grids map[int64][]byte) (err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return err
+	}
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		unilog.Logger().Error("can not begin transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, DeleteGridsSQL)
+	if err != nil {
+		unilog.Logger().Error("don't be able to delete grids", zap.Error(err))
+		return ErrPublishGridSet
+	}
+	_, err = tx.Exec(ctx, PublishGridSetSQL, set, ids)
+	if err != nil {
+		unilog.Logger().Error("don't be able to publish grid set", zap.String("set", set), zap.Error(err))
+		return ErrPublishGridSet
+	}
+	for id, blob := range grids {
+		_, err = tx.Exec(ctx, InsertGridSQL, id, blob)
+		if err != nil {
+			unilog.Logger().Error("don't be able to push grid", zap.Int64("id", id), zap.Error(err))
+			return ErrPublishGridSet
+		}
+	}
+	_, err = tx.Exec(ctx, DeleteGridSetSQL, set)
+	if err != nil {
+		unilog.Logger().Error("don't be able to delete grid set", zap.String("set", set), zap.Error(err))
+		return ErrPublishGridSet
+	}
+	if err := tx.Commit(ctx); err != nil {
+		unilog.Logger().Error("is not able to commit grid set transaction", zap.Error(err))
+		return ErrPublishGridSet
+	}
+	return nil
+}
+
+func (s *Storage) DeleteGridSet(ctx context.Context, cityId, set string) (err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return err
+	}
+	_, err = conn.Exec(ctx, DeleteGridSetSQL, set)
+	if err != nil {
+		unilog.Logger().Error("error in delete grid set", zap.Error(err))
+		return ErrDBTransaction
+	}
 	return
 }
 
 func formSelectGrids(ids []int64) string {
-	s := "SELECT id, blob FROM grids WHERE id IN ("
+	s := "SELECT id, blob, version FROM grids WHERE id IN ("
 	f := ");"
 	res := s
 	for i := range ids {
@@ -492,8 +671,17 @@ func (s *Storage) PushEvents(ctx context.Context, cityId string, events []data.E
 	defer tx.Rollback(ctx)
 
 	for _, event := range events {
+		params, err := json.Marshal(event.Params)
+		if err != nil {
+			unilog.Logger().Error("is not able to encode event parameters", zap.Error(err))
+			return ErrPushEvents
+		}
+		cell := envelopeArgs(event.Footprint.Cell)
 		_, err = tx.Exec(ctx, makeInsertEventSQL(s.config.EventsTableName),
-			event.Title, event.Start, event.Finish, event.Center.Lon, event.Center.Lat, pq.Array(event.PostCodes), pq.Array(event.Tags))
+			event.Title, event.Start, event.Finish, event.Center.Lon, event.Center.Lat, pq.Array(event.PostCodes), pq.Array(event.Tags),
+			event.Filtered.Bots, event.Filtered.Ads, event.Filtered.RateLimited, event.Filtered.Duplicates, string(params),
+			pq.Array(event.TopPosts), event.Authors, event.Likes, event.Comments, event.Location,
+			event.Footprint.Geometry, event.Footprint.Radius, cell[0], cell[1], cell[2], cell[3])
 		if err != nil {
 			unilog.Logger().Error("is not able to exec event", zap.Error(err))
 			return ErrPushEvents
@@ -524,12 +712,26 @@ func (s *Storage) PullEvents(ctx context.Context, cityId string, interval data.S
 	for rows.Next() {
 		e := new(data.Event)
 		p := new(data.Point)
-		err = rows.Scan(&e.Title, &e.Start, &e.Finish, pq.Array(&e.PostCodes), pq.Array(&e.Tags), &p.Lon, &p.Lat)
+		f := &e.Filtered
+		var params string
+		var bbox, cell bounds
+		err = rows.Scan(&e.Title, &e.Start, &e.Finish, pq.Array(&e.PostCodes), pq.Array(&e.Tags),
+			&f.Bots, &f.Ads, &f.RateLimited, &f.Duplicates, &params,
+			pq.Array(&e.TopPosts), &e.Authors, &e.Likes, &e.Comments, &e.Location,
+			&e.Footprint.Geometry, &e.Footprint.Radius, &bbox[0], &bbox[1], &bbox[2], &bbox[3],
+			&cell[0], &cell[1], &cell[2], &cell[3], &p.Lon, &p.Lat)
 		if err != nil {
 			unilog.Logger().Error("error in select events", zap.Error(err))
 			return nil, ErrSelectEvents
 		}
+		err = json.Unmarshal([]byte(params), &e.Params)
+		if err != nil {
+			unilog.Logger().Error("error in decoding event parameters", zap.Error(err))
+			return nil, ErrSelectEvents
+		}
 		e.Center = *p
+		e.Footprint.BBox = bbox.area()
+		e.Footprint.Cell = cell.area()
 		events = append(events, *e)
 	}
 	return
@@ -554,17 +756,52 @@ func (s *Storage) PullEventsTags(ctx context.Context, cityId string, tags []stri
 	for rows.Next() {
 		e := new(data.Event)
 		p := new(data.Point)
-		err = rows.Scan(&e.Title, &e.Start, &e.Finish, pq.Array(&e.PostCodes), pq.Array(&e.Tags), &p.Lon, &p.Lat)
+		f := &e.Filtered
+		var params string
+		var bbox, cell bounds
+		err = rows.Scan(&e.Title, &e.Start, &e.Finish, pq.Array(&e.PostCodes), pq.Array(&e.Tags),
+			&f.Bots, &f.Ads, &f.RateLimited, &f.Duplicates, &params,
+			pq.Array(&e.TopPosts), &e.Authors, &e.Likes, &e.Comments, &e.Location,
+			&e.Footprint.Geometry, &e.Footprint.Radius, &bbox[0], &bbox[1], &bbox[2], &bbox[3],
+			&cell[0], &cell[1], &cell[2], &cell[3], &p.Lon, &p.Lat)
 		if err != nil {
 			unilog.Logger().Error("error in select events", zap.Error(err))
 			return nil, ErrSelectEvents
 		}
+		err = json.Unmarshal([]byte(params), &e.Params)
+		if err != nil {
+			unilog.Logger().Error("error in decoding event parameters", zap.Error(err))
+			return nil, ErrSelectEvents
+		}
 		e.Center = *p
+		e.Footprint.BBox = bbox.area()
+		e.Footprint.Cell = cell.area()
 		events = putEvent(*e, events)
 	}
 	return
 }
 
+// bounds holds nullable left, top, right and bottom coordinates of a box.
+type bounds [4]*float64
+
+func (b bounds) area() data.Area {
+	if b[0] == nil || b[1] == nil || b[2] == nil || b[3] == nil {
+		return data.Area{}
+	}
+	return data.Area{
+		TopLeft:  &data.Point{Lon: *b[0], Lat: *b[1]},
+		BotRight: &data.Point{Lon: *b[2], Lat: *b[3]},
+	}
+}
+
+// envelopeArgs returns the arguments of ST_MakeEnvelope for the area, they are NULL for an empty area.
+func envelopeArgs(a data.Area) []interface{} {
+	if a.TopLeft == nil || a.BotRight == nil {
+		return []interface{}{nil, nil, nil, nil}
+	}
+	return []interface{}{a.TopLeft.Lon, a.BotRight.Lat, a.BotRight.Lon, a.TopLeft.Lat}
+}
+
 func putEvent(e data.Event, evs []data.Event) []data.Event {
 	if evs == nil {
 		evs = []data.Event{e}
@@ -638,6 +875,277 @@ func (s *Storage) PullLocations(ctx context.Context, cityId string) (locations [
 	return
 }
 
+func (s *Storage) PushBots(ctx context.Context, cityId string, bots []data.Bot) (err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return err
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		unilog.Logger().Error("can not begin transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	for _, b := range bots {
+		_, err = tx.Exec(ctx, InsertBotSQL, b.AuthorID, b.Reason)
+		if err != nil {
+			unilog.Logger().Error("is not able to exec bot", zap.Error(err))
+			return ErrPushBots
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		unilog.Logger().Error("is not able to commit bots transaction", zap.Error(err))
+		return ErrPushBots
+	}
+	return
+}
+
+func (s *Storage) PullBots(ctx context.Context, cityId string) (bots []data.Bot, err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return nil, err
+	}
+	rows, err := conn.Query(ctx, SelectBotsSQL)
+	if err != nil {
+		unilog.Logger().Error("error in select bots", zap.Error(err))
+		return nil, ErrSelectBots
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var b data.Bot
+		var reason *string
+		err = rows.Scan(&b.AuthorID, &reason)
+		if err != nil {
+			unilog.Logger().Error("error in select bots", zap.Error(err))
+			return nil, ErrSelectBots
+		}
+		if reason != nil {
+			b.Reason = *reason
+		}
+		bots = append(bots, b)
+	}
+	return
+}
+
+func (s *Storage) PushAlerts(ctx context.Context, cityId string, alerts []data.Alert) (err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return err
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		unilog.Logger().Error("can not begin transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	for _, a := range alerts {
+		_, err = tx.Exec(ctx, DeleteOverlappingAlertsSQL, a.Start, a.Finish, a.Kind)
+		if err != nil {
+			unilog.Logger().Error("is not able to delete overlapping alerts", zap.Error(err))
+			return ErrPushAlerts
+		}
+		_, err = tx.Exec(ctx, InsertAlertSQL, a.Start, a.Finish, a.Kind, a.Observed, a.Expected, a.Score)
+		if err != nil {
+			unilog.Logger().Error("is not able to exec alert", zap.Error(err))
+			return ErrPushAlerts
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		unilog.Logger().Error("is not able to commit alerts transaction", zap.Error(err))
+		return ErrPushAlerts
+	}
+	return
+}
+
+func (s *Storage) PullAlerts(ctx context.Context, cityId string, start, finish int64) (alerts []data.Alert, err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return nil, err
+	}
+	rows, err := conn.Query(ctx, SelectAlertsSQL, start, finish)
+	if err != nil {
+		unilog.Logger().Error("error in select alerts", zap.Error(err))
+		return nil, ErrSelectAlerts
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a data.Alert
+		err = rows.Scan(&a.Start, &a.Finish, &a.Kind, &a.Observed, &a.Expected, &a.Score)
+		if err != nil {
+			unilog.Logger().Error("error in select alerts", zap.Error(err))
+			return nil, ErrSelectAlerts
+		}
+		alerts = append(alerts, a)
+	}
+	return
+}
+
+func (s *Storage) PushWatermark(ctx context.Context, cityId string, time int64) (err error) {
+	conn, err := s.getCityConn(ctx, cityId)
+	if err != nil {
+		unilog.Logger().Error("unexpected cityId", zap.String("cityId", cityId), zap.Error(err))
+		return err
+	}
+	_, err = conn.Exec(ctx, UpsertWatermarkSQL, time)
+	if err != nil {
+		unilog.Logger().Error("is not able to exec watermark", zap.Error(err))
+		return ErrPushWatermark
+	}
+	return
+}
+
+func (s *Storage) PushGridTasks(ctx context.Context, tasks []data.GridTask) (err error) {
+	tx, err := s.general.Begin(ctx)
+	if err != nil {
+		unilog.Logger().Error("can not begin transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	created := time.Now().Unix()
+	for _, t := range tasks {
+		_, err = tx.Exec(ctx, InsertGridTaskSQL, t.Session, t.Key, t.Spec, created)
+		if err != nil {
+			unilog.Logger().Error("is not able to exec grid task", zap.Error(err))
+			return ErrPushGridTasks
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		unilog.Logger().Error("is not able to commit grid tasks transaction", zap.Error(err))
+		return ErrPushGridTasks
+	}
+	return
+}
+
+// LeaseGridTask leases a task to the owner for lease seconds, nil is returned if there are no tasks to lease.
+func (s *Storage) LeaseGridTask(ctx context.Context, owner string, lease int64) (task *data.GridTask, err error) {
+	row := s.general.QueryRow(ctx, LeaseGridTaskSQL, owner, time.Now().Unix(), lease)
+	t := data.GridTask{}
+	err = row.Scan(&t.Session, &t.Key, &t.Spec, &t.Status, &t.Owner, &t.LeaseUntil, &t.Attempts, &t.Posts, &t.Error)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		unilog.Logger().Error("error in lease grid task", zap.Error(err))
+		return nil, ErrLeaseGridTask
+	}
+	return &t, nil
+}
+
+// CompleteGridTask changes the task and adds its grids to the grid set of the session. The row of the task stays
+// locked until the grids are committed, so a concurrent cancellation waits for them and the deletion of the set
+// which follows it removes them. Grids of the task which has been canceled before are rejected.
+func (s *Storage) CompleteGridTask(ctx context.Context, cityId, session string, key int64, owner, status string,
+	posts int64, taskErr string, grids map[int64][]byte) (err error) {
+	tx, err := s.general.Begin(ctx)
+	if err != nil {
+		unilog.Logger().Error("can not begin transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, CompleteGridTaskSQL, session, key, owner, status, posts, taskErr)
+	if err != nil {
+		unilog.Logger().Error("error in complete grid task", zap.Error(err))
+		return ErrDBTransaction
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrGridTaskLease
+	}
+	if len(grids) != 0 {
+		err = s.PushGrid(ctx, cityId, session, grids, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		unilog.Logger().Error("is not able to commit grid task transaction", zap.Error(err))
+		return ErrDBTransaction
+	}
+	return
+}
+
+func (s *Storage) PullGridTasksStatus(ctx context.Context, session string) (status data.GridTasksStatus, err error) {
+	rows, err := s.general.Query(ctx, SelectGridTasksStatusSQL, session)
+	if err != nil {
+		unilog.Logger().Error("error in select grid tasks status", zap.Error(err))
+		return status, ErrSelectGridTasks
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			st           string
+			count, posts int64
+		)
+		err = rows.Scan(&st, &count, &posts)
+		if err != nil {
+			unilog.Logger().Error("error in select grid tasks status", zap.Error(err))
+			return status, ErrSelectGridTasks
+		}
+		status.Posts += posts
+		switch st {
+		case data.GridTaskPending:
+			status.Pending = count
+		case data.GridTaskLeased:
+			status.Leased = count
+		case data.GridTaskDone:
+			status.Done = count
+		case data.GridTaskFailed:
+			status.Failed = count
+		}
+	}
+	rows.Close()
+
+	rows, err = s.general.Query(ctx, SelectDoneGridTasksSQL, session)
+	if err != nil {
+		unilog.Logger().Error("error in select done grid tasks", zap.Error(err))
+		return status, ErrSelectGridTasks
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key int64
+		err = rows.Scan(&key)
+		if err != nil {
+			unilog.Logger().Error("error in select done grid tasks", zap.Error(err))
+			return status, ErrSelectGridTasks
+		}
+		status.DoneKeys = append(status.DoneKeys, key)
+	}
+	return status, nil
+}
+
+// RenewGridTask extends the lease of the task by lease seconds from now if it is still leased by the owner.
+func (s *Storage) RenewGridTask(ctx context.Context, session string, key int64, owner string, lease int64) (err error) {
+	tag, err := s.general.Exec(ctx, RenewGridTaskSQL, session, key, owner, time.Now().Unix(), lease)
+	if err != nil {
+		unilog.Logger().Error("error in renew grid task", zap.Error(err))
+		return ErrDBTransaction
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrGridTaskLease
+	}
+	return
+}
+
+func (s *Storage) CancelGridTasks(ctx context.Context, session string) (err error) {
+	_, err = s.general.Exec(ctx, CancelGridTasksSQL, session)
+	if err != nil {
+		unilog.Logger().Error("error in cancel grid tasks", zap.Error(err))
+		return ErrDBTransaction
+	}
+	return
+}
+
 func (s *Storage) PullShortPostInInterval(ctx context.Context, cityId string, shortCodes []string,
 	startTimestamp int64, endTimestamp int64) (posts []data.ShortPost, err error) {
 	conn, err := s.getCityConn(ctx, cityId)
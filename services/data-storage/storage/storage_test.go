@@ -1,9 +1,15 @@
 package storage
 
 import (
-	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"context"
+	"fmt"
+	"os"
 	"reflect"
 	"testing"
+	"time"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 func Test_formSelectGrids(t *testing.T) {
@@ -18,7 +24,7 @@ func Test_formSelectGrids(t *testing.T) {
 		{
 			name: "test",
 			args: args{ids: []int64{14, 88, 345}},
-			want: "SELECT id, blob FROM grids WHERE id IN (14,88,345);",
+			want: "SELECT id, blob, version FROM grids WHERE id IN (14,88,345);",
 		},
 	}
 	for _, tt := range tests {
@@ -89,3 +95,119 @@ func Test_putEvent(t *testing.T) {
 		})
 	}
 }
+
+// testDBEnv holds the connection string of the PostgreSQL database used by tests of the queries, the tests
+// are skipped if it is not set. Every test works in its own schema.
+const testDBEnv = "DATA_STORAGE_TEST_DB"
+
+// openGeneral returns the storage whose general database is a new schema of the test database and the
+// function which closes the storage and drops the schema.
+func openGeneral(t *testing.T) (*Storage, func()) {
+	dsn := os.Getenv(testDBEnv)
+	if dsn == "" {
+		t.Skipf("%v is not set", testDBEnv)
+	}
+	ctx := context.Background()
+	schema := fmt.Sprintf("test_%v", time.Now().UnixNano())
+	admin, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		admin.Close()
+		t.Fatal(err)
+	}
+	var pool *pgxpool.Pool
+	closeAll := func() {
+		if pool != nil {
+			pool.Close()
+		}
+		admin.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		admin.Close()
+	}
+	conf, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		closeAll()
+		t.Fatal(err)
+	}
+	conf.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err = pgxpool.ConnectConfig(ctx, conf)
+	if err != nil {
+		closeAll()
+		t.Fatal(err)
+	}
+	for _, q := range []string{CreateGridTasksTableSQL, CreateGridTasksIndexSQL} {
+		if _, err := pool.Exec(ctx, q); err != nil {
+			closeAll()
+			t.Fatal(err)
+		}
+	}
+	return &Storage{general: pool}, closeAll
+}
+
+// TestStorage_gridTasks leases, renews, expires and cancels tasks of the queue.
+func TestStorage_gridTasks(t *testing.T) {
+	s, closeAll := openGeneral(t)
+	defer closeAll()
+	ctx := context.Background()
+	err := s.PushGridTasks(ctx, []data.GridTask{{Session: "s1", Key: 1, Spec: []byte{1}}, {Session: "s1", Key: 2, Spec: []byte{2}}})
+	if err != nil {
+		t.Fatalf("PushGridTasks() error = %v", err)
+	}
+	lease := func(owner string, want int64) {
+		t.Helper()
+		task, err := s.LeaseGridTask(ctx, owner, 60)
+		if err != nil {
+			t.Fatalf("LeaseGridTask() error = %v", err)
+		}
+		switch {
+		case want == 0 && task != nil:
+			t.Fatalf("LeaseGridTask() = %v, want no task", task.Key)
+		case want != 0 && (task == nil || task.Key != want || task.Owner != owner):
+			t.Fatalf("LeaseGridTask() = %+v, want the key %v leased by %v", task, want, owner)
+		}
+	}
+	lease("w1", 1)
+	lease("w2", 2)
+	lease("w3", 0)
+
+	if err := s.RenewGridTask(ctx, "s1", 1, "w2", 60); err != ErrGridTaskLease {
+		t.Errorf("RenewGridTask() of another owner error = %v, want %v", err, ErrGridTaskLease)
+	}
+	if err := s.RenewGridTask(ctx, "s1", 1, "w1", 60); err != nil {
+		t.Errorf("RenewGridTask() error = %v", err)
+	}
+
+	// the expired lease is taken over by another worker, the former owner can't complete the task
+	if err := s.RenewGridTask(ctx, "s1", 2, "w2", -10); err != nil {
+		t.Fatalf("RenewGridTask() error = %v", err)
+	}
+	lease("w3", 2)
+	err = s.CompleteGridTask(ctx, "spb", "s1", 2, "w2", data.GridTaskDone, 10, "", nil)
+	if err != ErrGridTaskLease {
+		t.Errorf("CompleteGridTask() of the expired lease error = %v, want %v", err, ErrGridTaskLease)
+	}
+	if err := s.CompleteGridTask(ctx, "spb", "s1", 2, "w3", data.GridTaskDone, 10, "", nil); err != nil {
+		t.Errorf("CompleteGridTask() error = %v", err)
+	}
+
+	// grids of the canceled task are rejected before they reach the set
+	if err := s.CancelGridTasks(ctx, "s1"); err != nil {
+		t.Fatalf("CancelGridTasks() error = %v", err)
+	}
+	err = s.CompleteGridTask(ctx, "spb", "s1", 1, "w1", data.GridTaskDone, 5, "", map[int64][]byte{1: {1}})
+	if err != ErrGridTaskLease {
+		t.Errorf("CompleteGridTask() of the canceled task error = %v, want %v", err, ErrGridTaskLease)
+	}
+	if err := s.RenewGridTask(ctx, "s1", 1, "w1", 60); err != ErrGridTaskLease {
+		t.Errorf("RenewGridTask() of the canceled task error = %v, want %v", err, ErrGridTaskLease)
+	}
+	lease("w4", 0)
+	status, err := s.PullGridTasksStatus(ctx, "s1")
+	if err != nil {
+		t.Fatalf("PullGridTasksStatus() error = %v", err)
+	}
+	if status.Done != 1 || status.Failed != 1 || status.Posts != 10 {
+		t.Errorf("PullGridTasksStatus() = %+v, want a done and a canceled task", status)
+	}
+}
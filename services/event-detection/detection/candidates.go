@@ -5,7 +5,7 @@ import (
 	convtree "github.com/visheratin/conv-tree"
 )
 
-func findCandidates(histGrid *convtree.ConvTree, posts []data.Post, maxPoints int) (*convtree.ConvTree, bool) {
+func findCandidates(histGrid *convtree.ConvTree, posts []data.Post, thr threshold) (*convtree.ConvTree, bool) {
 	for _, post := range posts {
 		point := convtree.Point{
 			X:       post.Lon,
@@ -15,24 +15,24 @@ func findCandidates(histGrid *convtree.ConvTree, posts []data.Post, maxPoints in
 		}
 		histGrid.Insert(point, false)
 	}
-	hasAnomalies := detectCandTree(histGrid, maxPoints)
+	hasAnomalies := detectCandTree(histGrid, thr)
 	if hasAnomalies {
 		return histGrid, true
 	}
 	return nil, false
 }
 
-func detectCandTree(tree *convtree.ConvTree, maxPoints int) bool {
+func detectCandTree(tree *convtree.ConvTree, thr threshold) bool {
 	if tree.IsLeaf {
-		if len(tree.Points) >= maxPoints {
+		if len(tree.Points) >= thr(tree) {
 			return true
 		}
 		return false
 	}
 	res := false
-	res = res || detectCandTree(tree.ChildBottomLeft, maxPoints)
-	res = res || detectCandTree(tree.ChildBottomRight, maxPoints)
-	res = res || detectCandTree(tree.ChildTopLeft, maxPoints)
-	res = res || detectCandTree(tree.ChildTopRight, maxPoints)
+	res = res || detectCandTree(tree.ChildBottomLeft, thr)
+	res = res || detectCandTree(tree.ChildBottomRight, thr)
+	res = res || detectCandTree(tree.ChildTopLeft, thr)
+	res = res || detectCandTree(tree.ChildTopRight, thr)
 	return res
 }
@@ -1,48 +1,76 @@
 package detection
 
 import (
-	"regexp"
 	"sort"
-	"strings"
 
 	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
 	convtree "github.com/visheratin/conv-tree"
 )
 
-func FindEvents(histGrid convtree.ConvTree, posts []data.Post, maxPoints int, filterTags map[string]bool, start, finish int64) ([]data.Event, bool) {
-	candGrid, wasFound := findCandidates(&histGrid, posts, maxPoints)
+// Options holds optional inputs of FindEvents, zero values disable the corresponding features.
+type Options struct {
+	// FilterTags are tags which are ignored.
+	FilterTags map[string]bool
+	// TagModel is used for ranking of event tags.
+	TagModel *TagModel
+	// Filter removes spam posts before the search.
+	Filter *Filter
+	// Locations maps location IDs to their titles which are used in event titles.
+	Locations map[string]string
+}
+
+// threshold returns the number of posts in the leaf starting from which it is checked for events.
+type threshold func(leaf *convtree.ConvTree) int
+
+func fixedThreshold(maxPoints int) threshold {
+	return func(*convtree.ConvTree) int {
+		return maxPoints
+	}
+}
+
+// FindEvents searches for events in posts using the historic grid. Found events record the parameters p.
+func FindEvents(histGrid convtree.ConvTree, posts []data.Post, p Params, opts Options, start, finish int64) ([]data.Event, bool) {
+	return findEvents(&histGrid, posts, p, opts, fixedThreshold(p.MaxPoints), start, finish)
+}
+
+func findEvents(histGrid *convtree.ConvTree, posts []data.Post, p Params, opts Options, thr threshold, start, finish int64) ([]data.Event, bool) {
+	posts, removed := opts.Filter.Apply(posts)
+	candGrid, wasFound := findCandidates(histGrid, posts, thr)
 	if !wasFound {
 		return nil, false
 	}
-	splitGrid(candGrid, maxPoints)
-	events := treeEvents(candGrid, maxPoints, filterTags, start, finish)
+	splitGrid(candGrid, thr)
+	events := treeEvents(candGrid, thr, p, opts, removed, start, finish)
 	if len(events) == 0 {
 		return nil, false
 	}
 	return events, true
 }
 
-func splitGrid(tree *convtree.ConvTree, maxPoints int) {
+func splitGrid(tree *convtree.ConvTree, thr threshold) {
 	if tree.IsLeaf {
-		if len(tree.Points) >= maxPoints {
+		if len(tree.Points) >= thr(tree) {
 			tree.Check()
 		}
 	} else {
-		splitGrid(tree.ChildBottomLeft, maxPoints)
-		splitGrid(tree.ChildBottomRight, maxPoints)
-		splitGrid(tree.ChildTopLeft, maxPoints)
-		splitGrid(tree.ChildTopRight, maxPoints)
+		splitGrid(tree.ChildBottomLeft, thr)
+		splitGrid(tree.ChildBottomRight, thr)
+		splitGrid(tree.ChildTopLeft, thr)
+		splitGrid(tree.ChildTopRight, thr)
 	}
 }
 
-func treeEvents(tree *convtree.ConvTree, maxPoints int, filterTags map[string]bool, start, finish int64) []data.Event {
+func treeEvents(tree *convtree.ConvTree, thr threshold, p Params, opts Options, removed []FilteredPost, start, finish int64) []data.Event {
 	if tree.IsLeaf {
 		result := []data.Event{}
-		if len(tree.Points) >= maxPoints {
-			evHolders, posts := eventHolders(tree.Points, filterTags)
+		if len(tree.Points) >= thr(tree) {
+			evHolders, posts := eventHolders(tree.Points, opts.FilterTags)
 			for _, e := range evHolders {
-				event, ok := checkEvent(e, maxPoints, posts, start, finish)
+				event, ok := checkEvent(e, p, posts, opts, start, finish)
 				if ok {
+					event.Filtered = filterStats(tree, removed)
+					event.Footprint.Cell = cellArea(tree)
 					result = append(result, event)
 				}
 
@@ -51,34 +79,23 @@ func treeEvents(tree *convtree.ConvTree, maxPoints int, filterTags map[string]bo
 		return result
 	} else {
 		result := []data.Event{}
-		result = append(result, treeEvents(tree.ChildBottomLeft, maxPoints, filterTags, start, finish)...)
-		result = append(result, treeEvents(tree.ChildBottomRight, maxPoints, filterTags, start, finish)...)
-		result = append(result, treeEvents(tree.ChildTopLeft, maxPoints, filterTags, start, finish)...)
-		result = append(result, treeEvents(tree.ChildTopRight, maxPoints, filterTags, start, finish)...)
+		result = append(result, treeEvents(tree.ChildBottomLeft, thr, p, opts, removed, start, finish)...)
+		result = append(result, treeEvents(tree.ChildBottomRight, thr, p, opts, removed, start, finish)...)
+		result = append(result, treeEvents(tree.ChildTopLeft, thr, p, opts, removed, start, finish)...)
+		result = append(result, treeEvents(tree.ChildTopRight, thr, p, opts, removed, start, finish)...)
 		return result
 	}
 }
 
 func extractTags(post data.Post, filterTags map[string]bool) []string {
 	tags := []string{}
-	r, _ := regexp.Compile("#[a-zA-Z0-9а-яА-Я_]+")
-	hashtags := r.FindAllString(post.Caption, -1)
-	for idx := range hashtags {
-		hashtags[idx] = strings.ToLower(hashtags[idx])
-	}
-	for _, tag := range hashtags {
-		tag := strings.ToLower(tag)
+	for _, tag := range tokenizer.Hashtags(post.Caption) {
 		if filterTag(tag, filterTags) {
 			continue
 		}
 		tags = append(tags, tag)
 	}
-	r, _ = regexp.Compile("@[a-zA-Z0-9а-яА-Я_]+")
-	usernames := r.FindAllString(post.Caption, -1)
-	for idx := range usernames {
-		usernames[idx] = strings.ToLower(usernames[idx])
-	}
-	for _, tag := range usernames {
+	for _, tag := range tokenizer.Mentions(post.Caption) {
 		if filterTag(tag, filterTags) {
 			continue
 		}
@@ -195,57 +212,71 @@ func combineHolders(eh1, eh2 eventHolder) eventHolder {
 	return res
 }
 
-func checkEvent(e eventHolder, maxPoints int, posts []data.Post, start, finish int64) (data.Event, bool) {
-	if len(e.users) < 2 {
+func checkEvent(e eventHolder, p Params, posts []data.Post, opts Options, start, finish int64) (data.Event, bool) {
+	if len(e.users) < p.minEventUsers() {
 		return data.Event{}, false
 	}
-	if len(e.users) < maxPoints/2 {
-		return data.Event{}, false
-	}
-	tags := sortTags(e.tags, 5)
 	postCodes := []string{}
 	for k := range e.posts {
 		postCodes = append(postCodes, k)
 	}
-	return data.Event{
+	sort.Strings(postCodes)
+	event := data.Event{
 		Center:    eventCenter(e.posts, posts),
 		PostCodes: postCodes,
-		Tags:      tags,
-		Title:     tags[0],
+		Tags:      sortTags(e.tags, p.MaxTags, opts.TagModel),
 		Start:     start,
 		Finish:    finish,
-	}, true
+		Params:    p.Proto(),
+	}
+	summarize(&event, e, posts, p, opts)
+	return event, true
 }
 
-func sortTags(tags map[string]int, max int) []string {
-	rev := map[int][]string{}
-	for t, c := range tags {
-		if _, ok := rev[c]; !ok {
-			rev[c] = []string{t}
-		} else {
-			rev[c] = append(rev[c], t)
-		}
+// sortTags orders tags by their count multiplied by the weight from the tag model, so that generic
+// tags are placed at the end. Transliteration variants of a tag are removed, at most max tags are returned.
+func sortTags(tags map[string]int, max int, tagModel *TagModel) []string {
+	type scored struct {
+		tag   string
+		score float64
 	}
-	keys := make([]int, 0, len(rev))
-	for k := range rev {
-		keys = append(keys, k)
+	res := make([]scored, 0, len(tags))
+	for t, c := range tags {
+		res = append(res, scored{tag: t, score: float64(c) * tagModel.Weight(t)})
 	}
-	sort.Slice(keys, func(i, j int) bool {
-		return keys[i] > keys[j]
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].score != res[j].score {
+			return res[i].score > res[j].score
+		}
+		if tags[res[i].tag] != tags[res[j].tag] {
+			return tags[res[i].tag] > tags[res[j].tag]
+		}
+		return res[i].tag < res[j].tag
 	})
-	res := []string{}
-	for _, k := range keys {
-		res = append(res, rev[k]...)
-		//if len(res) >= max {
-		//	break
-		//}
+	sorted := make([]string, 0, len(res))
+	keys := map[string]bool{}
+	for _, r := range res {
+		if max > 0 && len(sorted) == max {
+			break
+		}
+		k := tokenizer.Key(r.tag)
+		if keys[k] {
+			continue
+		}
+		keys[k] = true
+		sorted = append(sorted, r.tag)
 	}
-	//l := max
-	//if l > (len(res) - 1) {
-	//	l = len(res)
-	//}
-	//res = res[0:l]
-	return res
+	return sorted
+}
+
+// eventTitle returns the first tag which is not generic or the first tag if all of them are generic.
+func eventTitle(tags []string, tagModel *TagModel) string {
+	for _, t := range tags {
+		if tagModel.Weight(t) > 0 {
+			return t
+		}
+	}
+	return tags[0]
 }
 
 func eventCenter(codes map[string]bool, posts []data.Post) data.Point {
@@ -0,0 +1,229 @@
+package detection
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+// FilterReason is the reason why a post was excluded from event detection.
+type FilterReason int
+
+const (
+	BotReason FilterReason = iota
+	AdReason
+	RateLimitReason
+	DuplicateReason
+)
+
+const (
+	// shingleSize is the number of characters in a shingle of a caption.
+	shingleSize = 4
+	// defaultDuplicateSimilarity is the default Jaccard similarity of near-duplicate captions.
+	defaultDuplicateSimilarity = 0.8
+)
+
+// FilterConfig sets up the pre-filter of posts. Zero values disable the corresponding checks.
+type FilterConfig struct {
+	// ExcludeAds removes posts marked as advertisement.
+	ExcludeAds bool
+	// MaxAuthorPosts is the maximum number of posts of one author in one cell during one hour.
+	MaxAuthorPosts int
+	// CellSize is the size of the cell for MaxAuthorPosts in degrees.
+	CellSize float64
+	// DuplicateCaptions removes posts which captions are near-duplicates of the caption of an earlier
+	// post of the same author. Captions are compared after removal of tags, mentions, digits and
+	// punctuation by the Jaccard similarity of their sets of character shingles.
+	DuplicateCaptions bool
+	// MinCaptionLength is the minimum length of the normalized caption checked for duplicates.
+	MinCaptionLength int
+	// DuplicateSimilarity is the similarity starting from which captions are near-duplicates, 0.8
+	// is used if it is zero.
+	DuplicateSimilarity float64
+}
+
+// Filter removes posts of bots, advertisement and spam before event detection.
+type Filter struct {
+	cfg  FilterConfig
+	bots map[string]bool
+}
+
+// FilteredPost is a post removed by the filter.
+type FilteredPost struct {
+	Post   data.Post
+	Reason FilterReason
+}
+
+func NewFilter(cfg FilterConfig, bots []data.Bot) *Filter {
+	f := &Filter{
+		cfg:  cfg,
+		bots: make(map[string]bool, len(bots)),
+	}
+	for _, b := range bots {
+		f.bots[b.AuthorID] = true
+	}
+	return f
+}
+
+// Apply splits posts into kept and removed ones. Posts are checked in the order of their timestamps,
+// so the earliest posts of an author are kept. Kept posts preserve the input order.
+func (f *Filter) Apply(posts []data.Post) ([]data.Post, []FilteredPost) {
+	if f == nil {
+		return posts, nil
+	}
+	order := make([]int, len(posts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return posts[order[i]].Timestamp < posts[order[j]].Timestamp
+	})
+
+	type authorCell struct {
+		author     string
+		x, y, hour int64
+	}
+	authors := map[authorCell]int{}
+	similarity := f.cfg.DuplicateSimilarity
+	if similarity <= 0 {
+		similarity = defaultDuplicateSimilarity
+	}
+	captions := map[string][]map[string]bool{}
+	removed := make(map[int]FilterReason)
+	for _, i := range order {
+		post := posts[i]
+		if f.bots[post.AuthorID] {
+			removed[i] = BotReason
+			continue
+		}
+		if f.cfg.ExcludeAds && post.IsAd {
+			removed[i] = AdReason
+			continue
+		}
+		if f.cfg.MaxAuthorPosts > 0 && f.cfg.CellSize > 0 {
+			k := authorCell{
+				author: post.AuthorID,
+				x:      int64(math.Floor(post.Lon / f.cfg.CellSize)),
+				y:      int64(math.Floor(post.Lat / f.cfg.CellSize)),
+				hour:   post.Timestamp / 3600,
+			}
+			authors[k]++
+			if authors[k] > f.cfg.MaxAuthorPosts {
+				removed[i] = RateLimitReason
+				continue
+			}
+		}
+		if f.cfg.DuplicateCaptions {
+			c := normalizeCaption(post.Caption)
+			if len([]rune(c)) >= f.cfg.MinCaptionLength && c != "" {
+				sh := shingles(c)
+				dup := false
+				for _, prev := range captions[post.AuthorID] {
+					if jaccard(sh, prev) >= similarity {
+						dup = true
+						break
+					}
+				}
+				if dup {
+					removed[i] = DuplicateReason
+					continue
+				}
+				captions[post.AuthorID] = append(captions[post.AuthorID], sh)
+			}
+		}
+	}
+	if len(removed) == 0 {
+		return posts, nil
+	}
+	kept := make([]data.Post, 0, len(posts)-len(removed))
+	filtered := make([]FilteredPost, 0, len(removed))
+	for i, post := range posts {
+		if r, ok := removed[i]; ok {
+			filtered = append(filtered, FilteredPost{Post: post, Reason: r})
+			continue
+		}
+		kept = append(kept, post)
+	}
+	return kept, filtered
+}
+
+// normalizeCaption returns the caption in lower case without tags, mentions and characters other
+// than letters.
+func normalizeCaption(caption string) string {
+	words := []string{}
+	for _, w := range strings.Fields(caption) {
+		if strings.HasPrefix(w, "#") || strings.HasPrefix(w, "@") {
+			continue
+		}
+		w = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, w)
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// shingles returns the set of substrings of shingleSize characters of the caption, a shorter caption
+// is the only shingle of itself.
+func shingles(caption string) map[string]bool {
+	rs := []rune(caption)
+	if len(rs) <= shingleSize {
+		return map[string]bool{caption: true}
+	}
+	res := make(map[string]bool, len(rs)-shingleSize+1)
+	for i := 0; i+shingleSize <= len(rs); i++ {
+		res[string(rs[i:i+shingleSize])] = true
+	}
+	return res
+}
+
+// jaccard returns the Jaccard similarity of two sets of shingles.
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	common := 0
+	for s := range a {
+		if b[s] {
+			common++
+		}
+	}
+	union := len(a) + len(b) - common
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
+
+// filterStats counts removed posts located in the bounds of the tree node.
+func filterStats(tree *convtree.ConvTree, removed []FilteredPost) data.FilterStats {
+	stats := data.FilterStats{}
+	minX, maxX := math.Min(tree.TopLeft.X, tree.BottomRight.X), math.Max(tree.TopLeft.X, tree.BottomRight.X)
+	minY, maxY := math.Min(tree.TopLeft.Y, tree.BottomRight.Y), math.Max(tree.TopLeft.Y, tree.BottomRight.Y)
+	for _, fp := range removed {
+		p := fp.Post
+		if p.Lon < minX || p.Lon > maxX || p.Lat < minY || p.Lat > maxY {
+			continue
+		}
+		switch fp.Reason {
+		case BotReason:
+			stats.Bots++
+		case AdReason:
+			stats.Ads++
+		case RateLimitReason:
+			stats.RateLimited++
+		case DuplicateReason:
+			stats.Duplicates++
+		}
+	}
+	return stats
+}
@@ -0,0 +1,96 @@
+package detection
+
+import (
+	"reflect"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+func TestFilter_Apply(t *testing.T) {
+	cfg := FilterConfig{
+		ExcludeAds:        true,
+		MaxAuthorPosts:    2,
+		CellSize:          0.01,
+		DuplicateCaptions: true,
+		MinCaptionLength:  10,
+	}
+	posts := []data.Post{
+		{Shortcode: "a1", AuthorID: "a", Timestamp: 100, Lat: 60.001, Lon: 30.001},
+		{Shortcode: "bot", AuthorID: "bot", Timestamp: 110, Lat: 60.001, Lon: 30.001},
+		{Shortcode: "ad", AuthorID: "b", Timestamp: 120, IsAd: true, Lat: 60.001, Lon: 30.001},
+		{Shortcode: "a3", AuthorID: "a", Timestamp: 300, Lat: 60.002, Lon: 30.002},
+		{Shortcode: "a2", AuthorID: "a", Timestamp: 200, Lat: 60.002, Lon: 30.002},
+		{Shortcode: "a4", AuthorID: "a", Timestamp: 200, Lat: 60.5, Lon: 30.5},
+		{Shortcode: "c1", AuthorID: "c", Timestamp: 100, Caption: "Best coffee in town! #coffee", Lat: 60.1, Lon: 30.1},
+		{Shortcode: "c2", AuthorID: "c", Timestamp: 150, Caption: "best coffee, in town 2020 #spb @shop", Lat: 60.2, Lon: 30.2},
+		{Shortcode: "c3", AuthorID: "c", Timestamp: 155, Caption: "The best coffee in town!", Lat: 60.2, Lon: 30.2},
+		{Shortcode: "c4", AuthorID: "c", Timestamp: 158, Caption: "Rainy evening at the embankment", Lat: 60.3, Lon: 30.3},
+		{Shortcode: "d1", AuthorID: "d", Timestamp: 150, Caption: "best coffee, in town 2020 #spb @shop", Lat: 60.2, Lon: 30.2},
+		{Shortcode: "e1", AuthorID: "e", Timestamp: 160, Caption: "#coffee", Lat: 60.2, Lon: 30.2},
+		{Shortcode: "f1", AuthorID: "f", Timestamp: 170, Caption: "#coffee", Lat: 60.2, Lon: 30.2},
+	}
+	f := NewFilter(cfg, []data.Bot{{AuthorID: "bot", Reason: "manual"}})
+	kept, removed := f.Apply(posts)
+
+	keptCodes := []string{}
+	for _, p := range kept {
+		keptCodes = append(keptCodes, p.Shortcode)
+	}
+	// captions of other authors are not compared
+	wantKept := []string{"a1", "a2", "a4", "c1", "c4", "d1", "e1", "f1"}
+	if !reflect.DeepEqual(keptCodes, wantKept) {
+		t.Errorf("Apply() kept = %v, want %v", keptCodes, wantKept)
+	}
+	reasons := map[string]FilterReason{}
+	for _, fp := range removed {
+		reasons[fp.Post.Shortcode] = fp.Reason
+	}
+	wantRemoved := map[string]FilterReason{
+		"bot": BotReason,
+		"ad":  AdReason,
+		"a3":  RateLimitReason,
+		"c2":  DuplicateReason,
+		"c3":  DuplicateReason,
+	}
+	if !reflect.DeepEqual(reasons, wantRemoved) {
+		t.Errorf("Apply() removed = %v, want %v", reasons, wantRemoved)
+	}
+
+	tree := &convtree.ConvTree{
+		TopLeft:     convtree.Point{X: 30, Y: 60.05},
+		BottomRight: convtree.Point{X: 30.05, Y: 60},
+	}
+	stats := filterStats(tree, removed)
+	wantStats := data.FilterStats{Bots: 1, Ads: 1, RateLimited: 1}
+	if !reflect.DeepEqual(stats, wantStats) {
+		t.Errorf("filterStats() = %v, want %v", stats, wantStats)
+	}
+}
+
+func TestFilter_ApplyNil(t *testing.T) {
+	var f *Filter
+	posts := []data.Post{{Shortcode: "a"}}
+	kept, removed := f.Apply(posts)
+	if len(kept) != 1 || len(removed) != 0 {
+		t.Errorf("Apply() on nil filter = %v, %v", kept, removed)
+	}
+}
+
+func Test_jaccard(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"best coffee", "best coffee", 1},
+		{"abcde", "bcdef", 1.0 / 3},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+	}
+	for _, tt := range tests {
+		if got := jaccard(shingles(tt.a), shingles(tt.b)); got != tt.want {
+			t.Errorf("jaccard(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
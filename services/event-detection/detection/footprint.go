@@ -0,0 +1,117 @@
+package detection
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371000.0
+
+type geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// eventFootprint returns the hull, the bounding box and the radius of the event posts. The cell is
+// set by the caller.
+func eventFootprint(posts []data.Post, center data.Point) data.Footprint {
+	f := data.Footprint{}
+	if len(posts) == 0 {
+		return f
+	}
+	seen := map[[2]float64]bool{}
+	points := [][2]float64{}
+	tl := data.Point{Lat: posts[0].Lat, Lon: posts[0].Lon}
+	br := tl
+	for _, post := range posts {
+		p := [2]float64{post.Lon, post.Lat}
+		if !seen[p] {
+			seen[p] = true
+			points = append(points, p)
+		}
+		tl.Lon = math.Min(tl.Lon, post.Lon)
+		tl.Lat = math.Max(tl.Lat, post.Lat)
+		br.Lon = math.Max(br.Lon, post.Lon)
+		br.Lat = math.Min(br.Lat, post.Lat)
+		f.Radius = math.Max(f.Radius, Distance(center.Lat, center.Lon, post.Lat, post.Lon))
+	}
+	f.BBox = data.Area{TopLeft: &tl, BotRight: &br}
+	f.Geometry = hullGeometry(convexHull(points))
+	return f
+}
+
+// cellArea returns the bounds of the ConvTree leaf.
+func cellArea(tree *convtree.ConvTree) data.Area {
+	return data.Area{
+		TopLeft:  &data.Point{Lat: tree.TopLeft.Y, Lon: tree.TopLeft.X},
+		BotRight: &data.Point{Lat: tree.BottomRight.Y, Lon: tree.BottomRight.X},
+	}
+}
+
+// convexHull returns the convex hull of unique points in counter-clockwise order without collinear
+// points, it is built with the monotone chain algorithm.
+func convexHull(points [][2]float64) [][2]float64 {
+	if len(points) < 3 {
+		return points
+	}
+	ps := make([][2]float64, len(points))
+	copy(ps, points)
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i][0] != ps[j][0] {
+			return ps[i][0] < ps[j][0]
+		}
+		return ps[i][1] < ps[j][1]
+	})
+	cross := func(o, a, b [2]float64) float64 {
+		return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+	}
+	hull := make([][2]float64, 0, 2*len(ps))
+	for _, p := range ps {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(ps) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], ps[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, ps[i])
+	}
+	return hull[:len(hull)-1]
+}
+
+// hullGeometry encodes the hull as a GeoJSON point, line or polygon depending on the number of
+// its vertices.
+func hullGeometry(hull [][2]float64) string {
+	var g geometry
+	switch len(hull) {
+	case 0:
+		return ""
+	case 1:
+		g = geometry{Type: "Point", Coordinates: hull[0]}
+	case 2:
+		g = geometry{Type: "LineString", Coordinates: hull}
+	default:
+		ring := append(append([][2]float64{}, hull...), hull[0])
+		g = geometry{Type: "Polygon", Coordinates: [][][2]float64{ring}}
+	}
+	b, _ := json.Marshal(g)
+	return string(b)
+}
+
+// Distance returns the great-circle distance between two points in meters.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
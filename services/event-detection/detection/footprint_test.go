@@ -0,0 +1,52 @@
+package detection
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func Test_eventFootprint(t *testing.T) {
+	posts := []data.Post{
+		{Lat: 59.90, Lon: 30.30},
+		{Lat: 59.90, Lon: 30.32},
+		{Lat: 59.92, Lon: 30.32},
+		{Lat: 59.92, Lon: 30.30},
+		{Lat: 59.91, Lon: 30.31},
+		{Lat: 59.91, Lon: 30.31},
+	}
+	center := data.Point{Lat: 59.91, Lon: 30.31}
+	f := eventFootprint(posts, center)
+	var g struct {
+		Type        string
+		Coordinates [][][2]float64
+	}
+	if err := json.Unmarshal([]byte(f.Geometry), &g); err != nil {
+		t.Fatalf("invalid geometry %v: %v", f.Geometry, err)
+	}
+	if g.Type != "Polygon" || len(g.Coordinates) != 1 || len(g.Coordinates[0]) != 5 {
+		t.Errorf("geometry = %v, want the square ring without the inner point", f.Geometry)
+	}
+	if ring := g.Coordinates[0]; ring[0] != ring[len(ring)-1] {
+		t.Errorf("ring is not closed: %v", ring)
+	}
+	if f.BBox.TopLeft.Lat != 59.92 || f.BBox.TopLeft.Lon != 30.30 || f.BBox.BotRight.Lat != 59.90 || f.BBox.BotRight.Lon != 30.32 {
+		t.Errorf("bbox = %v %v", f.BBox.TopLeft, f.BBox.BotRight)
+	}
+	want := Distance(center.Lat, center.Lon, 59.90, 30.30)
+	if math.Abs(f.Radius-want) > 1e-6 || f.Radius < 1000 || f.Radius > 1500 {
+		t.Errorf("radius = %v, want %v", f.Radius, want)
+	}
+
+	line := eventFootprint(posts[:2], center)
+	if !strings.HasPrefix(line.Geometry, `{"type":"LineString",`) {
+		t.Errorf("geometry of two posts = %v", line.Geometry)
+	}
+	point := eventFootprint(posts[4:], center)
+	if point.Geometry != `{"type":"Point","coordinates":[30.31,59.91]}` || point.Radius != 0 {
+		t.Errorf("footprint of one place = %+v", point)
+	}
+}
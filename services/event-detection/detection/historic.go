@@ -19,28 +19,51 @@ const (
 	gridSize   = 10
 )
 
-func HistoricGrid(data []data.Post, topLeft, bottomRight data.Point, maxPoints int, tz string, gridSize float64) (convtree.ConvTree, error) {
-	posts, numDays, err := splitPosts(data, tz, topLeft, gridSize)
-	if err != nil {
-		unilog.Logger().Error("unable to split posts", zap.Error(err))
-		return convtree.ConvTree{}, err
-	}
-	averagedPosts := map[convtree.Point]float64{}
-	for coord, data := range posts {
-		if len(data) > 0 {
-			averagedPosts[coord] = filterPosts(data, numDays)
-		}
+// TreeParams holds parameters of the ConvTree which is used as a historic grid.
+type TreeParams struct {
+	MinXLength float64
+	MinYLength float64
+	MaxDepth   int
+	ConvNumber int
+	GridSize   int
+}
+
+// DefaultTreeParams are the tree parameters used by HistoricGrid.
+var DefaultTreeParams = TreeParams{
+	MinXLength: minXLength,
+	MinYLength: minYLength,
+	MaxDepth:   maxDepth,
+	ConvNumber: convNumber,
+	GridSize:   gridSize,
+}
+
+// GridKey returns the key of the historic grid for the hour of t, which is month*1000 + dayType*100 + hour,
+// where dayType is 1 for weekdays and 2 for weekends.
+func GridKey(t time.Time) int64 {
+	var dayType int64
+	switch t.Weekday() {
+	case time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday:
+		dayType = 1
+	case time.Saturday, time.Sunday:
+		dayType = 2
 	}
-	tree, err := buildGrid(averagedPosts, topLeft, bottomRight, maxPoints)
+	return int64(t.Month())*1000 + dayType*100 + int64(t.Hour())
+}
+
+func HistoricGrid(data []data.Post, topLeft, bottomRight data.Point, maxPoints int, tz string, gridSize float64) (convtree.ConvTree, error) {
+	return HistoricGridParams(data, topLeft, bottomRight, maxPoints, tz, gridSize, DefaultTreeParams)
+}
+
+// HistoricGridParams is HistoricGrid with custom parameters of the tree.
+func HistoricGridParams(data []data.Post, topLeft, bottomRight data.Point, maxPoints int, tz string, gridSize float64, tp TreeParams) (convtree.ConvTree, error) {
+	stats, err := NewGridStats(data, topLeft, bottomRight, maxPoints, tz, gridSize, tp)
 	if err != nil {
-		unilog.Logger().Error("unable to build historic grid", zap.Error(err))
 		return convtree.ConvTree{}, err
 	}
-	tree.Clear()
-	return tree, nil
+	return stats.Grid()
 }
 
-func buildGrid(postData map[convtree.Point]float64, topLeft, bottomRight data.Point, maxPoints int) (convtree.ConvTree, error) {
+func buildGrid(postData map[convtree.Point]float64, topLeft, bottomRight data.Point, maxPoints int, tp TreeParams) (convtree.ConvTree, error) {
 	points := []convtree.Point{}
 	for coord, data := range postData {
 		numToAdd := int(data)
@@ -64,14 +87,27 @@ func buildGrid(postData map[convtree.Point]float64, topLeft, bottomRight data.Po
 		Y:      bottomRight.Lat,
 		Weight: 1,
 	}
-	tree, err := convtree.NewConvTree(tl, br, minXLength, minYLength, maxPoints, maxDepth, convNumber, gridSize, nil, points)
+	tree, err := convtree.NewConvTree(tl, br, tp.MinXLength, tp.MinYLength, maxPoints, tp.MaxDepth, tp.ConvNumber, tp.GridSize, nil, points)
 	if err != nil {
 		unilog.Logger().Error("unalble to create ConvTree", zap.Error(err))
 	}
 	return tree, err
 }
 
-func filterPosts(posts map[string]int, numDays int) float64 {
+// outlierDeviations is the number of standard deviations above the mean starting from which a number
+// of posts in a cell is an outlier.
+const outlierDeviations = 2
+
+// isOutlier reports whether n posts in a cell are an outlier for the cell with the given mean and
+// standard deviation of the daily numbers of posts.
+func isOutlier(n, avg, std float64) bool {
+	return n > avg+outlierDeviations*std
+}
+
+// cellStats returns the mean number of posts of the days with posts in the cell which are not
+// outliers, and the mean and the standard deviation of the numbers of posts of all numDays days
+// including days without posts, which set the bound of outliers.
+func cellStats(posts map[string]int, numDays int) (mean, avg, std float64) {
 	data := []float64{}
 	for _, v := range posts {
 		data = append(data, float64(v))
@@ -80,25 +116,22 @@ func filterPosts(posts map[string]int, numDays int) float64 {
 	for i := 0; i < diff; i++ {
 		data = append(data, 0.0)
 	}
-	if len(data) > 1 {
-		avg := stat.Mean(data, nil)
-		std := stat.StdDev(data, nil)
-		maxValue := avg + 2*std
-		res := []float64{}
-		for _, v := range posts {
-			val := float64(v)
-			if val <= maxValue {
-				res = append(res, val)
-			}
-		}
-		mean := 0.0
-		if len(res) > 0 {
-			mean = stat.Mean(res, nil)
+	if len(data) == 1 {
+		return data[0], data[0], 0
+	}
+	avg = stat.Mean(data, nil)
+	std = stat.StdDev(data, nil)
+	res := []float64{}
+	for _, v := range posts {
+		val := float64(v)
+		if !isOutlier(val, avg, std) {
+			res = append(res, val)
 		}
-		return mean
-	} else {
-		return data[0]
 	}
+	if len(res) > 0 {
+		mean = stat.Mean(res, nil)
+	}
+	return mean, avg, std
 }
 
 func splitPosts(data []data.Post, tz string, topLeft data.Point, gridSize float64) (map[convtree.Point]map[string]int, int, error) {
@@ -0,0 +1,193 @@
+package detection
+
+import (
+	"errors"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// ParamsKey is the key under which the parameters of the historic grids of a city are stored
+// together with them.
+const ParamsKey int64 = 1
+
+const (
+	defaultMaxPoints  = 6
+	defaultMinUsers   = 2
+	defaultUsersShare = 0.5
+	defaultTopPosts   = 5
+	defaultMaxTags    = 10
+	defaultWindow     = 3600
+)
+
+// Params holds parameters of the detection algorithm.
+type Params struct {
+	// MaxPoints is the number of posts in a grid cell starting from which it is checked for events.
+	MaxPoints int
+	Tree      TreeParams
+	// MinUsers is the minimum number of authors of an event.
+	MinUsers int
+	// UsersShare is the minimum number of authors of an event relative to MaxPoints.
+	UsersShare float64
+	// TopPosts is the number of representative posts of an event.
+	TopPosts int
+	// MaxTags is the maximum number of tags of an event.
+	MaxTags int
+	// WindowLength and WindowStride are the length of detection windows and the interval between
+	// their starts in seconds.
+	WindowLength int64
+	WindowStride int64
+	// Language is the ISO 639-1 code of the language of the city which selects the stoplist of
+	// tags, generic English tags are stoplisted for every language.
+	Language string
+}
+
+// DefaultParams are the parameters used if neither the request nor the config sets them.
+var DefaultParams = Params{
+	MaxPoints:  defaultMaxPoints,
+	Tree:       DefaultTreeParams,
+	MinUsers:   defaultMinUsers,
+	UsersShare: defaultUsersShare,
+	TopPosts:   defaultTopPosts,
+	MaxTags:    defaultMaxTags,
+	// hourly windows aligned to the start of the detection period
+	WindowLength: defaultWindow,
+	WindowStride: defaultWindow,
+}
+
+var (
+	ErrInvalidMaxPoints = errors.New("max points must be positive")
+	ErrInvalidTree      = errors.New("tree lengths, depth, convolution number and grid size must be positive")
+	ErrInvalidUsers     = errors.New("min users must be positive and users share must be in (0, 1]")
+	ErrInvalidSummary   = errors.New("numbers of top posts and tags must be positive")
+	ErrInvalidWindow    = errors.New("window length must be positive and not less than window stride")
+	ErrInvalidLanguage  = errors.New("there is no stoplist of the language")
+	ErrSearchTree       = errors.New("tree parameters are used only when historic grids are built")
+)
+
+// ParamsFromProto converts parameters from the request, zero values are kept as they are.
+func ParamsFromProto(dp *data.DetectionParams) Params {
+	if dp == nil {
+		return Params{}
+	}
+	return Params{
+		MaxPoints: int(dp.MaxPoints),
+		Tree: TreeParams{
+			MinXLength: dp.MinXLength,
+			MinYLength: dp.MinYLength,
+			MaxDepth:   int(dp.MaxDepth),
+			ConvNumber: int(dp.ConvNumber),
+			GridSize:   int(dp.ConvGridSize),
+		},
+		MinUsers:     int(dp.MinUsers),
+		UsersShare:   dp.UsersShare,
+		TopPosts:     int(dp.TopPosts),
+		MaxTags:      int(dp.MaxTags),
+		WindowLength: dp.WindowLength,
+		WindowStride: dp.WindowStride,
+		Language:     dp.Language,
+	}
+}
+
+func (p Params) Proto() data.DetectionParams {
+	return data.DetectionParams{
+		MaxPoints:    int32(p.MaxPoints),
+		MinXLength:   p.Tree.MinXLength,
+		MinYLength:   p.Tree.MinYLength,
+		MaxDepth:     int32(p.Tree.MaxDepth),
+		ConvNumber:   int32(p.Tree.ConvNumber),
+		ConvGridSize: int32(p.Tree.GridSize),
+		MinUsers:     int32(p.MinUsers),
+		UsersShare:   p.UsersShare,
+		TopPosts:     int32(p.TopPosts),
+		MaxTags:      int32(p.MaxTags),
+		WindowLength: p.WindowLength,
+		WindowStride: p.WindowStride,
+		Language:     p.Language,
+	}
+}
+
+// Merge returns p with the non-zero values of o.
+func (p Params) Merge(o Params) Params {
+	if o.MaxPoints != 0 {
+		p.MaxPoints = o.MaxPoints
+	}
+	if o.Tree.MinXLength != 0 {
+		p.Tree.MinXLength = o.Tree.MinXLength
+	}
+	if o.Tree.MinYLength != 0 {
+		p.Tree.MinYLength = o.Tree.MinYLength
+	}
+	if o.Tree.MaxDepth != 0 {
+		p.Tree.MaxDepth = o.Tree.MaxDepth
+	}
+	if o.Tree.ConvNumber != 0 {
+		p.Tree.ConvNumber = o.Tree.ConvNumber
+	}
+	if o.Tree.GridSize != 0 {
+		p.Tree.GridSize = o.Tree.GridSize
+	}
+	if o.MinUsers != 0 {
+		p.MinUsers = o.MinUsers
+	}
+	if o.UsersShare != 0 {
+		p.UsersShare = o.UsersShare
+	}
+	if o.TopPosts != 0 {
+		p.TopPosts = o.TopPosts
+	}
+	if o.MaxTags != 0 {
+		p.MaxTags = o.MaxTags
+	}
+	if o.WindowLength != 0 {
+		p.WindowLength = o.WindowLength
+	}
+	if o.WindowStride != 0 {
+		p.WindowStride = o.WindowStride
+	}
+	if o.Language != "" {
+		p.Language = o.Language
+	}
+	return p
+}
+
+// Validate checks merged parameters, so all of them must be set.
+func (p Params) Validate() error {
+	if p.MaxPoints <= 0 {
+		return ErrInvalidMaxPoints
+	}
+	t := p.Tree
+	if t.MinXLength <= 0 || t.MinYLength <= 0 || t.MaxDepth <= 0 || t.ConvNumber <= 0 || t.GridSize <= 0 {
+		return ErrInvalidTree
+	}
+	if p.MinUsers <= 0 || p.UsersShare <= 0 || p.UsersShare > 1 {
+		return ErrInvalidUsers
+	}
+	if p.TopPosts <= 0 || p.MaxTags <= 0 {
+		return ErrInvalidSummary
+	}
+	if p.WindowLength <= 0 || p.WindowStride <= 0 || p.WindowStride > p.WindowLength {
+		return ErrInvalidWindow
+	}
+	if _, ok := stoplists[p.Language]; p.Language != "" && !ok {
+		return ErrInvalidLanguage
+	}
+	return nil
+}
+
+// ValidateSearch checks parameters of an event search before they are merged. Tree parameters are
+// rejected because the search uses the grids which are already built with their own tree.
+func (p Params) ValidateSearch() error {
+	if p.Tree != (TreeParams{}) {
+		return ErrSearchTree
+	}
+	return nil
+}
+
+// minEventUsers is the minimum number of authors of an event.
+func (p Params) minEventUsers() int {
+	n := int(float64(p.MaxPoints) * p.UsersShare)
+	if n < p.MinUsers {
+		return p.MinUsers
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+package detection
+
+import (
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func TestParams_Merge(t *testing.T) {
+	req := ParamsFromProto(&data.DetectionParams{MaxPoints: 10, MaxDepth: 5})
+	p := DefaultParams.Merge(req)
+	if p.MaxPoints != 10 || p.Tree.MaxDepth != 5 {
+		t.Errorf("Merge() didn't take request values: %+v", p)
+	}
+	if p.Tree.MinXLength != DefaultTreeParams.MinXLength || p.MinUsers != DefaultParams.MinUsers {
+		t.Errorf("Merge() didn't keep defaults: %+v", p)
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	dp := p.Proto()
+	if got := ParamsFromProto(&dp); got != p {
+		t.Errorf("ParamsFromProto(Proto()) = %+v, want %+v", got, p)
+	}
+}
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  data.DetectionParams
+		want error
+	}{
+		{"negative max points", data.DetectionParams{MaxPoints: -1}, ErrInvalidMaxPoints},
+		{"negative depth", data.DetectionParams{MaxDepth: -3}, ErrInvalidTree},
+		{"share above one", data.DetectionParams{UsersShare: 1.5}, ErrInvalidUsers},
+		{"stride above length", data.DetectionParams{WindowLength: 3600, WindowStride: 7200}, ErrInvalidWindow},
+		{"unknown language", data.DetectionParams{Language: "xx"}, ErrInvalidLanguage},
+		{"valid", data.DetectionParams{MaxPoints: 20, UsersShare: 0.25, Language: "ru"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			if err := DefaultParams.Merge(ParamsFromProto(&req)).Validate(); err != tt.want {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateSearch(t *testing.T) {
+	if err := ParamsFromProto(&data.DetectionParams{MaxPoints: 10, WindowLength: 7200}).ValidateSearch(); err != nil {
+		t.Errorf("ValidateSearch() error = %v", err)
+	}
+	if err := ParamsFromProto(&data.DetectionParams{MaxDepth: 5}).ValidateSearch(); err != ErrSearchTree {
+		t.Errorf("ValidateSearch() = %v, want %v", err, ErrSearchTree)
+	}
+}
+
+func TestParams_minEventUsers(t *testing.T) {
+	tests := []struct {
+		p    Params
+		want int
+	}{
+		{Params{MaxPoints: 6, MinUsers: 2, UsersShare: 0.5}, 3},
+		{Params{MaxPoints: 7, MinUsers: 2, UsersShare: 0.5}, 3},
+		{Params{MaxPoints: 3, MinUsers: 2, UsersShare: 0.5}, 2},
+		{Params{MaxPoints: 20, MinUsers: 2, UsersShare: 0.25}, 5},
+	}
+	for _, tt := range tests {
+		if got := tt.p.minEventUsers(); got != tt.want {
+			t.Errorf("minEventUsers(%+v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
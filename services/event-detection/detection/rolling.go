@@ -0,0 +1,145 @@
+package detection
+
+import (
+	"math"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// StatsKeyOffset is added to the key of a historic grid to get the key of its statistics.
+const StatsKeyOffset = 100000
+
+// minCellMean is the mean number of posts over all days below which a cell is removed from the
+// statistics.
+const minCellMean = 0.01
+
+// StatsKey returns the key of the statistics of the historic grid with the key gridKey.
+func StatsKey(gridKey int64) int64 {
+	return gridKey + StatsKeyOffset
+}
+
+// GridStats holds the mean daily numbers of posts in the cells of a historic grid together with
+// everything needed to rebuild the grid from them. Cells are keyed by their top left corners as
+// longitude and latitude. Spread holds the mean and the variance of the numbers of posts in the
+// cells over all days including days without posts, which set the bound of outliers. Last is the
+// timestamp of the last hour taken into account and Version is the number of updates of the
+// statistics.
+type GridStats struct {
+	TopLeft   data.Point
+	BotRight  data.Point
+	GridSize  float64
+	MaxPoints int
+	Tree      TreeParams
+	Cells     map[[2]float64]float64
+	Spread    map[[2]float64][2]float64
+	Last      int64
+	Version   int64
+}
+
+// NewGridStats computes statistics of the historic grid from posts of all hours with the same grid key.
+func NewGridStats(posts []data.Post, topLeft, bottomRight data.Point, maxPoints int, tz string, gridSize float64, tp TreeParams) (GridStats, error) {
+	split, numDays, err := splitPosts(posts, tz, topLeft, gridSize)
+	if err != nil {
+		unilog.Logger().Error("unable to split posts", zap.Error(err))
+		return GridStats{}, err
+	}
+	s := GridStats{
+		TopLeft:   topLeft,
+		BotRight:  bottomRight,
+		GridSize:  gridSize,
+		MaxPoints: maxPoints,
+		Tree:      tp,
+		Cells:     map[[2]float64]float64{},
+		Spread:    map[[2]float64][2]float64{},
+	}
+	for coord, days := range split {
+		if len(days) > 0 {
+			c := [2]float64{coord.X, coord.Y}
+			mean, avg, std := cellStats(days, numDays)
+			s.Cells[c] = mean
+			s.Spread[c] = [2]float64{avg, std * std}
+		}
+	}
+	for _, post := range posts {
+		if post.Timestamp > s.Last {
+			s.Last = post.Timestamp
+		}
+	}
+	return s, nil
+}
+
+// Grid builds the historic grid from the statistics.
+func (s GridStats) Grid() (convtree.ConvTree, error) {
+	cells := make(map[convtree.Point]float64, len(s.Cells))
+	for c, v := range s.Cells {
+		cells[convtree.Point{X: c[0], Y: c[1]}] = v
+	}
+	tree, err := buildGrid(cells, s.TopLeft, s.BotRight, s.MaxPoints, s.Tree)
+	if err != nil {
+		unilog.Logger().Error("unable to build historic grid", zap.Error(err))
+		return convtree.ConvTree{}, err
+	}
+	tree.Clear()
+	return tree, nil
+}
+
+// Update adds the positions of posts of the hour starting at the hour timestamp to the statistics
+// as one more day. The estimate is the one of NewGridStats with exponentially weighted moving
+// averages of the given rate: the spread of a cell is updated with every day, including days
+// without posts, and the mean only with days with posts which are not outliers. Cells whose mean
+// number of posts over all days drops below minCellMean are removed. Hours not later than Last are
+// ignored, the result reports whether the statistics were changed.
+func (s *GridStats) Update(points []data.Point, hour int64, rate float64) bool {
+	if hour <= s.Last {
+		return false
+	}
+	counts := map[[2]float64]float64{}
+	for _, p := range points {
+		lat := s.TopLeft.Lat + float64(int((p.Lat-s.TopLeft.Lat)/s.GridSize))*s.GridSize
+		lon := s.TopLeft.Lon + float64(int((p.Lon-s.TopLeft.Lon)/s.GridSize))*s.GridSize
+		counts[[2]float64{lon, lat}]++
+	}
+	if s.Cells == nil {
+		s.Cells = map[[2]float64]float64{}
+	}
+	if s.Spread == nil {
+		s.Spread = map[[2]float64][2]float64{}
+	}
+	for c := range counts {
+		if _, ok := s.Cells[c]; !ok {
+			s.Cells[c] = 0
+		}
+	}
+	for c, mean := range s.Cells {
+		spread, ok := s.Spread[c]
+		if !ok {
+			// statistics built before the spread was kept
+			spread = [2]float64{mean, mean}
+		}
+		avg, variance := spread[0], spread[1]
+		n := counts[c]
+		if n > 0 && !isOutlier(n, avg, math.Sqrt(variance)) {
+			if mean == 0 {
+				mean = n
+			} else {
+				mean = (1-rate)*mean + rate*n
+			}
+		}
+		diff := n - avg
+		avg += rate * diff
+		variance = (1 - rate) * (variance + rate*diff*diff)
+		if avg < minCellMean {
+			delete(s.Cells, c)
+			delete(s.Spread, c)
+			continue
+		}
+		s.Cells[c] = mean
+		s.Spread[c] = [2]float64{avg, variance}
+	}
+	s.Last = hour
+	s.Version++
+	return true
+}
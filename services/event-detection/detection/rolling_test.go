@@ -0,0 +1,117 @@
+package detection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func TestGridStats_Update(t *testing.T) {
+	tl := data.Point{Lat: 60, Lon: 30}
+	br := data.Point{Lat: 59.9, Lon: 30.1}
+	// two days of the same hour with 4 and 2 posts in one cell
+	day := int64(1514764800)
+	posts := []data.Post{}
+	for i := 0; i < 4; i++ {
+		posts = append(posts, data.Post{Timestamp: day + int64(i), Lat: 59.995, Lon: 30.005})
+	}
+	for i := 0; i < 2; i++ {
+		posts = append(posts, data.Post{Timestamp: day + 86400 + int64(i), Lat: 59.995, Lon: 30.005})
+	}
+	s, err := NewGridStats(posts, tl, br, 6, "UTC", 0.01, DefaultTreeParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// cells are keyed by the corner nearest to the top left corner of the city
+	cell := [2]float64{30, 60}
+	if len(s.Cells) != 1 || s.Cells[cell] != 3 {
+		t.Fatalf("NewGridStats() cells = %v, want mean 3 in %v", s.Cells, cell)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		t.Fatal(err)
+	}
+	var restored GridStats
+	if err := gob.NewDecoder(&buf).Decode(&restored); err != nil {
+		t.Fatal(err)
+	}
+
+	if restored.Update(nil, day, 0.5) {
+		t.Errorf("Update() took an hour which is already in the statistics")
+	}
+	// the mean is 3 and the standard deviation is sqrt(2) over both days
+	if got := restored.Spread[cell]; math.Abs(got[0]-3) > 1e-9 || math.Abs(got[1]-2) > 1e-9 {
+		t.Fatalf("spread = %v, want [3 2]", got)
+	}
+	next := day + 2*86400
+	hour := []data.Point{{Lat: 59.995, Lon: 30.005}, {Lat: 59.955, Lon: 30.055}}
+	if !restored.Update(hour, next, 0.5) {
+		t.Fatalf("Update() ignored a new hour")
+	}
+	if got := restored.Cells[cell]; math.Abs(got-2) > 1e-9 {
+		t.Errorf("cell mean = %v, want 2", got)
+	}
+	if len(restored.Cells) != 2 {
+		t.Fatalf("cells = %v, want 2", restored.Cells)
+	}
+	var other [2]float64
+	for c := range restored.Cells {
+		if c != cell {
+			other = c
+		}
+	}
+	// a single post in a cell without posts is an outlier, as for historic statistics
+	if got := restored.Cells[other]; got != 0 {
+		t.Errorf("new cell mean = %v, want 0", got)
+	}
+	if restored.Last != next || restored.Version != 1 {
+		t.Errorf("Last = %v, Version = %v", restored.Last, restored.Version)
+	}
+
+	// days without posts and outliers change only the spread
+	restored.Update(nil, next+86400, 0.5)
+	restored.Update([]data.Point{{Lat: 59.955, Lon: 30.055}}, next+2*86400, 0.5)
+	if got := restored.Cells[cell]; math.Abs(got-2) > 1e-9 {
+		t.Errorf("cell mean after an empty day = %v, want 2", got)
+	}
+	if got := restored.Cells[other]; math.Abs(got-1) > 1e-9 {
+		t.Errorf("new cell mean = %v, want 1", got)
+	}
+	many := make([]data.Point, 20)
+	for i := range many {
+		many[i] = data.Point{Lat: 59.995, Lon: 30.005}
+	}
+	restored.Update(many, next+3*86400, 0.5)
+	if got := restored.Cells[cell]; math.Abs(got-2) > 1e-9 {
+		t.Errorf("cell mean after an outlier = %v, want 2", got)
+	}
+	if got := restored.Spread[cell][0]; got < 10 {
+		t.Errorf("cell spread mean after an outlier = %v, want at least 10", got)
+	}
+	if _, err := restored.Grid(); err != nil {
+		t.Errorf("Grid() error = %v", err)
+	}
+}
+
+func TestGridStats_UpdateWithoutSpread(t *testing.T) {
+	// statistics built before the spread was kept use the mean as the mean and the variance
+	s := GridStats{
+		TopLeft:  data.Point{Lat: 60, Lon: 30},
+		BotRight: data.Point{Lat: 59.9, Lon: 30.1},
+		GridSize: 0.01,
+		Cells:    map[[2]float64]float64{{30, 60}: 4, {30.01, 60}: 0.01},
+	}
+	if !s.Update([]data.Point{{Lat: 59.995, Lon: 30.005}, {Lat: 59.995, Lon: 30.005}}, 1514764800, 0.5) {
+		t.Fatalf("Update() ignored a new hour")
+	}
+	if got := s.Cells[[2]float64{30, 60}]; math.Abs(got-3) > 1e-9 {
+		t.Errorf("cell mean = %v, want 3", got)
+	}
+	if _, ok := s.Cells[[2]float64{30.01, 60}]; ok {
+		t.Errorf("cell without posts is kept: %v", s.Cells)
+	}
+}
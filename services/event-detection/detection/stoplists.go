@@ -0,0 +1,62 @@
+package detection
+
+import "github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
+
+// stoplists contain curated generic hashtags of different languages without the leading '#'. Names
+// of cities and countries are not listed, they are generic in their own city only and are weighted
+// down by the tag model learned from its history.
+var stoplists = map[string][]string{
+	"en": {
+		"love", "instagood", "photooftheday", "fashion", "beautiful", "happy", "cute", "tbt", "like4like",
+		"followme", "picoftheday", "follow", "me", "selfie", "summer", "art", "instadaily", "friends",
+		"repost", "nature", "girl", "fun", "style", "smile", "food", "instalike", "likeforlike", "family",
+		"travel", "fitness", "igers", "tagsforlikes", "follow4follow", "nofilter", "life", "beauty",
+		"amazing", "instamood", "instagram", "photography", "photo", "vscocam", "sun", "music", "followforfollow",
+		"beach", "ootd", "bestoftheday", "sunset", "dog", "sky", "vsco", "l4l", "makeup", "f4f", "foodporn",
+		"hair", "pretty", "swag", "cat", "model", "motivation", "girls", "baby", "party", "cool", "lol",
+		"gym", "design", "instapic", "funny", "healthy", "night", "tflers", "yummy", "flowers", "lifestyle",
+		"hot", "instafood", "wedding", "fit", "handmade", "black", "pink", "blue", "work", "workout",
+		"blackandwhite", "drawing", "inspiration", "home", "holiday", "christmas", "sea",
+		"weekend", "goodmorning", "goodnight", "mood", "today", "tb", "latergram", "instacool", "picofday",
+	},
+	"ru": {
+		"любовь", "красота", "счастье", "друзья", "лето", "зима", "весна", "осень", "семья", "жизнь",
+		"природа", "фото", "фотография", "селфи", "я", "мода", "стиль", "настроение", "улыбка", "девушка",
+		"девушки", "отдых", "путешествия", "еда", "вкусно", "утро", "вечер", "ночь", "выходные", "праздник",
+		"дети", "ребенок", "спорт", "фитнес", "музыка", "искусство", "маникюр", "макияж", "подарок",
+		"инстаграм", "лайк", "лайки", "подписка", "взаимныелайки", "взаимнаяподписка",
+	},
+	"uk": {
+		"любов", "щастя", "друзі", "літо", "зима", "весна", "осінь", "родина", "життя", "природа", "фото",
+		"краса",
+	},
+	"de": {
+		"liebe", "sommer", "urlaub", "freunde", "familie", "natur", "glück", "schön", "essen", "leben",
+	},
+	"fr": {
+		"amour", "été", "vacances", "amis", "famille", "nature", "bonheur", "beau", "vie",
+	},
+	"es": {
+		"amor", "verano", "vacaciones", "amigos", "familia", "naturaleza", "felicidad", "vida",
+	},
+}
+
+// stopTags are normalized stoplists by languages.
+var stopTags = makeStopTags()
+
+func makeStopTags() map[string]map[string]bool {
+	res := map[string]map[string]bool{}
+	for lang, tags := range stoplists {
+		res[lang] = map[string]bool{}
+		for _, t := range tags {
+			res[lang][tokenizer.Normalize(t)] = true
+		}
+	}
+	return res
+}
+
+// isStopTag checks if the tag without the leading '#' is in the stoplist of the language. Generic
+// English tags are used all over the world, so they are checked for every language.
+func isStopTag(tag, language string) bool {
+	return stopTags["en"][tag] || stopTags[language][tag]
+}
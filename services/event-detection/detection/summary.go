@@ -0,0 +1,148 @@
+package detection
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// maxTitleLength is the length of the title column of the events table.
+const maxTitleLength = 100
+
+// summarize fills the title, representative posts, numbers of authors, likes and comments, the
+// location and the footprint of the event. Tags and the center of the event must be already set.
+func summarize(event *data.Event, e eventHolder, posts []data.Post, p Params, opts Options) {
+	evPosts := []data.Post{}
+	for _, post := range posts {
+		if e.posts[post.Shortcode] {
+			evPosts = append(evPosts, post)
+		}
+	}
+	event.Authors = int64(len(e.users))
+	for _, post := range evPosts {
+		event.Likes += post.LikesCount
+		event.Comments += post.CommentsCount
+	}
+	event.TopPosts = topPosts(evPosts, event.Center, p.TopPosts)
+	event.Location = eventLocation(evPosts, opts.Locations)
+	event.Title = summaryTitle(event.Tags, evPosts, event.Location, opts)
+	event.Footprint = eventFootprint(evPosts, event.Center)
+}
+
+// topPosts returns shortcodes of n posts with the highest engagement and the smallest distance to
+// the center. The distance is taken relative to the mean distance of the posts to the center.
+func topPosts(posts []data.Post, center data.Point, n int) []string {
+	type scored struct {
+		code  string
+		score float64
+	}
+	dists := make([]float64, len(posts))
+	mean := 0.0
+	for i, post := range posts {
+		dists[i] = math.Hypot(post.Lat-center.Lat, post.Lon-center.Lon)
+		mean += dists[i] / float64(len(posts))
+	}
+	if mean == 0 {
+		mean = 1
+	}
+	res := make([]scored, len(posts))
+	for i, post := range posts {
+		engagement := math.Log1p(float64(post.LikesCount + post.CommentsCount))
+		res[i] = scored{
+			code:  post.Shortcode,
+			score: (1 + engagement) / (1 + dists[i]/mean),
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].score != res[j].score {
+			return res[i].score > res[j].score
+		}
+		return res[i].code < res[j].code
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	codes := make([]string, len(res))
+	for i, r := range res {
+		codes[i] = r.code
+	}
+	return codes
+}
+
+// eventLocation returns the title of the most common location of the posts among the known ones.
+func eventLocation(posts []data.Post, locations map[string]string) string {
+	counts := map[string]int{}
+	for _, post := range posts {
+		if _, ok := locations[post.LocationID]; ok {
+			counts[post.LocationID]++
+		}
+	}
+	best, bestCount := "", 0
+	for id, c := range counts {
+		if c > bestCount || (c == bestCount && id < best) {
+			best, bestCount = id, c
+		}
+	}
+	return locations[best]
+}
+
+// summaryTitle builds the title from the main tag of the event, the tag which most often occurs
+// together with it in the same posts and the location, e.g. "rockfest gorkypark at Gorky Park".
+func summaryTitle(tags []string, posts []data.Post, location string, opts Options) string {
+	words := []string{}
+	if len(tags) > 0 {
+		main := eventTitle(tags, opts.TagModel)
+		words = append(words, strings.TrimLeft(main, "#@"))
+		if second := coTag(main, tags, posts, opts); second != "" {
+			words = append(words, strings.TrimLeft(second, "#@"))
+		}
+	}
+	title := strings.Join(words, " ")
+	if location != "" {
+		if title == "" {
+			title = location
+		} else {
+			title += " at " + location
+		}
+	}
+	r := []rune(title)
+	if len(r) > maxTitleLength {
+		title = strings.TrimSpace(string(r[:maxTitleLength]))
+	}
+	return title
+}
+
+// coTag returns the non-generic tag from tags which occurs in the largest number of posts together
+// with the main tag, ties are broken by the order of tags.
+func coTag(main string, tags []string, posts []data.Post, opts Options) string {
+	counts := map[string]int{}
+	for _, post := range posts {
+		pt := extractTags(post, opts.FilterTags)
+		found := false
+		for _, t := range pt {
+			if t == main {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		seen := map[string]bool{}
+		for _, t := range pt {
+			if t != main && !seen[t] {
+				seen[t] = true
+				counts[t]++
+			}
+		}
+	}
+	best, bestCount := "", 0
+	for _, t := range tags {
+		if counts[t] > bestCount && opts.TagModel.Weight(t) > 0 {
+			best, bestCount = t, counts[t]
+		}
+	}
+	return best
+}
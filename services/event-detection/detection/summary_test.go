@@ -0,0 +1,52 @@
+package detection
+
+import (
+	"reflect"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func Test_summarize(t *testing.T) {
+	posts := []data.Post{
+		{Shortcode: "a", AuthorID: "1", Caption: "#rockfest #gorkypark", LikesCount: 100, CommentsCount: 10, LocationID: "park", Lat: 55.7300, Lon: 37.6000},
+		{Shortcode: "b", AuthorID: "2", Caption: "#rockfest #gorkypark #moscow", LikesCount: 1, LocationID: "park", Lat: 55.7301, Lon: 37.6001},
+		{Shortcode: "c", AuthorID: "3", Caption: "#rockfest #music", LikesCount: 20, CommentsCount: 2, LocationID: "cafe", Lat: 55.7400, Lon: 37.6100},
+		{Shortcode: "d", AuthorID: "4", Caption: "#other", LikesCount: 1000, Lat: 55.7300, Lon: 37.6000},
+	}
+	e := eventHolder{
+		users: map[string]bool{"1": true, "2": true, "3": true},
+		posts: map[string]bool{"a": true, "b": true, "c": true},
+		tags:  map[string]int{"#rockfest": 3, "#gorkypark": 2, "#moscow": 1, "#music": 1},
+	}
+	p := DefaultParams
+	p.TopPosts = 2
+	opts := Options{
+		TagModel:  &TagModel{Docs: 1000, DF: map[string]int64{"#moscow": 900}},
+		Locations: map[string]string{"park": "Gorky Park", "cafe": "Cafe"},
+	}
+	event := data.Event{
+		Center: eventCenter(e.posts, posts),
+		Tags:   sortTags(e.tags, p.MaxTags, opts.TagModel),
+	}
+	summarize(&event, e, posts, p, opts)
+
+	if event.Title != "rockfest gorkypark at Gorky Park" {
+		t.Errorf("Title = %q", event.Title)
+	}
+	if !reflect.DeepEqual(event.TopPosts, []string{"a", "c"}) {
+		t.Errorf("TopPosts = %v", event.TopPosts)
+	}
+	if event.Authors != 3 || event.Likes != 121 || event.Comments != 12 || event.Location != "Gorky Park" {
+		t.Errorf("summary = %v authors, %v likes, %v comments, %q location", event.Authors, event.Likes, event.Comments, event.Location)
+	}
+}
+
+func Test_sortTagsDedup(t *testing.T) {
+	tags := map[string]int{"#рокфест": 5, "#rokfest": 3, "#gorkypark": 4, "#concert": 1}
+	got := sortTags(tags, 2, nil)
+	want := []string{"#рокфест", "#gorkypark"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sortTags() = %v, want %v", got, want)
+	}
+}
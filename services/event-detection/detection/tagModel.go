@@ -0,0 +1,107 @@
+package detection
+
+import (
+	"math"
+	"strings"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+const (
+	// TagModelKey is the key under which the tag model of a city is stored together with its grids.
+	TagModelKey int64 = 0
+	// TagsKeyOffset is added to the key of a historic grid to get the key of the tag model of its
+	// posts while grids are built by several nodes.
+	TagsKeyOffset int64 = 200000
+	// genericShare is the share of documents starting from which a tag is considered generic.
+	genericShare = 0.02
+)
+
+// TagModel holds document frequencies of tags in the city history. A document is the set of tags
+// of all posts made in the same grid cell during the same hour. Tags which are used in many
+// documents, e.g. "#love" or the name of the city, don't describe particular events.
+// Language selects the stoplist of the city, it is set from detection parameters.
+type TagModel struct {
+	Docs     int64
+	DF       map[string]int64
+	Language string
+}
+
+// TagsKey returns the key of the tag model of posts of the historic grid with the key gridKey.
+func TagsKey(gridKey int64) int64 {
+	return gridKey + TagsKeyOffset
+}
+
+func NewTagModel() TagModel {
+	return TagModel{DF: map[string]int64{}}
+}
+
+// AddPosts adds documents formed from the posts, cells are computed as in HistoricGrid.
+func (m *TagModel) AddPosts(posts []data.Post, topLeft data.Point, gridSize float64) {
+	type doc struct {
+		cell convtree.Point
+		hour int64
+	}
+	docs := map[doc]map[string]bool{}
+	for _, post := range posts {
+		d := doc{
+			cell: convtree.Point{
+				X: topLeft.Lon + float64(int((post.Lon-topLeft.Lon)/gridSize))*gridSize,
+				Y: topLeft.Lat + float64(int((post.Lat-topLeft.Lat)/gridSize))*gridSize,
+			},
+			hour: post.Timestamp / 3600,
+		}
+		tags, ok := docs[d]
+		if !ok {
+			tags = map[string]bool{}
+			docs[d] = tags
+		}
+		for _, t := range tokenizer.Hashtags(post.Caption) {
+			tags[t] = true
+		}
+	}
+	if m.DF == nil {
+		m.DF = map[string]int64{}
+	}
+	for _, tags := range docs {
+		m.Docs++
+		for t := range tags {
+			m.DF[t]++
+		}
+	}
+}
+
+func (m *TagModel) Merge(o TagModel) {
+	if m.DF == nil {
+		m.DF = map[string]int64{}
+	}
+	m.Docs += o.Docs
+	for t, c := range o.DF {
+		m.DF[t] += c
+	}
+}
+
+// Weight returns the weight of the tag in [0, 1] which is lower for more frequent tags. Generic and
+// stoplisted tags have zero weight. The model may be nil, then only the English stoplist is used.
+func (m *TagModel) Weight(tag string) float64 {
+	language := ""
+	if m != nil {
+		language = m.Language
+	}
+	if isStopTag(strings.TrimPrefix(tag, "#"), language) {
+		return 0
+	}
+	if m == nil || m.Docs < 2 {
+		return 1
+	}
+	df := m.DF[tag]
+	if df == 0 {
+		return 1
+	}
+	if float64(df)/float64(m.Docs) >= genericShare {
+		return 0
+	}
+	return math.Log(float64(m.Docs)/float64(df)) / math.Log(float64(m.Docs))
+}
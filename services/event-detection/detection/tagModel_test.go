@@ -0,0 +1,75 @@
+package detection
+
+import (
+	"reflect"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func TestTagModel_AddPosts(t *testing.T) {
+	tl := data.Point{Lat: 60, Lon: 30}
+	posts := []data.Post{
+		{Caption: "#Moscow #concert", Timestamp: 3600, Lat: 60.0001, Lon: 30.0001},
+		{Caption: "#moscow", Timestamp: 3700, Lat: 60.0002, Lon: 30.0002},
+		{Caption: "#moscow #food", Timestamp: 7200, Lat: 60.0001, Lon: 30.0001},
+		{Caption: "#moscow", Timestamp: 3600, Lat: 60.5, Lon: 30.5},
+	}
+	m := NewTagModel()
+	m.AddPosts(posts, tl, 0.01)
+	want := TagModel{Docs: 3, DF: map[string]int64{"#moscow": 3, "#concert": 1, "#food": 1}}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("AddPosts() = %v, want %v", m, want)
+	}
+}
+
+func Test_sortTags(t *testing.T) {
+	m := &TagModel{Docs: 1000, DF: map[string]int64{"#moscow": 900, "#rockfest": 2, "#gorkypark": 10}}
+	tests := []struct {
+		name      string
+		tags      map[string]int
+		tagModel  *TagModel
+		want      []string
+		wantTitle string
+	}{
+		{
+			"English stoplist without model",
+			map[string]int{"#love": 10, "#rockfest": 3, "#любовь": 5},
+			nil,
+			[]string{"#любовь", "#rockfest", "#love"},
+			"#любовь",
+		},
+		{
+			"stoplist of the city language",
+			map[string]int{"#love": 10, "#rockfest": 3, "#любовь": 5},
+			&TagModel{Language: "ru"},
+			[]string{"#rockfest", "#love", "#любовь"},
+			"#rockfest",
+		},
+		{
+			"generic tags go last",
+			map[string]int{"#love": 10, "#rockfest": 3, "#moscow": 5, "#gorkypark": 3},
+			m,
+			[]string{"#rockfest", "#gorkypark", "#love", "#moscow"},
+			"#rockfest",
+		},
+		{
+			"only generic tags",
+			map[string]int{"#love": 10, "#moscow": 5},
+			m,
+			[]string{"#love", "#moscow"},
+			"#love",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortTags(tt.tags, 5, tt.tagModel)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortTags() = %v, want %v", got, tt.want)
+			}
+			if title := eventTitle(got, tt.tagModel); title != tt.wantTitle {
+				t.Errorf("eventTitle() = %v, want %v", title, tt.wantTitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package detection
+
+import (
+	"errors"
+	"math"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// Kinds of city-level alerts.
+const (
+	SurgeAlert = "surge"
+	DropAlert  = "drop"
+)
+
+const (
+	defaultSeason    = 168
+	defaultAlpha     = 0.3
+	defaultBeta      = 0.01
+	defaultGamma     = 0.2
+	defaultThreshold = 4
+	// deviationRate is the weight of the last residual in the running mean absolute deviation.
+	deviationRate = 0.1
+)
+
+var ErrInvalidVolume = errors.New("volume smoothing factors must be in (0, 1], season and threshold must be positive")
+
+// VolumeParams sets up the detector of city-wide anomalies of the hourly number of posts. The
+// baseline is the additive Holt-Winters forecast with the season of Season hours and the smoothing
+// factors Alpha, Beta and Gamma of the level, trend and season. An hour is anomalous if the residual
+// exceeds Threshold robust deviations. Zero values mean defaults.
+type VolumeParams struct {
+	Season    int
+	Alpha     float64
+	Beta      float64
+	Gamma     float64
+	Threshold float64
+}
+
+// DefaultVolumeParams use the weekly season.
+var DefaultVolumeParams = VolumeParams{
+	Season:    defaultSeason,
+	Alpha:     defaultAlpha,
+	Beta:      defaultBeta,
+	Gamma:     defaultGamma,
+	Threshold: defaultThreshold,
+}
+
+// Merge returns p with the non-zero values of o.
+func (p VolumeParams) Merge(o VolumeParams) VolumeParams {
+	if o.Season != 0 {
+		p.Season = o.Season
+	}
+	if o.Alpha != 0 {
+		p.Alpha = o.Alpha
+	}
+	if o.Beta != 0 {
+		p.Beta = o.Beta
+	}
+	if o.Gamma != 0 {
+		p.Gamma = o.Gamma
+	}
+	if o.Threshold != 0 {
+		p.Threshold = o.Threshold
+	}
+	return p
+}
+
+func (p VolumeParams) Validate() error {
+	valid := func(f float64) bool { return f > 0 && f <= 1 }
+	if p.Season <= 0 || p.Threshold <= 0 || !valid(p.Alpha) || !valid(p.Beta) || !valid(p.Gamma) {
+		return ErrInvalidVolume
+	}
+	return nil
+}
+
+// History is the number of seconds of the timeline before the detection period used to fit the
+// baseline: two seasons initialize it and two more let it settle.
+func (p VolumeParams) History() int64 {
+	return int64(4*p.Season) * 3600
+}
+
+// VolumeAlerts returns alerts for anomalous hours of [start, finish). The timeline must contain
+// every hour starting at least History() seconds before start. Hours without posts are zeros and
+// may be reported as drops, also at the end of the period. Hours which are not collected yet, or
+// are missing from the timeline, are replaced with the forecast. Anomalous hours are clipped to the
+// threshold. Consecutive anomalous hours of the same kind are merged into one alert.
+func VolumeAlerts(timeline []data.Timestamp, p VolumeParams, start, finish int64) []data.Alert {
+	res := []data.Alert{}
+	if len(timeline) == 0 {
+		return res
+	}
+	counts := map[int64]float64{}
+	collected := map[int64]bool{}
+	first := timeline[0].Time
+	for _, ts := range timeline {
+		counts[ts.Time] = float64(ts.PostsNumber)
+		collected[ts.Time] = ts.Collected
+		if ts.Time < first {
+			first = ts.Time
+		}
+	}
+	n := int((finish - first + 3599) / 3600)
+	if n < 2*p.Season {
+		return res
+	}
+	series := make([]float64, n)
+	present := make([]bool, n)
+	for i := range series {
+		t := first + int64(i)*3600
+		series[i] = counts[t]
+		present[i] = collected[t]
+	}
+
+	hw := newHoltWinters(series[:2*p.Season], present[:2*p.Season], p)
+	var cur *data.Alert
+	for i := 2 * p.Season; i < n; i++ {
+		t := first + int64(i)*3600
+		f := hw.forecast()
+		if !present[i] {
+			hw.update(f, false)
+			continue
+		}
+		x := series[i]
+		dev := hw.deviation(f)
+		score := (x - f) / dev
+		anomaly := math.Abs(score) >= p.Threshold
+		if anomaly {
+			// anomalous values are clipped, so that the baseline slowly follows lasting shifts
+			hw.update(f+math.Copysign(p.Threshold*dev, score), false)
+		} else {
+			hw.update(x, true)
+		}
+		if t < start || t >= finish {
+			continue
+		}
+		if !anomaly {
+			cur = nil
+			continue
+		}
+		kind := SurgeAlert
+		if score < 0 {
+			kind = DropAlert
+		}
+		if cur == nil || cur.Kind != kind || cur.Finish != t {
+			res = append(res, data.Alert{Start: t, Kind: kind})
+			cur = &res[len(res)-1]
+		}
+		cur.Finish = t + 3600
+		cur.Observed += int64(x)
+		cur.Expected += f
+		cur.Score = math.Max(cur.Score, math.Abs(score))
+	}
+	return res
+}
+
+type holtWinters struct {
+	p        VolumeParams
+	level    float64
+	trend    float64
+	seasonal []float64
+	// mad is the running mean absolute deviation of the residuals.
+	mad float64
+	pos int
+}
+
+// newHoltWinters initializes the model from the first two seasons of the series.
+func newHoltWinters(series []float64, present []bool, p VolumeParams) *holtWinters {
+	s := p.Season
+	mean := func(from, to int) float64 {
+		sum, num := 0.0, 0
+		for i := from; i < to; i++ {
+			if present[i] {
+				sum += series[i]
+				num++
+			}
+		}
+		if num == 0 {
+			return 0
+		}
+		return sum / float64(num)
+	}
+	m1, m2 := mean(0, s), mean(s, 2*s)
+	hw := &holtWinters{
+		p:        p,
+		level:    m2,
+		trend:    (m2 - m1) / float64(s),
+		seasonal: make([]float64, s),
+	}
+	for i := 0; i < s; i++ {
+		sum, num := 0.0, 0
+		if present[i] {
+			sum += series[i] - m1
+			num++
+		}
+		if present[s+i] {
+			sum += series[s+i] - m2
+			num++
+		}
+		if num > 0 {
+			hw.seasonal[i] = sum / float64(num)
+		}
+	}
+	sum, num := 0.0, 0
+	for i := range series {
+		if present[i] {
+			m := m1
+			if i >= s {
+				m = m2
+			}
+			sum += math.Abs(series[i] - m - hw.seasonal[i%s])
+			num++
+		}
+	}
+	if num > 0 {
+		hw.mad = sum / float64(num)
+	}
+	return hw
+}
+
+func (hw *holtWinters) forecast() float64 {
+	f := hw.level + hw.trend + hw.seasonal[hw.pos]
+	if f < 0 {
+		return 0
+	}
+	return f
+}
+
+// deviation returns the robust standard deviation of the residuals. It is not smaller than the
+// Poisson deviation of the forecast, so that quiet hours don't produce alerts for a few posts.
+func (hw *holtWinters) deviation(f float64) float64 {
+	return math.Max(1.25*hw.mad, math.Sqrt(math.Max(f, 1)))
+}
+
+// update moves the model to the next hour. The deviation is updated only for observed values
+// which are not anomalous.
+func (hw *holtWinters) update(x float64, observed bool) {
+	f := hw.forecast()
+	if observed {
+		hw.mad = deviationRate*math.Abs(x-f) + (1-deviationRate)*hw.mad
+	}
+	p := hw.p
+	season := hw.seasonal[hw.pos]
+	level := p.Alpha*(x-season) + (1-p.Alpha)*(hw.level+hw.trend)
+	hw.trend = p.Beta*(level-hw.level) + (1-p.Beta)*hw.trend
+	hw.seasonal[hw.pos] = p.Gamma*(x-level) + (1-p.Gamma)*season
+	hw.level = level
+	hw.pos = (hw.pos + 1) % p.Season
+}
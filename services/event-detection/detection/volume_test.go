@@ -0,0 +1,66 @@
+package detection
+
+import (
+	"math"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// dailyTimeline returns collected hourly counts with the daily season for the given number of days.
+func dailyTimeline(days int) []data.Timestamp {
+	res := []data.Timestamp{}
+	for i := 0; i < days*24; i++ {
+		n := 100 + 50*math.Sin(2*math.Pi*float64(i%24)/24) + float64(i%3)
+		res = append(res, data.Timestamp{Time: int64(i) * 3600, PostsNumber: int64(n), Collected: true})
+	}
+	return res
+}
+
+func TestVolumeAlerts(t *testing.T) {
+	p := DefaultVolumeParams.Merge(VolumeParams{Season: 24})
+	tl := dailyTimeline(10)
+	start, finish := p.History()+24*3600, int64(len(tl))*3600
+	if got := VolumeAlerts(tl, p, start, finish); len(got) != 0 {
+		t.Fatalf("VolumeAlerts() on the regular timeline = %+v", got)
+	}
+
+	surge := 5 * 24
+	tl[surge].PostsNumber *= 4
+	tl[surge+1].PostsNumber *= 4
+	drop := 7 * 24
+	tl[drop].PostsNumber = 0
+	tl[drop+1].PostsNumber = 0
+	// hours which are not collected yet are not reported as drops
+	for i := len(tl) - 3; i < len(tl); i++ {
+		tl[i].PostsNumber, tl[i].Collected = 0, false
+	}
+
+	got := VolumeAlerts(tl, p, start, finish)
+	if len(got) != 2 {
+		t.Fatalf("VolumeAlerts() = %+v, want 2 alerts", got)
+	}
+	if got[0].Kind != SurgeAlert || got[0].Start != int64(surge)*3600 || got[0].Finish != int64(surge+2)*3600 {
+		t.Errorf("first alert = %+v, want two-hour surge at %v", got[0], surge)
+	}
+	if got[0].Observed <= int64(got[0].Expected) || got[0].Score < p.Threshold {
+		t.Errorf("surge counts and score are wrong: %+v", got[0])
+	}
+	if got[1].Kind != DropAlert || got[1].Start != int64(drop)*3600 || got[1].Finish != int64(drop+2)*3600 || got[1].Observed != 0 {
+		t.Errorf("second alert = %+v, want two-hour drop to zero at %v", got[1], drop)
+	}
+}
+
+func TestVolumeAlerts_lastHours(t *testing.T) {
+	p := DefaultVolumeParams.Merge(VolumeParams{Season: 24})
+	tl := dailyTimeline(10)
+	start, finish := p.History()+24*3600, int64(len(tl))*3600
+	// the outage lasts to the end of the period, its hours are collected without posts
+	for i := len(tl) - 3; i < len(tl); i++ {
+		tl[i].PostsNumber = 0
+	}
+	got := VolumeAlerts(tl, p, start, finish)
+	if len(got) != 1 || got[0].Kind != DropAlert || got[0].Start != finish-3*3600 || got[0].Finish != finish {
+		t.Errorf("VolumeAlerts() = %+v, want the drop in the last three hours", got)
+	}
+}
@@ -0,0 +1,238 @@
+package detection
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+// Window is a detection window [Start, Finish) with the hours of the detection period it covers.
+type Window struct {
+	Start  int64
+	Finish int64
+	Slots  []Slot
+}
+
+// Slot is a clock hour of the detection period, the first and the last hours may be covered only in
+// part if the period isn't aligned to hours. Share is the part of the hour covered by the window.
+type Slot struct {
+	Start int64
+	Share float64
+}
+
+// HourStart returns the start of the clock hour of the location which contains t. Hours of
+// locations with offsets which are not whole hours don't start on UTC hours.
+func HourStart(t int64, loc *time.Location) int64 {
+	_, offset := time.Unix(t, 0).In(loc).Zone()
+	h := t - (t+int64(offset))%3600
+	if h > t {
+		h -= 3600
+	}
+	return h
+}
+
+// Windows splits [start, finish) into windows of the given length started every stride seconds.
+// Windows are cut at finish. Slots of windows are aligned to clock hours of the location, so they
+// match hours of historic grids whatever the start is.
+func Windows(start, finish, length, stride int64, loc *time.Location) []Window {
+	res := []Window{}
+	for ws := start; ws < finish; ws += stride {
+		we := ws + length
+		if we > finish {
+			we = finish
+		}
+		w := Window{Start: ws, Finish: we}
+		for h := HourStart(ws, loc); h < we; h = nextHour(h, loc) {
+			from, to := h, nextHour(h, loc)
+			if from < ws {
+				from = ws
+			}
+			if to > we {
+				to = we
+			}
+			w.Slots = append(w.Slots, Slot{Start: h, Share: float64(to-from) / 3600})
+		}
+		res = append(res, w)
+		if we == finish {
+			break
+		}
+	}
+	return res
+}
+
+// nextHour returns the start of the clock hour of the location after the hour which starts at h.
+func nextHour(h int64, loc *time.Location) int64 {
+	if n := HourStart(h+3600, loc); n > h {
+		return n
+	}
+	return h + 3600
+}
+
+// minCoverage is the minimum share of a window covered by hours with historic grids for the window
+// to be searched.
+const minCoverage = 0.5
+
+// ScaleShares takes shares of the hours of the window which have historic grids and scales them up
+// so that their sum is the length of the window in hours. The expected number of posts in hours
+// without a grid is estimated from the other hours then, otherwise all posts of the window would be
+// compared with the thresholds of a part of it. Coverage is the part of the window covered by hours
+// with grids, false is returned if it is less than a half.
+func ScaleShares(w Window, shares []float64) (scaled []float64, coverage float64, ok bool) {
+	total, covered := 0.0, 0.0
+	for _, s := range w.Slots {
+		total += s.Share
+	}
+	for _, s := range shares {
+		covered += s
+	}
+	if total == 0 || covered == 0 {
+		return nil, 0, false
+	}
+	coverage = covered / total
+	if coverage < minCoverage {
+		return nil, coverage, false
+	}
+	scaled = make([]float64, len(shares))
+	for i, s := range shares {
+		scaled[i] = s / coverage
+	}
+	return scaled, coverage, true
+}
+
+// FindWindowEvents searches for events in posts of a window using the historic grids of the hours
+// it covers, shares are the parts of the hours covered by the window. The most detailed grid is
+// used for the search, the expected number of posts in each of its cells is summed over the hours.
+// A window which exactly covers one hour is the same as FindEvents.
+func FindWindowEvents(grids []convtree.ConvTree, shares []float64, posts []data.Post, p Params, opts Options, start, finish int64) ([]data.Event, bool) {
+	if len(grids) == 0 {
+		return nil, false
+	}
+	if len(grids) == 1 && shares[0] == 1 {
+		return FindEvents(grids[0], posts, p, opts, start, finish)
+	}
+	base, baseLeaves := 0, 0
+	for i := range grids {
+		if n := countLeaves(&grids[i]); n > baseLeaves {
+			base, baseLeaves = i, n
+		}
+	}
+	tree := &grids[base]
+	setWindowThresholds(tree, grids, shares, p.MaxPoints)
+	// leaves split during the search inherit the threshold of their parent
+	thr := func(leaf *convtree.ConvTree) int {
+		return leaf.MaxPoints
+	}
+	return findEvents(tree, posts, p, opts, thr, start, finish)
+}
+
+// setWindowThresholds sets MaxPoints of every leaf of the tree to the sum of the thresholds of the
+// grids scaled by the shares of their hours and by the ratio of the leaf area to the area of the
+// grid leaf containing its center.
+func setWindowThresholds(tree *convtree.ConvTree, grids []convtree.ConvTree, shares []float64, maxPoints int) {
+	if !tree.IsLeaf {
+		setWindowThresholds(tree.ChildBottomLeft, grids, shares, maxPoints)
+		setWindowThresholds(tree.ChildBottomRight, grids, shares, maxPoints)
+		setWindowThresholds(tree.ChildTopLeft, grids, shares, maxPoints)
+		setWindowThresholds(tree.ChildTopRight, grids, shares, maxPoints)
+		return
+	}
+	x := (tree.TopLeft.X + tree.BottomRight.X) / 2
+	y := (tree.TopLeft.Y + tree.BottomRight.Y) / 2
+	area := leafArea(tree)
+	sum := 0.0
+	for i := range grids {
+		ratio := 1.0
+		if leaf := leafAt(&grids[i], x, y); leaf != nil && leafArea(leaf) > 0 {
+			ratio = area / leafArea(leaf)
+		}
+		sum += shares[i] * float64(maxPoints) * ratio
+	}
+	tree.MaxPoints = int(math.Ceil(sum - 1e-9))
+	if tree.MaxPoints < 1 {
+		tree.MaxPoints = 1
+	}
+}
+
+func leafAt(tree *convtree.ConvTree, x, y float64) *convtree.ConvTree {
+	if x < tree.TopLeft.X || x > tree.BottomRight.X || y > tree.TopLeft.Y || y < tree.BottomRight.Y {
+		return nil
+	}
+	if tree.IsLeaf {
+		return tree
+	}
+	for _, c := range []*convtree.ConvTree{tree.ChildTopLeft, tree.ChildTopRight, tree.ChildBottomLeft, tree.ChildBottomRight} {
+		if leaf := leafAt(c, x, y); leaf != nil {
+			return leaf
+		}
+	}
+	return nil
+}
+
+func leafArea(tree *convtree.ConvTree) float64 {
+	return (tree.BottomRight.X - tree.TopLeft.X) * (tree.TopLeft.Y - tree.BottomRight.Y)
+}
+
+func countLeaves(tree *convtree.ConvTree) int {
+	if tree.IsLeaf {
+		return 1
+	}
+	return countLeaves(tree.ChildBottomLeft) + countLeaves(tree.ChildBottomRight) +
+		countLeaves(tree.ChildTopLeft) + countLeaves(tree.ChildTopRight)
+}
+
+// Deduplicator removes events found again in overlapping windows. Windows must be added in the
+// order of their starts.
+type Deduplicator struct {
+	recent []data.Event
+}
+
+// Add returns events which don't duplicate events added before. An event is a duplicate if it
+// overlaps in time with an earlier event and at least half of the posts of the smaller of them
+// belong to both.
+func (d *Deduplicator) Add(start int64, events []data.Event) []data.Event {
+	kept := d.recent[:0]
+	for _, e := range d.recent {
+		if e.Finish > start {
+			kept = append(kept, e)
+		}
+	}
+	d.recent = kept
+	res := []data.Event{}
+	for _, e := range events {
+		dup := false
+		for _, r := range d.recent {
+			if r.Finish > e.Start && e.Finish > r.Start && sharedPosts(r.PostCodes, e.PostCodes) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			res = append(res, e)
+		}
+	}
+	d.recent = append(d.recent, res...)
+	return res
+}
+
+func sharedPosts(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	bs := make([]string, len(b))
+	copy(bs, b)
+	sort.Strings(bs)
+	common := 0
+	for _, c := range a {
+		i := sort.SearchStrings(bs, c)
+		if i < len(bs) && bs[i] == c {
+			common++
+		}
+	}
+	return 2*common >= len(a)
+}
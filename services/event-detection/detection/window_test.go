@@ -0,0 +1,93 @@
+package detection
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func TestWindows(t *testing.T) {
+	got := Windows(0, 4*3600, 3*3600, 1800, time.UTC)
+	if len(got) != 3 {
+		t.Fatalf("Windows() returned %v windows, want 3", len(got))
+	}
+	want := Window{
+		Start:  1800,
+		Finish: 1800 + 3*3600,
+		Slots: []Slot{
+			{Start: 0, Share: 0.5},
+			{Start: 3600, Share: 1},
+			{Start: 7200, Share: 1},
+			{Start: 10800, Share: 0.5},
+		},
+	}
+	if !reflect.DeepEqual(got[1], want) {
+		t.Errorf("Windows()[1] = %+v, want %+v", got[1], want)
+	}
+	if last := got[2]; last.Start != 3600 || last.Finish != 4*3600 {
+		t.Errorf("Windows() doesn't stop at finish: %+v", last)
+	}
+
+	cut := Windows(0, 4*3600, 3*3600, 3*3600, time.UTC)
+	if len(cut) != 2 || cut[1].Finish != 4*3600 || len(cut[1].Slots) != 1 {
+		t.Errorf("last window is not cut at finish: %+v", cut)
+	}
+
+	// slots of a period which isn't aligned to hours are clock hours
+	shifted := Windows(1800, 1800+7200, 3600, 3600, time.UTC)
+	wantShifted := []Slot{{Start: 0, Share: 0.5}, {Start: 3600, Share: 0.5}}
+	if len(shifted) != 2 || !reflect.DeepEqual(shifted[0].Slots, wantShifted) {
+		t.Errorf("Windows() with unaligned start = %+v, want slots %+v", shifted, wantShifted)
+	}
+
+	hourly := Windows(0, 7200, 3600, 3600, time.UTC)
+	if len(hourly) != 2 || len(hourly[0].Slots) != 1 || hourly[0].Slots[0].Share != 1 {
+		t.Errorf("Windows() with one-hour windows = %+v", hourly)
+	}
+	// clock hours of the location with the offset of five and a half hours start at half past UTC hours
+	loc := time.FixedZone("IST", 5*3600+1800)
+	local := Windows(0, 7200, 3600, 3600, loc)
+	wantLocal := []Slot{{Start: -1800, Share: 0.5}, {Start: 1800, Share: 0.5}}
+	if len(local) != 2 || !reflect.DeepEqual(local[0].Slots, wantLocal) {
+		t.Errorf("Windows() in %v = %+v, want slots %+v", loc, local, wantLocal)
+	}
+	if got := HourStart(1800, loc); got != 1800 {
+		t.Errorf("HourStart() = %v, want 1800", got)
+	}
+}
+
+func TestScaleShares(t *testing.T) {
+	w := Windows(0, 4*3600, 4*3600, 4*3600, time.UTC)[0]
+	got, coverage, ok := ScaleShares(w, []float64{1, 1, 1})
+	if !ok || coverage != 0.75 || !reflect.DeepEqual(got, []float64{4.0 / 3, 4.0 / 3, 4.0 / 3}) {
+		t.Errorf("ScaleShares() = %v, %v, %v, want shares scaled to the window", got, coverage, ok)
+	}
+	if got, _, _ := ScaleShares(w, []float64{1, 1, 1, 1}); !reflect.DeepEqual(got, []float64{1, 1, 1, 1}) {
+		t.Errorf("ScaleShares() of a covered window = %v", got)
+	}
+	if _, coverage, ok := ScaleShares(w, []float64{1}); ok || coverage != 0.25 {
+		t.Errorf("ScaleShares() = %v, %v, want a window covered by a quarter to be skipped", coverage, ok)
+	}
+}
+
+func TestDeduplicator_Add(t *testing.T) {
+	d := &Deduplicator{}
+	first := []data.Event{{Start: 0, Finish: 3600, PostCodes: []string{"a", "b", "c", "d"}}}
+	if got := d.Add(0, first); len(got) != 1 {
+		t.Fatalf("Add() dropped the first event")
+	}
+	next := []data.Event{
+		{Start: 1800, Finish: 5400, PostCodes: []string{"c", "d", "e"}},
+		{Start: 1800, Finish: 5400, PostCodes: []string{"x", "y"}},
+	}
+	got := d.Add(1800, next)
+	if len(got) != 1 || got[0].PostCodes[0] != "x" {
+		t.Errorf("Add() = %+v, want only the event with new posts", got)
+	}
+	later := []data.Event{{Start: 7200, Finish: 10800, PostCodes: []string{"a", "b", "c", "d"}}}
+	if got := d.Add(7200, later); len(got) != 1 {
+		t.Errorf("Add() dropped an event which doesn't overlap in time")
+	}
+}
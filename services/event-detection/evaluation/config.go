@@ -0,0 +1,131 @@
+package evaluation
+
+import (
+	"errors"
+
+	"github.com/BurntSushi/toml"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// Config describes an evaluation run. Grids are built from posts of [HistoricStart, HistoricFinish)
+// and events are searched for in sliding windows of [DetectStart, DetectFinish), whose length and
+// stride are taken from the detection parameters.
+type Config struct {
+	PostsPath       string
+	GroundTruthPath string
+	ReportPath      string
+	Timezone        string
+	TopLeft         data.Point
+	BotRight        data.Point
+	HistoricStart   int64
+	HistoricFinish  int64
+	DetectStart     int64
+	DetectFinish    int64
+	FilterTags      []string
+	// MatchRadius is used for ground-truth events without their own radius, in meters.
+	MatchRadius float64
+	// MatchSlack widens ground-truth time windows on both sides, in seconds.
+	MatchSlack int64
+	// Filter removes spam posts before detection, bots are not known offline.
+	Filter detection.FilterConfig
+	Sweep  Sweep
+}
+
+// Sweep lists values of detection parameters. Every combination of the values is evaluated,
+// empty lists except GridSize fall back to the default values.
+type Sweep struct {
+	MaxPoints    []int
+	GridSize     []float64
+	MinLength    []float64
+	MaxDepth     []int
+	ConvNumber   []int
+	ConvGridSize []int
+}
+
+// Params is a single combination of detection parameters.
+type Params struct {
+	MaxPoints int
+	GridSize  float64
+	Tree      detection.TreeParams
+}
+
+func readConfig(path string) (cfg Config, err error) {
+	_, err = toml.DecodeFile(path, &cfg)
+	if err != nil {
+		unilog.Logger().Error("unable to read config file", zap.String("path", path), zap.Error(err))
+		return
+	}
+	err = cfg.validate()
+	if err != nil {
+		unilog.Logger().Error("invalid config file", zap.String("path", path), zap.Error(err))
+	}
+	return
+}
+
+func (cfg Config) validate() error {
+	if cfg.PostsPath == "" || cfg.GroundTruthPath == "" || cfg.ReportPath == "" {
+		return errors.New("posts, ground truth and report paths must be set")
+	}
+	if cfg.HistoricStart >= cfg.HistoricFinish {
+		return errors.New("historic period is empty")
+	}
+	if cfg.DetectStart >= cfg.DetectFinish {
+		return errors.New("detection period is empty")
+	}
+	if len(cfg.Sweep.GridSize) == 0 {
+		return errors.New("at least one grid size must be set")
+	}
+	return nil
+}
+
+// Params returns all combinations of the sweep values.
+func (s Sweep) Params() []Params {
+	maxPoints := s.MaxPoints
+	if len(maxPoints) == 0 {
+		maxPoints = []int{detection.DefaultParams.MaxPoints}
+	}
+	minLength := s.MinLength
+	if len(minLength) == 0 {
+		minLength = []float64{detection.DefaultTreeParams.MinXLength}
+	}
+	maxDepth := s.MaxDepth
+	if len(maxDepth) == 0 {
+		maxDepth = []int{detection.DefaultTreeParams.MaxDepth}
+	}
+	convNumber := s.ConvNumber
+	if len(convNumber) == 0 {
+		convNumber = []int{detection.DefaultTreeParams.ConvNumber}
+	}
+	convGridSize := s.ConvGridSize
+	if len(convGridSize) == 0 {
+		convGridSize = []int{detection.DefaultTreeParams.GridSize}
+	}
+	res := []Params{}
+	for _, mp := range maxPoints {
+		for _, gs := range s.GridSize {
+			for _, ml := range minLength {
+				for _, md := range maxDepth {
+					for _, cn := range convNumber {
+						for _, cgs := range convGridSize {
+							res = append(res, Params{
+								MaxPoints: mp,
+								GridSize:  gs,
+								Tree: detection.TreeParams{
+									MinXLength: ml,
+									MinYLength: ml,
+									MaxDepth:   md,
+									ConvNumber: cn,
+									GridSize:   cgs,
+								},
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+	return res
+}
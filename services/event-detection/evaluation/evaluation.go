@@ -0,0 +1,37 @@
+// Package evaluation replays stored posts through the detection algorithms offline and scores
+// found events against labelled ground truth.
+package evaluation
+
+import (
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/offline"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// Run evaluates every parameter combination from the config and writes the report.
+func Run(confPath string) error {
+	cfg, err := readConfig(confPath)
+	if err != nil {
+		return err
+	}
+	posts, err := offline.ReadPosts(cfg.PostsPath)
+	if err != nil {
+		return err
+	}
+	truth, err := readTruth(cfg.GroundTruthPath)
+	if err != nil {
+		return err
+	}
+	rows := []ReportRow{}
+	for _, p := range cfg.Sweep.Params() {
+		events, err := Replay(posts, cfg, p)
+		if err != nil {
+			unilog.Logger().Error("unable to replay posts", zap.Any("params", p), zap.Error(err))
+			return err
+		}
+		sc := Evaluate(events, truth, cfg.MatchRadius, cfg.MatchSlack)
+		unilog.Logger().Info("evaluated parameters", zap.Any("params", p), zap.Any("score", sc))
+		rows = append(rows, ReportRow{Params: p, Score: sc})
+	}
+	return writeReport(cfg.ReportPath, rows)
+}
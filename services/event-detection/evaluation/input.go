@@ -0,0 +1,28 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/angrymuskrat/event-monitoring-system/utils/truth"
+
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// readTruth reads a JSON array of ground-truth events.
+func readTruth(path string) ([]truth.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		unilog.Logger().Error("unable to open ground truth file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	defer f.Close()
+	res := []truth.Event{}
+	err = json.NewDecoder(f).Decode(&res)
+	if err != nil {
+		unilog.Logger().Error("unable to decode ground truth file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package evaluation
+
+import (
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/offline"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// Replay builds historic grids from posts of the historic period and searches for events in
+// sliding multi-hour windows of the detection period in the same way as the event detection
+// service does.
+func Replay(posts []data.Post, cfg Config, p Params) ([]data.Event, error) {
+	mp := offline.ModelParams{
+		TopLeft:   cfg.TopLeft,
+		BotRight:  cfg.BotRight,
+		Timezone:  cfg.Timezone,
+		GridSize:  p.GridSize,
+		Detection: detection.Params{MaxPoints: p.MaxPoints, Tree: p.Tree},
+	}
+	m, err := offline.BuildModel(posts, mp, cfg.HistoricStart, cfg.HistoricFinish)
+	if err != nil {
+		return nil, err
+	}
+	return offline.Detect(m, posts, offline.DetectParams{
+		Start:      cfg.DetectStart,
+		Finish:     cfg.DetectFinish,
+		FilterTags: cfg.FilterTags,
+		Filter:     cfg.Filter,
+	})
+}
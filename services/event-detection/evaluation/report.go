@@ -0,0 +1,69 @@
+package evaluation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// ReportRow is a result of the evaluation of a single parameter combination.
+type ReportRow struct {
+	Params Params
+	Score  Score
+}
+
+var csvHeader = []string{
+	"maxPoints", "gridSize", "minLength", "maxDepth", "convNumber", "convGridSize",
+	"detected", "truePositives", "truth", "matched", "precision", "recall", "f1", "meanTTD",
+}
+
+// writeReport writes rows as JSON if the path has the .json extension and as CSV otherwise.
+func writeReport(path string, rows []ReportRow) error {
+	f, err := os.Create(path)
+	if err != nil {
+		unilog.Logger().Error("unable to create report file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	defer f.Close()
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(rows)
+	} else {
+		err = writeCSV(f, rows)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to write report", zap.String("path", path), zap.Error(err))
+	}
+	return err
+}
+
+func writeCSV(f *os.File, rows []ReportRow) error {
+	w := csv.NewWriter(f)
+	if err := w.Write(csvHeader); err != nil {
+		return err
+	}
+	ff := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	for _, r := range rows {
+		p, s := r.Params, r.Score
+		rec := []string{
+			strconv.Itoa(p.MaxPoints), ff(p.GridSize), ff(p.Tree.MinXLength), strconv.Itoa(p.Tree.MaxDepth),
+			strconv.Itoa(p.Tree.ConvNumber), strconv.Itoa(p.Tree.GridSize),
+			strconv.Itoa(s.Detected), strconv.Itoa(s.TruePositives), strconv.Itoa(s.Truth), strconv.Itoa(s.Matched),
+			ff(s.Precision), ff(s.Recall), ff(s.F1), ff(s.MeanTTD),
+		}
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,98 @@
+package evaluation
+
+import (
+	"strings"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
+	"github.com/angrymuskrat/event-monitoring-system/utils/truth"
+)
+
+// Score holds quality metrics of detected events against the ground truth. MeanTTD is the mean
+// time to detect over matched ground-truth events, in seconds, measured from the start of the
+// ground-truth event to the finish of the first matching detected event.
+type Score struct {
+	Detected      int
+	TruePositives int
+	Truth         int
+	Matched       int
+	Precision     float64
+	Recall        float64
+	F1            float64
+	MeanTTD       float64
+}
+
+// Evaluate matches detected events against the ground truth. A detected event is a true positive
+// if it matches at least one ground-truth event.
+func Evaluate(events []data.Event, labels []truth.Event, radius float64, slack int64) Score {
+	sc := Score{Detected: len(events), Truth: len(labels)}
+	ttd := map[int]int64{}
+	for _, e := range events {
+		tp := false
+		for ti, t := range labels {
+			if !matches(e, t, radius, slack) {
+				continue
+			}
+			tp = true
+			d := e.Finish - t.Start
+			if d < 0 {
+				d = 0
+			}
+			if prev, ok := ttd[ti]; !ok || d < prev {
+				ttd[ti] = d
+			}
+		}
+		if tp {
+			sc.TruePositives++
+		}
+	}
+	sc.Matched = len(ttd)
+	if sc.Detected > 0 {
+		sc.Precision = float64(sc.TruePositives) / float64(sc.Detected)
+	}
+	if sc.Truth > 0 {
+		sc.Recall = float64(sc.Matched) / float64(sc.Truth)
+	}
+	if sc.Precision+sc.Recall > 0 {
+		sc.F1 = 2 * sc.Precision * sc.Recall / (sc.Precision + sc.Recall)
+	}
+	if sc.Matched > 0 {
+		var sum int64
+		for _, d := range ttd {
+			sum += d
+		}
+		sc.MeanTTD = float64(sum) / float64(sc.Matched)
+	}
+	return sc
+}
+
+func matches(e data.Event, t truth.Event, radius float64, slack int64) bool {
+	if e.Start >= t.Finish+slack || e.Finish <= t.Start-slack {
+		return false
+	}
+	r := t.Radius
+	if r == 0 {
+		r = radius
+	}
+	if detection.Distance(e.Center.Lat, e.Center.Lon, t.Lat, t.Lon) > r {
+		return false
+	}
+	if len(t.Tags) == 0 {
+		return true
+	}
+	for _, tt := range t.Tags {
+		tt = tagKey(tt)
+		for _, et := range e.Tags {
+			if tagKey(et) == tt {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tagKey matches transliteration variants, ground-truth tags may be written without '#'.
+func tagKey(tag string) string {
+	return tokenizer.Key(strings.TrimLeft(tag, "#@"))
+}
@@ -0,0 +1,83 @@
+package evaluation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/utils/truth"
+)
+
+func TestEvaluate(t *testing.T) {
+	labels := []truth.Event{
+		{Name: "concert", Lat: 59.93, Lon: 30.31, Start: 7200, Finish: 14400, Tags: []string{"Concert"}},
+		{Name: "fair", Lat: 59.95, Lon: 30.35, Radius: 100, Start: 0, Finish: 3600},
+	}
+	tests := []struct {
+		name   string
+		events []data.Event
+		slack  int64
+		want   Score
+	}{
+		{
+			"no events",
+			nil,
+			0,
+			Score{Truth: 2},
+		},
+		{
+			"one match with late detection",
+			[]data.Event{
+				{Center: data.Point{Lat: 59.931, Lon: 30.31}, Tags: []string{"#concert"}, Start: 10800, Finish: 14400},
+				{Center: data.Point{Lat: 59.931, Lon: 30.31}, Tags: []string{"#concert"}, Start: 7200, Finish: 10800},
+				{Center: data.Point{Lat: 59.931, Lon: 30.31}, Tags: []string{"#other"}, Start: 7200, Finish: 10800},
+			},
+			0,
+			Score{Detected: 3, TruePositives: 2, Truth: 2, Matched: 1, Precision: 2.0 / 3, Recall: 0.5, F1: 2 * (2.0 / 3) * 0.5 / (2.0/3 + 0.5), MeanTTD: 3600},
+		},
+		{
+			"outside of radius and window",
+			[]data.Event{
+				{Center: data.Point{Lat: 59.96, Lon: 30.35}, Start: 0, Finish: 3600},
+				{Center: data.Point{Lat: 59.95, Lon: 30.35}, Start: 7200, Finish: 10800},
+			},
+			0,
+			Score{Detected: 2, Truth: 2},
+		},
+		{
+			"within slack",
+			[]data.Event{
+				{Center: data.Point{Lat: 59.95, Lon: 30.35}, Start: 3600, Finish: 7200},
+			},
+			1800,
+			Score{Detected: 1, TruePositives: 1, Truth: 2, Matched: 1, Precision: 1, Recall: 0.5, F1: 2 * 0.5 / 1.5, MeanTTD: 7200},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Evaluate(tt.events, labels, 500, tt.slack); !reflect.DeepEqual(rounded(got), rounded(tt.want)) {
+				t.Errorf("Evaluate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func rounded(s Score) Score {
+	r := func(v float64) float64 {
+		return math.Round(v*1e6) / 1e6
+	}
+	s.Precision, s.Recall, s.F1 = r(s.Precision), r(s.Recall), r(s.F1)
+	return s
+}
+
+func TestSweep_Params(t *testing.T) {
+	s := Sweep{MaxPoints: []int{4, 6}, GridSize: []float64{0.001, 0.002}, MaxDepth: []int{10, 20}}
+	got := s.Params()
+	if len(got) != 8 {
+		t.Fatalf("Params() returned %v combinations, want 8", len(got))
+	}
+	if got[0].Tree.ConvNumber != 3 || got[0].Tree.MinXLength != 0.005 {
+		t.Errorf("Params() default tree parameters = %+v", got[0].Tree)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/offline"
+	"github.com/visheratin/unilog"
+)
+
+func main() {
+	lp := flag.String("log", "./log.txt", "path to the log file")
+	cp := flag.String("config", "./config.toml", "path to the config file")
+	flag.Parse()
+
+	logCfg := unilog.DefaultConfig()
+	logCfg.OutputPaths = []string{*lp}
+	logCfg.ErrorOutputPaths = []string{*lp}
+	unilog.InitLog(logCfg)
+
+	if err := offline.Run(*cp); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,120 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/gob"
+	"sort"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
+	convtree "github.com/visheratin/conv-tree"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// DetectParams sets up the search for events in [Start, Finish). Non-zero detection parameters
+// override the ones the model was built with, except for tree parameters which are used only when
+// the model is built.
+type DetectParams struct {
+	Start      int64
+	Finish     int64
+	Detection  detection.Params
+	FilterTags []string
+	Filter     detection.FilterConfig
+	Bots       []data.Bot
+	Locations  []data.Location
+}
+
+// Detect searches for events in every window of the period in the same way as the event detection
+// service does. Windows are split into clock hours, which are taken in the timezone of the model.
+// Thresholds of hours without a grid are estimated from the other hours, windows mostly without
+// grids are skipped.
+func Detect(m *Model, posts []data.Post, p DetectParams) ([]data.Event, error) {
+	loc, err := time.LoadLocation(m.Params.Timezone)
+	if err != nil {
+		unilog.Logger().Error("unable to load timezone", zap.Error(err))
+		return nil, err
+	}
+	tagModel, err := m.TagModel()
+	if err != nil {
+		return nil, err
+	}
+	mp := m.Params.Detection
+	mp.WindowLength, mp.WindowStride = 0, 0
+	dp := detection.DefaultParams.Merge(mp).Merge(p.Detection)
+	if err := dp.Validate(); err != nil {
+		unilog.Logger().Error("invalid detection parameters", zap.Error(err))
+		return nil, err
+	}
+	if tagModel == nil {
+		tm := detection.NewTagModel()
+		tagModel = &tm
+	}
+	tagModel.Language = dp.Language
+	sorted := []data.Post{}
+	for _, post := range posts {
+		if post.Timestamp >= p.Start && post.Timestamp < p.Finish {
+			sorted = append(sorted, post)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+	filterTags := map[string]bool{}
+	for _, t := range p.FilterTags {
+		filterTags[tokenizer.Normalize(t)] = true
+	}
+	opts := detection.Options{
+		FilterTags: filterTags,
+		TagModel:   tagModel,
+		Filter:     detection.NewFilter(p.Filter, p.Bots),
+		Locations:  locationTitles(p.Locations),
+	}
+
+	res := []data.Event{}
+	dedup := &detection.Deduplicator{}
+	for _, w := range detection.Windows(p.Start, p.Finish, dp.WindowLength, dp.WindowStride, loc) {
+		from := sort.Search(len(sorted), func(i int) bool { return sorted[i].Timestamp >= w.Start })
+		to := sort.Search(len(sorted), func(i int) bool { return sorted[i].Timestamp >= w.Finish })
+		if from == to {
+			continue
+		}
+		// grids are decoded for every window because the search modifies the trees
+		grids := []convtree.ConvTree{}
+		shares := []float64{}
+		for _, slot := range w.Slots {
+			b, ok := m.Grids[detection.GridKey(time.Unix(slot.Start, 0).In(loc))]
+			if !ok {
+				continue
+			}
+			var grid convtree.ConvTree
+			if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&grid); err != nil {
+				unilog.Logger().Error("unable to decode grid", zap.Error(err))
+				return nil, err
+			}
+			grids = append(grids, grid)
+			shares = append(shares, slot.Share)
+		}
+		shares, coverage, ok := detection.ScaleShares(w, shares)
+		if !ok {
+			unilog.Logger().Warn("window is skipped, there are no grids of most of its hours",
+				zap.Int64("start", w.Start), zap.Float64("coverage", coverage))
+			continue
+		}
+		evs, found := detection.FindWindowEvents(grids, shares, sorted[from:to], dp, opts, w.Start, w.Finish)
+		if found {
+			res = append(res, dedup.Add(w.Start, evs)...)
+		}
+	}
+	return res, nil
+}
+
+func locationTitles(locations []data.Location) map[string]string {
+	res := make(map[string]string, len(locations))
+	for _, l := range locations {
+		res[l.ID] = l.Title
+	}
+	return res
+}
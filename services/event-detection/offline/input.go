@@ -0,0 +1,169 @@
+package offline
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// ReadPosts reads posts from a CSV file if the path has the .csv extension and from a file with
+// one JSON-encoded post per line otherwise.
+func ReadPosts(path string) ([]data.Post, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		unilog.Logger().Error("unable to open posts file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	defer f.Close()
+	var posts []data.Post
+	if strings.ToLower(filepath.Ext(path)) == ".csv" {
+		posts, err = readCSV(f)
+	} else {
+		posts, err = readNDJSON(f)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to read posts file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	return posts, nil
+}
+
+// ReadLocations reads locations from a file with one JSON-encoded location per line.
+func ReadLocations(path string) ([]data.Location, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		unilog.Logger().Error("unable to open locations file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	defer f.Close()
+	res := []data.Location{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var l data.Location
+		if err := json.Unmarshal(line, &l); err != nil {
+			unilog.Logger().Error("unable to decode location", zap.String("path", path), zap.Error(err))
+			return nil, err
+		}
+		res = append(res, l)
+	}
+	if err := sc.Err(); err != nil {
+		unilog.Logger().Error("unable to read locations file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
+
+func readNDJSON(r io.Reader) ([]data.Post, error) {
+	res := []data.Post{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var p data.Post
+		if err := json.Unmarshal(line, &p); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	return res, sc.Err()
+}
+
+// readCSV reads posts from CSV with a header. Columns are named as the fields of data.Post in any
+// case, unknown columns are ignored.
+func readCSV(r io.Reader) ([]data.Post, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+	res := []data.Post{}
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var p data.Post
+		for i, v := range rec {
+			if i >= len(header) {
+				break
+			}
+			if err := setField(&p, header[i], v); err != nil {
+				return nil, fmt.Errorf("line %v, column %v: %v", line, header[i], err)
+			}
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func setField(p *data.Post, name, v string) (err error) {
+	switch name {
+	case "id":
+		p.ID = v
+	case "shortcode":
+		p.Shortcode = v
+	case "imageurl":
+		p.ImageURL = v
+	case "isvideo":
+		p.IsVideo, err = parseBool(v)
+	case "caption":
+		p.Caption = v
+	case "commentscount":
+		p.CommentsCount, err = parseInt(v)
+	case "timestamp":
+		p.Timestamp, err = parseInt(v)
+	case "likescount":
+		p.LikesCount, err = parseInt(v)
+	case "isad":
+		p.IsAd, err = parseBool(v)
+	case "authorid":
+		p.AuthorID = v
+	case "locationid":
+		p.LocationID = v
+	case "lat":
+		p.Lat, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case "lon":
+		p.Lon, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+	}
+	return
+}
+
+func parseInt(v string) (int64, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func parseBool(v string) (bool, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
@@ -0,0 +1,32 @@
+package offline
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func Test_readCSV(t *testing.T) {
+	in := `Shortcode,AuthorID,Timestamp,Lat,Lon,IsAd,Caption,Unknown
+abc,1,1514754000,59.93,30.31,true,"#spb, concert",x
+def,2,1514754100,59.94,30.32,,,
+`
+	posts, err := readCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("readCSV() error = %v", err)
+	}
+	want := []data.Post{
+		{Shortcode: "abc", AuthorID: "1", Timestamp: 1514754000, Lat: 59.93, Lon: 30.31, IsAd: true, Caption: "#spb, concert"},
+		{Shortcode: "def", AuthorID: "2", Timestamp: 1514754100, Lat: 59.94, Lon: 30.32},
+	}
+	if !reflect.DeepEqual(posts, want) {
+		t.Errorf("readCSV() = %v, want %v", posts, want)
+	}
+
+	_, err = readCSV(strings.NewReader("Timestamp\nnoon\n"))
+	if err == nil {
+		t.Errorf("readCSV() expected error for invalid timestamp")
+	}
+}
@@ -0,0 +1,133 @@
+// Package offline runs the detection algorithms over posts loaded from files without data storage
+// and the event detection service.
+package offline
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// ModelParams sets up building of the historic model. Zero detection parameters are replaced with
+// detection.DefaultParams.
+type ModelParams struct {
+	TopLeft   data.Point
+	BotRight  data.Point
+	Timezone  string
+	GridSize  float64
+	Detection detection.Params
+}
+
+// Model is the historic model of a city: gob-encoded grids by their keys and the tag model under
+// detection.TagModelKey, the same as the event detection service stores in data storage.
+type Model struct {
+	Params ModelParams
+	Start  int64
+	Finish int64
+	Grids  map[int64][]byte
+}
+
+// BuildModel builds historic grids and the tag model from posts of [start, finish).
+func BuildModel(posts []data.Post, p ModelParams, start, finish int64) (*Model, error) {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		unilog.Logger().Error("unable to load timezone", zap.Error(err))
+		return nil, err
+	}
+	p.Detection = detection.DefaultParams.Merge(p.Detection)
+	if err := p.Detection.Validate(); err != nil {
+		unilog.Logger().Error("invalid detection parameters", zap.Error(err))
+		return nil, err
+	}
+	historic := map[int64][]data.Post{}
+	for _, post := range posts {
+		if post.Timestamp >= start && post.Timestamp < finish {
+			k := detection.GridKey(time.Unix(post.Timestamp, 0).In(loc))
+			historic[k] = append(historic[k], post)
+		}
+	}
+	m := &Model{
+		Params: p,
+		Start:  start,
+		Finish: finish,
+		Grids:  map[int64][]byte{},
+	}
+	tagModel := detection.NewTagModel()
+	for k, ps := range historic {
+		tagModel.AddPosts(ps, p.TopLeft, p.GridSize)
+		grid, err := detection.HistoricGridParams(ps, p.TopLeft, p.BotRight, p.Detection.MaxPoints, p.Timezone, p.GridSize, p.Detection.Tree)
+		if err != nil {
+			return nil, err
+		}
+		m.Grids[k], err = encode(grid)
+		if err != nil {
+			unilog.Logger().Error("can't encode grid", zap.Error(err))
+			return nil, err
+		}
+	}
+	m.Grids[detection.TagModelKey], err = encode(tagModel)
+	if err != nil {
+		unilog.Logger().Error("can't encode tag model", zap.Error(err))
+		return nil, err
+	}
+	return m, nil
+}
+
+// TagModel decodes the tag model of the historic model, nil is returned if there is no tag model.
+func (m *Model) TagModel() (*detection.TagModel, error) {
+	b, ok := m.Grids[detection.TagModelKey]
+	if !ok {
+		return nil, nil
+	}
+	tags := detection.NewTagModel()
+	err := gob.NewDecoder(bytes.NewReader(b)).Decode(&tags)
+	if err != nil {
+		unilog.Logger().Error("unable to decode tag model", zap.Error(err))
+		return nil, err
+	}
+	return &tags, nil
+}
+
+// Save writes the model to the file in gob encoding.
+func (m *Model) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		unilog.Logger().Error("unable to create model file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	defer f.Close()
+	err = gob.NewEncoder(f).Encode(m)
+	if err != nil {
+		unilog.Logger().Error("unable to write model file", zap.String("path", path), zap.Error(err))
+	}
+	return err
+}
+
+// LoadModel reads the model written by Save.
+func LoadModel(path string) (*Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		unilog.Logger().Error("unable to open model file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	defer f.Close()
+	m := &Model{}
+	err = gob.NewDecoder(f).Decode(m)
+	if err != nil {
+		unilog.Logger().Error("unable to decode model file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	return m, nil
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	return buf.Bytes(), err
+}
@@ -0,0 +1,116 @@
+package offline
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+type featureCollection struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Type       string            `json:"type"`
+	Geometry   interface{}       `json:"geometry"`
+	Properties featureProperties `json:"properties"`
+}
+
+type geometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+type featureProperties struct {
+	Title     string           `json:"title"`
+	Center    []float64        `json:"center"`
+	Radius    float64          `json:"radius"`
+	Start     int64            `json:"start"`
+	Finish    int64            `json:"finish"`
+	Tags      []string         `json:"tags"`
+	PostCodes []string         `json:"postCodes"`
+	TopPosts  []string         `json:"topPosts"`
+	Authors   int64            `json:"authors"`
+	Likes     int64            `json:"likes"`
+	Comments  int64            `json:"comments"`
+	Location  string           `json:"location,omitempty"`
+	Filtered  data.FilterStats `json:"filtered"`
+}
+
+// WriteEvents writes events as a GeoJSON feature collection if the path has the .geojson or .json
+// extension and as one JSON-encoded event per line otherwise. Features are the footprints of the
+// events, or their centers if footprints are not set.
+func WriteEvents(path string, events []data.Event) error {
+	f, err := os.Create(path)
+	if err != nil {
+		unilog.Logger().Error("unable to create events file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".geojson", ".json":
+		err = writeGeoJSON(f, events)
+	default:
+		err = writeNDJSON(f, events)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to write events", zap.String("path", path), zap.Error(err))
+	}
+	return err
+}
+
+func writeGeoJSON(w io.Writer, events []data.Event) error {
+	fc := featureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]feature, 0, len(events)),
+	}
+	for _, e := range events {
+		center := []float64{e.Center.Lon, e.Center.Lat}
+		var g interface{} = geometry{
+			Type:        "Point",
+			Coordinates: center,
+		}
+		if e.Footprint.Geometry != "" {
+			g = json.RawMessage(e.Footprint.Geometry)
+		}
+		fc.Features = append(fc.Features, feature{
+			Type:     "Feature",
+			Geometry: g,
+			Properties: featureProperties{
+				Title:     e.Title,
+				Center:    center,
+				Radius:    e.Footprint.Radius,
+				Start:     e.Start,
+				Finish:    e.Finish,
+				Tags:      e.Tags,
+				PostCodes: e.PostCodes,
+				TopPosts:  e.TopPosts,
+				Authors:   e.Authors,
+				Likes:     e.Likes,
+				Comments:  e.Comments,
+				Location:  e.Location,
+				Filtered:  e.Filtered,
+			},
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(fc)
+}
+
+func writeNDJSON(w io.Writer, events []data.Event) error {
+	enc := json.NewEncoder(w)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
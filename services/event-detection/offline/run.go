@@ -0,0 +1,134 @@
+package offline
+
+import (
+	"errors"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// Config describes a run of emsdetect. If the model file exists and Rebuild is false, the model
+// is loaded from it, otherwise it is built from posts of [HistoricStart, HistoricFinish) and saved.
+// Events are searched in [DetectStart, DetectFinish), an empty period only builds the model.
+type Config struct {
+	PostsPath string
+	// LocationsPath is an optional file with locations which titles are used in event titles.
+	LocationsPath  string
+	ModelPath      string
+	OutputPath     string
+	Rebuild        bool
+	Timezone       string
+	TopLeft        data.Point
+	BotRight       data.Point
+	HistoricStart  int64
+	HistoricFinish int64
+	GridSize       float64
+	Detection      detection.Params
+	DetectStart    int64
+	DetectFinish   int64
+	FilterTags     []string
+	Filter         detection.FilterConfig
+}
+
+func readConfig(path string) (cfg Config, err error) {
+	_, err = toml.DecodeFile(path, &cfg)
+	if err != nil {
+		unilog.Logger().Error("unable to read config file", zap.String("path", path), zap.Error(err))
+		return
+	}
+	err = cfg.validate()
+	if err != nil {
+		unilog.Logger().Error("invalid config file", zap.String("path", path), zap.Error(err))
+	}
+	return
+}
+
+func (cfg Config) validate() error {
+	if cfg.PostsPath == "" || cfg.ModelPath == "" {
+		return errors.New("posts and model paths must be set")
+	}
+	if cfg.DetectStart < cfg.DetectFinish && cfg.OutputPath == "" {
+		return errors.New("output path must be set")
+	}
+	return nil
+}
+
+func (cfg Config) validateModel() error {
+	if cfg.HistoricStart >= cfg.HistoricFinish {
+		return errors.New("historic period is empty")
+	}
+	if cfg.GridSize <= 0 {
+		return errors.New("grid size must be positive")
+	}
+	return nil
+}
+
+// Run builds or loads the historic model and searches for events as set in the config.
+func Run(confPath string) error {
+	cfg, err := readConfig(confPath)
+	if err != nil {
+		return err
+	}
+	posts, err := ReadPosts(cfg.PostsPath)
+	if err != nil {
+		return err
+	}
+	unilog.Logger().Info("read posts", zap.Int("num", len(posts)))
+
+	var m *Model
+	if _, err := os.Stat(cfg.ModelPath); err == nil && !cfg.Rebuild {
+		m, err = LoadModel(cfg.ModelPath)
+		if err != nil {
+			return err
+		}
+		unilog.Logger().Info("loaded model", zap.String("path", cfg.ModelPath), zap.Int("grids", len(m.Grids)))
+	} else {
+		if err := cfg.validateModel(); err != nil {
+			unilog.Logger().Error("unable to build model", zap.Error(err))
+			return err
+		}
+		p := ModelParams{
+			TopLeft:   cfg.TopLeft,
+			BotRight:  cfg.BotRight,
+			Timezone:  cfg.Timezone,
+			GridSize:  cfg.GridSize,
+			Detection: cfg.Detection,
+		}
+		m, err = BuildModel(posts, p, cfg.HistoricStart, cfg.HistoricFinish)
+		if err != nil {
+			return err
+		}
+		if err := m.Save(cfg.ModelPath); err != nil {
+			return err
+		}
+		unilog.Logger().Info("built model", zap.String("path", cfg.ModelPath), zap.Int("grids", len(m.Grids)))
+	}
+
+	if cfg.DetectStart >= cfg.DetectFinish {
+		return nil
+	}
+	var locations []data.Location
+	if cfg.LocationsPath != "" {
+		locations, err = ReadLocations(cfg.LocationsPath)
+		if err != nil {
+			return err
+		}
+	}
+	events, err := Detect(m, posts, DetectParams{
+		Start:      cfg.DetectStart,
+		Finish:     cfg.DetectFinish,
+		Detection:  cfg.Detection,
+		FilterTags: cfg.FilterTags,
+		Filter:     cfg.Filter,
+		Locations:  locations,
+	})
+	if err != nil {
+		return err
+	}
+	unilog.Logger().Info("found events", zap.Int("num", len(events)))
+	return WriteEvents(cfg.OutputPath, events)
+}
@@ -30,15 +30,16 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // HistoricRequest represents a request for generating historic grids for event detection.
 type HistoricRequest struct {
-	Timezone             string       `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	CityId               string       `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
-	StartTime            int64        `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	FinishTime           int64        `protobuf:"varint,4,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
-	Area                 *proto1.Area `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`
-	GridSize             float64      `protobuf:"fixed64,6,opt,name=gridSize,proto3" json:"gridSize,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Timezone             string                  `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CityId               string                  `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	StartTime            int64                   `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	FinishTime           int64                   `protobuf:"varint,4,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	Area                 *proto1.Area            `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`
+	GridSize             float64                 `protobuf:"fixed64,6,opt,name=gridSize,proto3" json:"gridSize,omitempty"`
+	Params               *proto1.DetectionParams `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *HistoricRequest) Reset()         { *m = HistoricRequest{} }
@@ -116,6 +117,13 @@ func (m *HistoricRequest) GetGridSize() float64 {
 	return 0
 }
 
+func (m *HistoricRequest) GetParams() *proto1.DetectionParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // HistoricResponse represents a response containing historic generation session ID.
 type HistoricResponse struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -173,14 +181,15 @@ func (m *HistoricResponse) GetErr() string {
 }
 
 type EventRequest struct {
-	Timezone             string   `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	CityId               string   `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
-	StartTime            int64    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	FinishTime           int64    `protobuf:"varint,4,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
-	FilterTags           []string `protobuf:"bytes,5,rep,name=filterTags,proto3" json:"filterTags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Timezone             string                  `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CityId               string                  `protobuf:"bytes,2,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	StartTime            int64                   `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	FinishTime           int64                   `protobuf:"varint,4,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	FilterTags           []string                `protobuf:"bytes,5,rep,name=filterTags,proto3" json:"filterTags,omitempty"`
+	Params               *proto1.DetectionParams `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *EventRequest) Reset()         { *m = EventRequest{} }
@@ -251,6 +260,13 @@ func (m *EventRequest) GetFilterTags() []string {
 	return nil
 }
 
+func (m *EventRequest) GetParams() *proto1.DetectionParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 type EventResponse struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
@@ -353,10 +369,18 @@ func (m *StatusRequest) GetId() string {
 	return ""
 }
 
+// StatusResponse represents a state of a session. Elapsed and eta are in seconds, eta is zero if
+// it can't be estimated yet.
 type StatusResponse struct {
 	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Finished             bool     `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
 	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	TotalIntervals       int64    `protobuf:"varint,4,opt,name=totalIntervals,proto3" json:"totalIntervals,omitempty"`
+	ProcessedIntervals   int64    `protobuf:"varint,5,opt,name=processedIntervals,proto3" json:"processedIntervals,omitempty"`
+	PostsRead            int64    `protobuf:"varint,6,opt,name=postsRead,proto3" json:"postsRead,omitempty"`
+	EventsFound          int64    `protobuf:"varint,7,opt,name=eventsFound,proto3" json:"eventsFound,omitempty"`
+	Elapsed              int64    `protobuf:"varint,8,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	Eta                  int64    `protobuf:"varint,9,opt,name=eta,proto3" json:"eta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -416,6 +440,342 @@ func (m *StatusResponse) GetErr() string {
 	return ""
 }
 
+func (m *StatusResponse) GetTotalIntervals() int64 {
+	if m != nil {
+		return m.TotalIntervals
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetProcessedIntervals() int64 {
+	if m != nil {
+		return m.ProcessedIntervals
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetPostsRead() int64 {
+	if m != nil {
+		return m.PostsRead
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetEventsFound() int64 {
+	if m != nil {
+		return m.EventsFound
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetElapsed() int64 {
+	if m != nil {
+		return m.Elapsed
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetEta() int64 {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
+// CancelRequest represents a request for stopping a running historic or event session.
+type CancelRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelRequest) Reset()         { *m = CancelRequest{} }
+func (m *CancelRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()    {}
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92500682d66d7a3, []int{6}
+}
+func (m *CancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRequest.Merge(m, src)
+}
+func (m *CancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRequest proto.InternalMessageInfo
+
+func (m *CancelRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelResponse) Reset()         { *m = CancelResponse{} }
+func (m *CancelResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelResponse) ProtoMessage()    {}
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92500682d66d7a3, []int{7}
+}
+func (m *CancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelResponse.Merge(m, src)
+}
+func (m *CancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelResponse proto.InternalMessageInfo
+
+func (m *CancelResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+// ListRequest represents a request for listing sessions. Empty cityId means all cities.
+type ListRequest struct {
+	CityId               string   `protobuf:"bytes,1,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92500682d66d7a3, []int{8}
+}
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetCityId() string {
+	if m != nil {
+		return m.CityId
+	}
+	return ""
+}
+
+// SessionInfo represents a short description of a session. Kind is either "historic" or "events".
+type SessionInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	CityId               string   `protobuf:"bytes,3,opt,name=cityId,proto3" json:"cityId,omitempty"`
+	StartTime            int64    `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	FinishTime           int64    `protobuf:"varint,5,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Finished             bool     `protobuf:"varint,7,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
+func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
+func (*SessionInfo) ProtoMessage()    {}
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92500682d66d7a3, []int{9}
+}
+func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionInfo.Merge(m, src)
+}
+func (m *SessionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionInfo proto.InternalMessageInfo
+
+func (m *SessionInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetCityId() string {
+	if m != nil {
+		return m.CityId
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+type ListResponse struct {
+	Sessions             []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Err                  string         `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListResponse) Reset()         { *m = ListResponse{} }
+func (m *ListResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()    {}
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92500682d66d7a3, []int{10}
+}
+func (m *ListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(m, src)
+}
+func (m *ListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResponse proto.InternalMessageInfo
+
+func (m *ListResponse) GetSessions() []*SessionInfo {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *ListResponse) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*HistoricRequest)(nil), "proto.HistoricRequest")
 	proto.RegisterType((*HistoricResponse)(nil), "proto.HistoricResponse")
@@ -423,6 +783,11 @@ func init() {
 	proto.RegisterType((*EventResponse)(nil), "proto.EventResponse")
 	proto.RegisterType((*StatusRequest)(nil), "proto.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "proto.StatusResponse")
+	proto.RegisterType((*CancelRequest)(nil), "proto.CancelRequest")
+	proto.RegisterType((*CancelResponse)(nil), "proto.CancelResponse")
+	proto.RegisterType((*ListRequest)(nil), "proto.ListRequest")
+	proto.RegisterType((*SessionInfo)(nil), "proto.SessionInfo")
+	proto.RegisterType((*ListResponse)(nil), "proto.ListResponse")
 }
 
 func init() {
@@ -430,34 +795,50 @@ func init() {
 }
 
 var fileDescriptor_f92500682d66d7a3 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0xbf, 0x8e, 0xd4, 0x30,
-	0x10, 0xc6, 0xcf, 0xc9, 0x6d, 0x74, 0x3b, 0xb0, 0x21, 0x32, 0xc7, 0x12, 0x45, 0x28, 0x44, 0xa1,
-	0x49, 0xc3, 0x9e, 0x38, 0x68, 0x28, 0x10, 0xe2, 0xc4, 0xdf, 0x36, 0x77, 0xa2, 0x37, 0xf1, 0xdc,
-	0x31, 0x12, 0x97, 0x1c, 0xb6, 0x77, 0x25, 0xf6, 0x25, 0x68, 0x29, 0x79, 0x13, 0x5a, 0x4a, 0x1e,
-	0x01, 0x2d, 0x2f, 0x82, 0xe2, 0x38, 0xd9, 0x3f, 0x34, 0x88, 0x86, 0x2a, 0xf9, 0xbe, 0x19, 0xdb,
-	0xbf, 0xcf, 0x1e, 0xb8, 0x87, 0x0b, 0xac, 0xcd, 0x7d, 0x89, 0x06, 0x2b, 0x43, 0x4d, 0x7d, 0x74,
-	0xa5, 0x1a, 0xd3, 0x1c, 0x69, 0x54, 0x0b, 0xaa, 0x70, 0x66, 0x15, 0x1f, 0xd9, 0x4f, 0x12, 0x75,
-	0x35, 0x29, 0x8c, 0xe8, 0x0a, 0xf9, 0x37, 0x06, 0x37, 0x5e, 0x93, 0x36, 0x8d, 0xa2, 0xaa, 0xc4,
-	0x8f, 0x73, 0xd4, 0x86, 0x27, 0x70, 0x60, 0xe8, 0x12, 0x97, 0x4d, 0x8d, 0x31, 0xcb, 0x58, 0x31,
-	0x2e, 0x07, 0xcd, 0xa7, 0x10, 0x54, 0x64, 0x3e, 0xbd, 0x91, 0xb1, 0x67, 0x2b, 0x4e, 0xf1, 0x3b,
-	0x30, 0xd6, 0x46, 0x28, 0x73, 0x46, 0x97, 0x18, 0xfb, 0x19, 0x2b, 0xfc, 0x72, 0x6d, 0xf0, 0x14,
-	0xe0, 0x9c, 0x6a, 0xd2, 0xef, 0x6d, 0x79, 0xdf, 0x96, 0x37, 0x1c, 0x9e, 0xc2, 0xbe, 0x50, 0x28,
-	0xe2, 0x51, 0xc6, 0x8a, 0x6b, 0xc7, 0x30, 0xb3, 0x80, 0xcf, 0x14, 0x8a, 0xd2, 0xfa, 0x2d, 0xd1,
-	0x85, 0x22, 0x79, 0x4a, 0x4b, 0x8c, 0x83, 0x8c, 0x15, 0xac, 0x1c, 0x74, 0xfe, 0x08, 0xa2, 0x75,
-	0x00, 0x7d, 0xd5, 0xd4, 0x1a, 0x79, 0x08, 0x1e, 0x49, 0xc7, 0xee, 0x91, 0xe4, 0x11, 0xf8, 0xa8,
-	0x94, 0x43, 0x6e, 0x7f, 0xf3, 0xaf, 0x0c, 0xae, 0xbf, 0x68, 0x2f, 0xee, 0x7f, 0x86, 0x86, 0x73,
-	0xfa, 0x60, 0x50, 0x9d, 0x89, 0x0b, 0x1d, 0x8f, 0x32, 0xbf, 0x18, 0x97, 0x1b, 0x4e, 0xfe, 0x00,
-	0x26, 0x8e, 0xf0, 0xaf, 0x53, 0xdd, 0x85, 0xc9, 0xa9, 0x11, 0x66, 0xae, 0xfb, 0x54, 0x3b, 0x4b,
-	0xf2, 0xb7, 0x10, 0xf6, 0x0d, 0x6e, 0xd3, 0x29, 0x04, 0xda, 0x3a, 0xae, 0xcb, 0xa9, 0xf6, 0x3e,
-	0x3a, 0x56, 0xec, 0x52, 0x1f, 0x94, 0x83, 0xee, 0x0f, 0xf6, 0x87, 0x83, 0x8f, 0x3f, 0x7b, 0x10,
-	0x5a, 0xd8, 0xe7, 0xfd, 0x18, 0xf2, 0x13, 0x98, 0xf4, 0xef, 0xf2, 0x4a, 0x91, 0xd4, 0x7c, 0xda,
-	0x8d, 0xdc, 0x6c, 0x67, 0xdc, 0x92, 0xdb, 0x7f, 0xf8, 0x1d, 0x5a, 0xbe, 0xc7, 0x9f, 0x42, 0xd8,
-	0xbb, 0x1d, 0x36, 0x3f, 0x74, 0xcd, 0x5b, 0x31, 0x93, 0x5b, 0x3b, 0xee, 0xb0, 0xc1, 0x63, 0x80,
-	0x97, 0x54, 0x4b, 0x8b, 0xa6, 0xf9, 0x4d, 0xd7, 0xb6, 0xf9, 0xf0, 0xc9, 0xe1, 0xb6, 0x39, 0x2c,
-	0x7d, 0xe2, 0x06, 0x44, 0xff, 0xd3, 0xc9, 0x27, 0xd1, 0xf7, 0x55, 0xca, 0x7e, 0xac, 0x52, 0xf6,
-	0x73, 0x95, 0xb2, 0x2f, 0xbf, 0xd2, 0xbd, 0x77, 0x81, 0xed, 0x7c, 0xf8, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0x67, 0x09, 0x30, 0xb1, 0x03, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xee, 0xc6, 0x89, 0x9b, 0x4c, 0xda, 0xfc, 0xa2, 0xed, 0x9f, 0x9f, 0x65, 0xa1, 0x10, 0x19,
+	0x81, 0x72, 0x69, 0x2a, 0x0a, 0x17, 0x0e, 0x80, 0x28, 0x50, 0xa8, 0xc4, 0xa1, 0x72, 0xfa, 0x02,
+	0x4b, 0x3c, 0x2d, 0x2b, 0x52, 0x6f, 0xd8, 0xdd, 0x56, 0xa2, 0x8f, 0xc0, 0x13, 0x20, 0x5e, 0x85,
+	0x17, 0xe0, 0x06, 0x8f, 0x80, 0xca, 0x9d, 0x67, 0x40, 0x5e, 0xef, 0xda, 0x4e, 0x42, 0x50, 0xc5,
+	0x85, 0x93, 0x3d, 0xdf, 0xcc, 0xec, 0xce, 0x7c, 0xf3, 0xed, 0xc0, 0x2d, 0xbc, 0xc0, 0x54, 0xef,
+	0x24, 0xa8, 0x71, 0xac, 0xb9, 0x48, 0x77, 0xa7, 0x52, 0x68, 0xb1, 0xab, 0x50, 0x5e, 0xf0, 0x31,
+	0x0e, 0x8d, 0x45, 0x1b, 0xe6, 0x13, 0x76, 0x73, 0x5f, 0xc2, 0x34, 0xcb, 0x1d, 0xd1, 0x4f, 0x02,
+	0xff, 0xbd, 0xe4, 0x4a, 0x0b, 0xc9, 0xc7, 0x31, 0xbe, 0x3b, 0x47, 0xa5, 0x69, 0x08, 0x4d, 0xcd,
+	0xcf, 0xf0, 0x52, 0xa4, 0x18, 0x90, 0x3e, 0x19, 0xb4, 0xe2, 0xc2, 0xa6, 0xdb, 0xe0, 0x8f, 0xb9,
+	0x7e, 0x7f, 0x98, 0x04, 0x35, 0xe3, 0xb1, 0x16, 0xbd, 0x01, 0x2d, 0xa5, 0x99, 0xd4, 0xc7, 0xfc,
+	0x0c, 0x03, 0xaf, 0x4f, 0x06, 0x5e, 0x5c, 0x02, 0xb4, 0x07, 0x70, 0xc2, 0x53, 0xae, 0xde, 0x18,
+	0x77, 0xdd, 0xb8, 0x2b, 0x08, 0xed, 0x41, 0x9d, 0x49, 0x64, 0x41, 0xa3, 0x4f, 0x06, 0xed, 0x3d,
+	0x18, 0x9a, 0x02, 0x9f, 0x48, 0x64, 0xb1, 0xc1, 0xb3, 0x8a, 0x4e, 0x25, 0x4f, 0x46, 0xfc, 0x12,
+	0x03, 0xbf, 0x4f, 0x06, 0x24, 0x2e, 0x6c, 0xba, 0x03, 0xfe, 0x94, 0x49, 0x76, 0xa6, 0x82, 0x55,
+	0x93, 0xbd, 0x95, 0x67, 0x3f, 0x73, 0x7c, 0x1c, 0x19, 0x67, 0x6c, 0x83, 0xa2, 0xfb, 0xd0, 0x2d,
+	0xfb, 0x55, 0x53, 0x91, 0x2a, 0xa4, 0x1d, 0xa8, 0xf1, 0xc4, 0xb6, 0x5a, 0xe3, 0x09, 0xed, 0x82,
+	0x87, 0x52, 0xda, 0x0e, 0xb3, 0xdf, 0xe8, 0x2b, 0x81, 0xb5, 0xe7, 0x19, 0xcf, 0xff, 0x92, 0x23,
+	0x38, 0xe1, 0x13, 0x8d, 0xf2, 0x98, 0x9d, 0xaa, 0xa0, 0xd1, 0xf7, 0x06, 0xad, 0xb8, 0x82, 0x54,
+	0x78, 0xf0, 0xaf, 0xc3, 0xc3, 0x5d, 0x58, 0xb7, 0x0d, 0x5d, 0x9b, 0x84, 0x9b, 0xb0, 0x3e, 0xd2,
+	0x4c, 0x9f, 0x2b, 0x47, 0xc2, 0x5c, 0x4a, 0xf4, 0xa9, 0x06, 0x1d, 0x17, 0x61, 0x4f, 0xdd, 0x06,
+	0x5f, 0x19, 0xc4, 0x86, 0x59, 0x2b, 0xe3, 0x2f, 0xef, 0x0d, 0x73, 0x96, 0x9a, 0x71, 0x61, 0xbb,
+	0x9b, 0xbd, 0xe2, 0x66, 0x7a, 0x07, 0x3a, 0x5a, 0x68, 0x36, 0x39, 0x4c, 0x35, 0xca, 0x0b, 0x36,
+	0x51, 0x96, 0x9f, 0x39, 0x94, 0x0e, 0x81, 0x4e, 0xa5, 0x18, 0xa3, 0x52, 0x98, 0x94, 0xb1, 0x0d,
+	0x13, 0xfb, 0x1b, 0x4f, 0x36, 0x91, 0xa9, 0x50, 0x5a, 0xc5, 0xc8, 0x12, 0x43, 0x9b, 0x17, 0x97,
+	0x00, 0xed, 0x43, 0xdb, 0xbc, 0x2d, 0x75, 0x20, 0xce, 0xd3, 0xc4, 0xc8, 0xcb, 0x8b, 0xab, 0x10,
+	0x0d, 0x60, 0x15, 0x27, 0x6c, 0xaa, 0x30, 0x09, 0x9a, 0xc6, 0xeb, 0x4c, 0xd3, 0x83, 0x66, 0x41,
+	0xcb, 0xa0, 0xd9, 0x6f, 0xc6, 0xde, 0x53, 0x96, 0x8e, 0x71, 0xb2, 0x8c, 0xbd, 0x08, 0x3a, 0x2e,
+	0xc0, 0x92, 0x67, 0x89, 0x20, 0xe5, 0x08, 0x6e, 0x43, 0xfb, 0x15, 0x57, 0x85, 0x0a, 0x4b, 0xa5,
+	0x91, 0xaa, 0xd2, 0xa2, 0xcf, 0x04, 0xda, 0x23, 0x54, 0x8a, 0x8b, 0xf4, 0x30, 0x3d, 0x11, 0x0b,
+	0xb3, 0xa5, 0x50, 0x7f, 0xcb, 0x53, 0xa7, 0x4f, 0xf3, 0x5f, 0x39, 0xcb, 0x5b, 0xae, 0xda, 0xfa,
+	0x9f, 0x55, 0xdb, 0x58, 0x50, 0x6d, 0x39, 0x7f, 0x7f, 0xe9, 0xfc, 0x57, 0x67, 0xe7, 0x1f, 0x1d,
+	0xc1, 0x5a, 0xde, 0xa4, 0xa5, 0x61, 0x08, 0x4d, 0x95, 0x37, 0x93, 0xa9, 0xc8, 0x1b, 0xb4, 0xf7,
+	0x68, 0xbe, 0xbd, 0x86, 0x95, 0x1e, 0xe3, 0x22, 0x66, 0x51, 0xb9, 0x7b, 0x1f, 0x3c, 0xe8, 0x18,
+	0xb5, 0x17, 0xaf, 0x81, 0xee, 0xc3, 0xba, 0xdb, 0x03, 0x2f, 0x24, 0x4f, 0x14, 0xdd, 0xb6, 0x67,
+	0xce, 0x6d, 0xc3, 0xf0, 0xff, 0x05, 0x3c, 0x2f, 0x2b, 0x5a, 0xa1, 0x8f, 0xa1, 0xe3, 0xd0, 0x5c,
+	0xf6, 0x74, 0xd3, 0x15, 0x56, 0x7d, 0x27, 0xe1, 0xd6, 0x1c, 0x5a, 0x1c, 0xf0, 0x00, 0xe0, 0x80,
+	0xa7, 0x89, 0x29, 0x4d, 0xd1, 0x0d, 0x1b, 0x56, 0x5d, 0x34, 0xe1, 0xe6, 0x2c, 0x58, 0xa4, 0x3e,
+	0xb4, 0x0b, 0x49, 0xfd, 0xdd, 0xcd, 0x8f, 0x9c, 0x1a, 0x2d, 0x85, 0x45, 0xfe, 0x8c, 0x46, 0xc3,
+	0xad, 0x39, 0xb4, 0x52, 0xb9, 0x99, 0xd1, 0xc8, 0x71, 0xee, 0x26, 0x52, 0x51, 0x67, 0xb8, 0x31,
+	0x83, 0xb9, 0xd4, 0xfd, 0xee, 0x97, 0xab, 0x1e, 0xf9, 0x76, 0xd5, 0x23, 0xdf, 0xaf, 0x7a, 0xe4,
+	0xe3, 0x8f, 0xde, 0xca, 0x6b, 0xdf, 0xc4, 0xdd, 0xfb, 0x35, 0x00, 0x05, 0xc5, 0xb1, 0xa6, 0xcb,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -476,6 +857,8 @@ type EventDetectionClient interface {
 	HistoricStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	FindEvents(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EventResponse, error)
 	EventsStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	CancelSession(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	ListSessions(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 }
 
 type eventDetectionClient struct {
@@ -522,12 +905,32 @@ func (c *eventDetectionClient) EventsStatus(ctx context.Context, in *StatusReque
 	return out, nil
 }
 
+func (c *eventDetectionClient) CancelSession(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, "/proto.EventDetection/CancelSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventDetectionClient) ListSessions(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/proto.EventDetection/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventDetectionServer is the server API for EventDetection service.
 type EventDetectionServer interface {
 	HistoricGrids(context.Context, *HistoricRequest) (*HistoricResponse, error)
 	HistoricStatus(context.Context, *StatusRequest) (*StatusResponse, error)
 	FindEvents(context.Context, *EventRequest) (*EventResponse, error)
 	EventsStatus(context.Context, *StatusRequest) (*StatusResponse, error)
+	CancelSession(context.Context, *CancelRequest) (*CancelResponse, error)
+	ListSessions(context.Context, *ListRequest) (*ListResponse, error)
 }
 
 // UnimplementedEventDetectionServer can be embedded to have forward compatible implementations.
@@ -546,6 +949,12 @@ func (*UnimplementedEventDetectionServer) FindEvents(ctx context.Context, req *E
 func (*UnimplementedEventDetectionServer) EventsStatus(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EventsStatus not implemented")
 }
+func (*UnimplementedEventDetectionServer) CancelSession(ctx context.Context, req *CancelRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
+}
+func (*UnimplementedEventDetectionServer) ListSessions(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
 
 func RegisterEventDetectionServer(s *grpc.Server, srv EventDetectionServer) {
 	s.RegisterService(&_EventDetection_serviceDesc, srv)
@@ -623,16 +1032,52 @@ func _EventDetection_EventsStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-var _EventDetection_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.EventDetection",
-	HandlerType: (*EventDetectionServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HistoricGrids",
-			Handler:    _EventDetection_HistoricGrids_Handler,
-		},
-		{
-			MethodName: "HistoricStatus",
+func _EventDetection_CancelSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventDetectionServer).CancelSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EventDetection/CancelSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventDetectionServer).CancelSession(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventDetection_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventDetectionServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.EventDetection/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventDetectionServer).ListSessions(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventDetection_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.EventDetection",
+	HandlerType: (*EventDetectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HistoricGrids",
+			Handler:    _EventDetection_HistoricGrids_Handler,
+		},
+		{
+			MethodName: "HistoricStatus",
 			Handler:    _EventDetection_HistoricStatus_Handler,
 		},
 		{
@@ -643,6 +1088,14 @@ var _EventDetection_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EventsStatus",
 			Handler:    _EventDetection_EventsStatus_Handler,
 		},
+		{
+			MethodName: "CancelSession",
+			Handler:    _EventDetection_CancelSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _EventDetection_ListSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event-detection/proto/service.proto",
@@ -672,6 +1125,18 @@ func (m *HistoricRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.GridSize != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GridSize))))
@@ -782,6 +1247,18 @@ func (m *EventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.FilterTags) > 0 {
 		for iNdEx := len(m.FilterTags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FilterTags[iNdEx])
@@ -917,6 +1394,36 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Eta != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Eta))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Elapsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Elapsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventsFound != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EventsFound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PostsRead != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PostsRead))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProcessedIntervals != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ProcessedIntervals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalIntervals != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TotalIntervals))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Err) > 0 {
 		i -= len(m.Err)
 		copy(dAtA[i:], m.Err)
@@ -944,134 +1451,367 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Timezone)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovService(uint64(m.StartTime))
-	}
-	if m.FinishTime != 0 {
-		n += 1 + sovService(uint64(m.FinishTime))
-	}
-	if m.Area != nil {
-		l = m.Area.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.GridSize != 0 {
-		n += 9
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *CancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Timezone)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.CityId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovService(uint64(m.StartTime))
-	}
-	if m.FinishTime != 0 {
-		n += 1 + sovService(uint64(m.FinishTime))
-	}
-	if len(m.FilterTags) > 0 {
-		for _, s := range m.FilterTags {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FinishTime != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.FinishTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CityId) > 0 {
+		i -= len(m.CityId)
+		copy(dAtA[i:], m.CityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.CityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Err) > 0 {
+		i -= len(m.Err)
+		copy(dAtA[i:], m.Err)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Err)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovService(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovService(uint64(m.FinishTime))
+	}
+	if m.Area != nil {
+		l = m.Area.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GridSize != 0 {
+		n += 9
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoricResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovService(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovService(uint64(m.FinishTime))
+	}
+	if len(m.FilterTags) > 0 {
+		for _, s := range m.FilterTags {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -1093,19 +1833,914 @@ func (m *StatusResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.TotalIntervals != 0 {
+		n += 1 + sovService(uint64(m.TotalIntervals))
+	}
+	if m.ProcessedIntervals != 0 {
+		n += 1 + sovService(uint64(m.ProcessedIntervals))
+	}
+	if m.PostsRead != 0 {
+		n += 1 + sovService(uint64(m.PostsRead))
+	}
+	if m.EventsFound != 0 {
+		n += 1 + sovService(uint64(m.EventsFound))
+	}
+	if m.Elapsed != 0 {
+		n += 1 + sovService(uint64(m.Elapsed))
+	}
+	if m.Eta != 0 {
+		n += 1 + sovService(uint64(m.Eta))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.CityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovService(uint64(m.StartTime))
+	}
+	if m.FinishTime != 0 {
+		n += 1 + sovService(uint64(m.FinishTime))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			m.FinishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Area == nil {
+				m.Area = &proto1.Area{}
+			}
+			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GridSize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GridSize = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &proto1.DetectionParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			m.FinishTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterTags = append(m.FilterTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &proto1.DetectionParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1128,15 +2763,15 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1164,11 +2799,31 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1196,13 +2851,13 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalIntervals", wireType)
 			}
-			m.StartTime = 0
+			m.TotalIntervals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1212,16 +2867,16 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				m.TotalIntervals |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedIntervals", wireType)
 			}
-			m.FinishTime = 0
+			m.ProcessedIntervals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1231,16 +2886,16 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishTime |= int64(b&0x7F) << shift
+				m.ProcessedIntervals |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostsRead", wireType)
 			}
-			var msglen int
+			m.PostsRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1250,39 +2905,151 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PostsRead |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsFound", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.EventsFound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsFound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+			}
+			m.Elapsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Elapsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eta", wireType)
+			}
+			m.Eta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Area == nil {
-				m.Area = &proto1.Area{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GridSize", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.GridSize = float64(math.Float64frombits(v))
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1305,7 +3072,7 @@ func (m *HistoricRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,15 +3095,15 @@ func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1364,11 +3131,62 @@ func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1396,7 +3214,7 @@ func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.CityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1420,7 +3238,7 @@ func (m *HistoricResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,15 +3261,15 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,11 +3297,11 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,13 +3329,13 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CityId = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CityId", wireType)
 			}
-			m.StartTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1527,16 +3345,29 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.FinishTime = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1546,16 +3377,16 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishTime |= int64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilterTags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			var stringLen uint64
+			m.FinishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1565,78 +3396,14 @@ func (m *EventRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FinishTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FilterTags = append(m.FilterTags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1664,13 +3431,13 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1680,24 +3447,12 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Err = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Finished = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1720,7 +3475,7 @@ func (m *EventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,17 +3498,17 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1763,128 +3518,27 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+			m.Sessions = append(m.Sessions, &SessionInfo{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Finished = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
@@ -1,7 +1,12 @@
 package service
 
 import (
+	"errors"
+	"os"
+
 	"github.com/BurntSushi/toml"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
 )
@@ -12,12 +17,98 @@ type Config struct {
 	MaxPoints          int
 	DataStorageAddress string
 	Address            string
+	SessionStorePath   string
+	Filter             detection.FilterConfig
+	// Detection holds default parameters of the detection algorithm, MaxPoints takes precedence
+	// over Detection.MaxPoints.
+	Detection detection.Params
+	// Volume sets up the detector of city-wide anomalies of the number of posts.
+	Volume detection.VolumeParams
+	// RollingRate is the rate of the exponentially weighted update of historic grids with posts of
+	// event sessions, zero disables the updates.
+	RollingRate float64
+	// Grids sets up the workers which build historic grids.
+	Grids GridsConfig
+}
+
+// GridsConfig sets up the workers which build historic grids of the sessions of all nodes. Tasks
+// are leased from the queue in data storage by Workers workers, WorkersNumber is used if it is zero
+// and a negative value disables the workers. NodeID names the node in the leases, the host name and
+// the address are used if it is empty. Lease is the duration of a lease and Poll is the interval of
+// polling the queue in seconds. A task fails after Retries failed attempts.
+type GridsConfig struct {
+	NodeID  string
+	Workers int
+	Lease   int64
+	Poll    int64
+	Retries int
+}
+
+var defaultGridsConfig = GridsConfig{
+	Lease:   600,
+	Poll:    10,
+	Retries: 3,
+}
+
+// detectionParams returns parameters which are used if the request doesn't set them.
+func (cfg Config) detectionParams() detection.Params {
+	p := detection.DefaultParams.Merge(cfg.Detection)
+	if cfg.MaxPoints != 0 {
+		p.MaxPoints = cfg.MaxPoints
+	}
+	return p
+}
+
+// requestParams merges the request parameters over the defaults from the config and validates them.
+func (cfg Config) requestParams(dp *data.DetectionParams) (detection.Params, error) {
+	p := cfg.detectionParams().Merge(detection.ParamsFromProto(dp))
+	err := p.Validate()
+	if err != nil {
+		unilog.Logger().Error("invalid detection parameters", zap.Error(err))
+	}
+	return p, err
+}
+
+func (cfg Config) volumeParams() detection.VolumeParams {
+	return detection.DefaultVolumeParams.Merge(cfg.Volume)
+}
+
+// gridsConfig returns the config of the grid workers with defaults for unset values.
+func (cfg Config) gridsConfig() GridsConfig {
+	gc := cfg.Grids
+	if gc.Workers == 0 {
+		gc.Workers = cfg.WorkersNumber
+	}
+	if gc.Lease <= 0 {
+		gc.Lease = defaultGridsConfig.Lease
+	}
+	if gc.Poll <= 0 {
+		gc.Poll = defaultGridsConfig.Poll
+	}
+	if gc.Retries <= 0 {
+		gc.Retries = defaultGridsConfig.Retries
+	}
+	if gc.NodeID == "" {
+		host, _ := os.Hostname()
+		gc.NodeID = host + "/" + cfg.Address
+	}
+	return gc
 }
 
 func readConfig(path string) (cfg Config, err error) {
 	_, err = toml.DecodeFile(path, &cfg)
 	if err != nil {
 		unilog.Logger().Error("unable to read config file", zap.String("path", path), zap.Error(err))
+		return
+	}
+	err = cfg.volumeParams().Validate()
+	if err != nil {
+		unilog.Logger().Error("invalid volume parameters", zap.String("path", path), zap.Error(err))
+		return
+	}
+	if cfg.RollingRate < 0 || cfg.RollingRate > 1 {
+		err = errors.New("rolling rate must be in [0, 1]")
+		unilog.Logger().Error("invalid rolling rate", zap.String("path", path), zap.Error(err))
 	}
 	return
 }
@@ -34,3 +34,23 @@ func decodeGRPCStatusResponse(_ context.Context, grpcReply interface{}) (interfa
 	reply := grpcReply.(*proto.StatusResponse)
 	return *reply, nil
 }
+
+func decodeGRPCCancelSessionRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.CancelRequest)
+	return *req, nil
+}
+
+func decodeGRPCCancelSessionResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.CancelResponse)
+	return *reply, nil
+}
+
+func decodeGRPCListSessionsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.ListRequest)
+	return *req, nil
+}
+
+func decodeGRPCListSessionsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.ListResponse)
+	return *reply, nil
+}
@@ -34,3 +34,23 @@ func encodeGRPCStatusResponse(_ context.Context, request interface{}) (interface
 	req := request.(proto.StatusResponse)
 	return req, nil
 }
+
+func encodeGRPCCancelSessionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.CancelRequest)
+	return &req, nil
+}
+
+func encodeGRPCCancelSessionResponse(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.CancelResponse)
+	return req, nil
+}
+
+func encodeGRPCListSessionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.ListRequest)
+	return &req, nil
+}
+
+func encodeGRPCListSessionsResponse(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(proto.ListResponse)
+	return req, nil
+}
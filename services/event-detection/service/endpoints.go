@@ -5,6 +5,8 @@ import (
 
 	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
 	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func makeHistoricGridsEndpoint(s Service) endpoint.Endpoint {
@@ -22,12 +24,14 @@ func makeHistoricGridsEndpoint(s Service) endpoint.Endpoint {
 func makeHistoricStatusEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(proto.StatusRequest)
-		s, f, err := s.HistoricStatus(ctx, req)
-		var msg string
+		resp, err := s.HistoricStatus(ctx, req)
+		if err == ErrSessionNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		if err != nil {
-			msg = err.Error()
+			resp.Err = err.Error()
 		}
-		return proto.StatusResponse{Status: s, Finished: f, Err: msg}, nil
+		return resp, nil
 	}
 }
 
@@ -46,11 +50,40 @@ func makeFindEventsEndpoint(s Service) endpoint.Endpoint {
 func makeEventsStatusEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(proto.StatusRequest)
-		s, f, err := s.EventsStatus(ctx, req)
+		resp, err := s.EventsStatus(ctx, req)
+		if err == ErrSessionNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			resp.Err = err.Error()
+		}
+		return resp, nil
+	}
+}
+
+func makeCancelSessionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.CancelRequest)
+		err = s.CancelSession(ctx, req)
+		if err == ErrSessionNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		return proto.CancelResponse{Err: msg}, nil
+	}
+}
+
+func makeListSessionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(proto.ListRequest)
+		sessions, err := s.ListSessions(ctx, req)
 		var msg string
 		if err != nil {
 			msg = err.Error()
 		}
-		return proto.StatusResponse{Status: s, Finished: f, Err: msg}, nil
+		return proto.ListResponse{Sessions: sessions, Err: msg}, nil
 	}
 }
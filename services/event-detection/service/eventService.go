@@ -2,26 +2,94 @@ package service
 
 import (
 	"context"
+	"sort"
 	"sync"
 
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
 	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
 	"github.com/google/uuid"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+const (
+	historicKind = "historic"
+	eventsKind   = "events"
 )
 
 type eventService struct {
 	cfg           Config
+	store         *sessionStore
 	histSesssions map[string]*historicSession
 	eventSessions map[string]*eventSession
 	mut           sync.Mutex
 }
 
-func newEventService(cfg Config) *eventService {
-	return &eventService{cfg: cfg, histSesssions: make(map[string]*historicSession), eventSessions: make(map[string]*eventSession)}
+func newEventService(cfg Config) (*eventService, error) {
+	store, err := openSessionStore(cfg.SessionStorePath)
+	if err != nil {
+		return nil, err
+	}
+	svc := &eventService{
+		cfg:           cfg,
+		store:         store,
+		histSesssions: make(map[string]*historicSession),
+		eventSessions: make(map[string]*eventSession),
+	}
+	err = svc.restoreSessions()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return svc, nil
+}
+
+// restoreSessions loads sessions from the store and resumes the ones which were running.
+func (svc *eventService) restoreSessions() error {
+	hrs, err := svc.store.loadHistoric()
+	if err != nil {
+		unilog.Logger().Error("unable to load historic sessions", zap.Error(err))
+		return err
+	}
+	for _, r := range hrs {
+		session, err := restoreHistoricSession(svc.cfg, r, svc.store)
+		if err != nil {
+			return err
+		}
+		svc.histSesssions[r.ID] = session
+		if r.Status == RunningStatus {
+			unilog.Logger().Info("resume historic session", zap.String("session", r.ID), zap.Int("done", len(r.Done)))
+			go session.generateGrids()
+		}
+	}
+	ers, err := svc.store.loadEvents()
+	if err != nil {
+		unilog.Logger().Error("unable to load event sessions", zap.Error(err))
+		return err
+	}
+	for _, r := range ers {
+		session := restoreEventSession(svc.cfg, r, svc.store)
+		svc.eventSessions[r.ID] = session
+		if r.Status == RunningStatus {
+			unilog.Logger().Info("resume event session", zap.String("session", r.ID), zap.Int("done", len(r.Done)))
+			go session.detectEvents()
+		}
+	}
+	return nil
 }
 
 func (svc *eventService) HistoricGrids(ctx context.Context, histReq proto.HistoricRequest) (string, error) {
+	p, err := svc.cfg.requestParams(histReq.Params)
+	if err != nil {
+		return "", err
+	}
+	dp := p.Proto()
+	histReq.Params = &dp
 	id := uuid.New().String()
-	session := newHistoricSession(svc.cfg, histReq, id)
+	session, err := newHistoricSession(svc.cfg, histReq, id, svc.store)
+	if err != nil {
+		return "", err
+	}
 	svc.mut.Lock()
 	svc.histSesssions[id] = session
 	svc.mut.Unlock()
@@ -29,17 +97,32 @@ func (svc *eventService) HistoricGrids(ctx context.Context, histReq proto.Histor
 	return id, nil
 }
 
-func (svc *eventService) HistoricStatus(ctx context.Context, req proto.StatusRequest) (string, bool, error) {
-	finished := false
-	if svc.histSesssions[req.Id].status == FinishedStatus {
-		finished = true
+func (svc *eventService) HistoricStatus(ctx context.Context, req proto.StatusRequest) (proto.StatusResponse, error) {
+	svc.mut.Lock()
+	session, ok := svc.histSesssions[req.Id]
+	svc.mut.Unlock()
+	if !ok {
+		return proto.StatusResponse{}, ErrSessionNotFound
 	}
-	return svc.histSesssions[req.Id].status.String(), finished, nil
+	return session.statusResponse(), nil
 }
 
 func (svc *eventService) FindEvents(ctx context.Context, eventReq proto.EventRequest) (string, error) {
+	err := detection.ParamsFromProto(eventReq.Params).ValidateSearch()
+	if err != nil {
+		unilog.Logger().Error("invalid detection parameters", zap.Error(err))
+		return "", err
+	}
+	// parameters which are not set in the request are taken from the grids at the start of the session
+	_, err = svc.cfg.requestParams(eventReq.Params)
+	if err != nil {
+		return "", err
+	}
 	id := uuid.New().String()
-	session := newEventSession(svc.cfg, eventReq, id)
+	session, err := newEventSession(svc.cfg, eventReq, id, svc.store)
+	if err != nil {
+		return "", err
+	}
 	svc.mut.Lock()
 	svc.eventSessions[id] = session
 	svc.mut.Unlock()
@@ -47,10 +130,55 @@ func (svc *eventService) FindEvents(ctx context.Context, eventReq proto.EventReq
 	return id, nil
 }
 
-func (svc *eventService) EventsStatus(ctx context.Context, req proto.StatusRequest) (string, bool, error) {
-	finished := false
-	if svc.eventSessions[req.Id].status == FinishedStatus {
-		finished = true
+func (svc *eventService) EventsStatus(ctx context.Context, req proto.StatusRequest) (proto.StatusResponse, error) {
+	svc.mut.Lock()
+	session, ok := svc.eventSessions[req.Id]
+	svc.mut.Unlock()
+	if !ok {
+		return proto.StatusResponse{}, ErrSessionNotFound
+	}
+	return session.statusResponse(), nil
+}
+
+func (svc *eventService) CancelSession(ctx context.Context, req proto.CancelRequest) error {
+	svc.mut.Lock()
+	hs, hok := svc.histSesssions[req.Id]
+	es, eok := svc.eventSessions[req.Id]
+	svc.mut.Unlock()
+	switch {
+	case hok:
+		return hs.stop()
+	case eok:
+		return es.stop()
+	default:
+		return ErrSessionNotFound
+	}
+}
+
+func (svc *eventService) ListSessions(ctx context.Context, req proto.ListRequest) ([]*proto.SessionInfo, error) {
+	svc.mut.Lock()
+	res := make([]*proto.SessionInfo, 0, len(svc.histSesssions)+len(svc.eventSessions))
+	for _, s := range svc.histSesssions {
+		res = append(res, s.info())
+	}
+	for _, s := range svc.eventSessions {
+		res = append(res, s.info())
+	}
+	svc.mut.Unlock()
+	if req.CityId != "" {
+		filtered := res[:0]
+		for _, s := range res {
+			if s.CityId == req.CityId {
+				filtered = append(filtered, s)
+			}
+		}
+		res = filtered
 	}
-	return svc.eventSessions[req.Id].status.String(), finished, nil
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].StartTime != res[j].StartTime {
+			return res[i].StartTime < res[j].StartTime
+		}
+		return res[i].Id < res[j].Id
+	})
+	return res, nil
 }
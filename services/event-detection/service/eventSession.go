@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
+	"fmt"
 	"sync"
 	"time"
 
 	service "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
 	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
 	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
 	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/services/tokenizer"
 	convtree "github.com/visheratin/conv-tree"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
@@ -23,182 +26,680 @@ type eventSession struct {
 	cfg      Config
 	eventReq proto.EventRequest
 	grids    map[int64][]byte
+	versions map[int64]int64
+	hours    map[int64][]data.Point
+	tags     *detection.TagModel
+	filter   *detection.Filter
+	locs     map[string]string
+	params   detection.Params
+	done     map[int64]bool
+	skipped  []int64
+	prog     progress
+	store    *sessionStore
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mut      sync.Mutex
 }
 
-func newEventSession(config Config, eventReq proto.EventRequest, id string) *eventSession {
-	return &eventSession{
+// connect returns the client of data storage at the address, it is replaced in tests.
+var connect = connectDataStorage
+
+func connectDataStorage(addr string) (service.Service, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	if err != nil {
+		return nil, err
+	}
+	return service.NewGRPCClient(conn), nil
+}
+
+// selectAttempts is the number of attempts to read posts of a window from data storage.
+const selectAttempts = 3
+
+// intervalEvents holds events found in the interval which starts at the start timestamp and the
+// number of posts read for it. Failed intervals are not marked as done.
+type intervalEvents struct {
+	start  int64
+	posts  int
+	events []data.Event
+	failed bool
+}
+
+func newEventSession(config Config, eventReq proto.EventRequest, id string, store *sessionStore) (*eventSession, error) {
+	es := &eventSession{
 		id:       id,
 		status:   RunningStatus,
 		cfg:      config,
 		eventReq: eventReq,
 		grids:    make(map[int64][]byte),
+		done:     make(map[int64]bool),
+		store:    store,
+	}
+	es.ctx, es.cancel = context.WithCancel(context.Background())
+	err := store.saveEvent(es.record())
+	if err != nil {
+		unilog.Logger().Error("unable to save event session", zap.String("session", id), zap.Error(err))
+		return nil, err
+	}
+	return es, nil
+}
+
+func restoreEventSession(config Config, r eventRecord, store *sessionStore) *eventSession {
+	done := make(map[int64]bool, len(r.Done))
+	for _, k := range r.Done {
+		done[k] = true
+	}
+	es := &eventSession{
+		id:       r.ID,
+		status:   r.Status,
+		cfg:      config,
+		eventReq: r.Request,
+		grids:    make(map[int64][]byte),
+		done:     done,
+		skipped:  r.Skipped,
+		prog:     r.Progress,
+		store:    store,
+	}
+	es.ctx, es.cancel = context.WithCancel(context.Background())
+	return es
+}
+
+// record must be called with es.mut held. Done intervals are stored by complete, so they are not
+// set in the record.
+func (es *eventSession) record() eventRecord {
+	return eventRecord{
+		ID:       es.id,
+		Request:  es.eventReq,
+		Status:   es.status,
+		Skipped:  es.skipped,
+		Progress: es.prog,
+	}
+}
+
+func (es *eventSession) statusResponse() proto.StatusResponse {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	resp := proto.StatusResponse{Status: es.status.String(), Finished: es.status == FinishedStatus}
+	es.prog.fill(&resp, int64(len(es.done)), time.Now())
+	if len(es.skipped) > 0 {
+		resp.Err = fmt.Sprintf("posts of %d windows couldn't be read, windows starting at %v were skipped", len(es.skipped), es.skipped)
+	}
+	return resp
+}
+
+func (es *eventSession) info() *proto.SessionInfo {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	return &proto.SessionInfo{
+		Id:         es.id,
+		Kind:       eventsKind,
+		CityId:     es.eventReq.CityId,
+		StartTime:  es.eventReq.StartTime,
+		FinishTime: es.eventReq.FinishTime,
+		Status:     es.status.String(),
+		Finished:   es.status == FinishedStatus,
+	}
+}
+
+// setStatus moves a running session to the status s, sessions in other statuses are not changed.
+func (es *eventSession) setStatus(s StatusType) error {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	if es.status != RunningStatus {
+		return ErrSessionNotRunning
+	}
+	es.status = s
+	if s != RunningStatus {
+		es.prog.Finished = time.Now()
+	}
+	err := es.store.saveEvent(es.record())
+	if err != nil {
+		unilog.Logger().Error("unable to save event session", zap.String("session", es.id), zap.Error(err))
+	}
+	return nil
+}
+
+// fail marks the session as failed and stops its workers.
+func (es *eventSession) fail() {
+	es.setStatus(FailedStatus)
+	es.cancel()
+}
+
+func (es *eventSession) stop() error {
+	err := es.setStatus(CanceledStatus)
+	if err != nil {
+		return err
+	}
+	es.cancel()
+	return nil
+}
+
+func (es *eventSession) start(total int64) {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	es.prog.Total = total
+	es.prog.start(int64(len(es.done)), time.Now())
+}
+
+func (es *eventSession) isDone(start int64) bool {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	return es.done[start]
+}
+
+func (es *eventSession) complete(ie intervalEvents) {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	es.done[ie.start] = true
+	es.prog.Posts += int64(ie.posts)
+	es.prog.Events += int64(len(ie.events))
+	err := es.store.completeEvent(es.record(), ie.start)
+	if err != nil {
+		unilog.Logger().Error("unable to save event session", zap.String("session", es.id), zap.Error(err))
 	}
 }
 
+// skip records the window which posts couldn't be read. The window is not marked as done.
+func (es *eventSession) skip(start int64) {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	es.skipped = append(es.skipped, start)
+	err := es.store.saveEvent(es.record())
+	if err != nil {
+		unilog.Logger().Error("unable to save event session", zap.String("session", es.id), zap.Error(err))
+	}
+}
+
+func (es *eventSession) hasSkipped() bool {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	return len(es.skipped) > 0
+}
+
+// detectEvents searches for events in the windows of the session which are not done yet. The
+// session fails if posts of some windows couldn't be read, the skipped windows are reported in its
+// status.
 func (es *eventSession) detectEvents() {
-	conn, err := grpc.Dial(es.cfg.DataStorageAddress, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	client, err := connect(es.cfg.DataStorageAddress)
 	if err != nil {
 		unilog.Logger().Error("unable to connect to data storage", zap.Error(err))
-		es.status = FailedStatus
+		es.fail()
 		return
 	}
-	client := service.NewGRPCClient(conn)
-	ids := generateGridIds(es.eventReq.StartTime, es.eventReq.FinishTime)
-	es.grids, err = client.PullGrid(context.Background(), es.eventReq.CityId, ids)
+	loc, err := time.LoadLocation(es.eventReq.Timezone)
+	if err != nil {
+		unilog.Logger().Error("unable to load timezone", zap.Error(err))
+		es.fail()
+		return
+	}
+	ids := generateGridIds(es.eventReq.StartTime, es.eventReq.FinishTime, loc)
+	if es.cfg.RollingRate > 0 {
+		for _, id := range ids {
+			ids = append(ids, detection.StatsKey(id))
+		}
+	}
+	ids = append(ids, detection.TagModelKey, detection.ParamsKey)
+	es.grids, es.versions, err = client.PullGrid(es.ctx, es.eventReq.CityId, "", ids)
 	if err != nil {
 		unilog.Logger().Error("unable to get grids from data storage", zap.Error(err))
-		es.status = FailedStatus
+		es.fail()
 		return
 	}
 
-	times, err := getTimes(es.eventReq.StartTime, es.eventReq.FinishTime, es.eventReq.Timezone)
+	if b, ok := es.grids[detection.TagModelKey]; ok {
+		tags := detection.NewTagModel()
+		err = gob.NewDecoder(bytes.NewReader(b)).Decode(&tags)
+		if err != nil {
+			unilog.Logger().Error("unable to decode tag model", zap.Error(err))
+			es.fail()
+			return
+		}
+		es.tags = &tags
+	}
+	var gridParams data.DetectionParams
+	if b, ok := es.grids[detection.ParamsKey]; ok {
+		err = gridParams.Unmarshal(b)
+		if err != nil {
+			unilog.Logger().Error("unable to decode detection parameters", zap.Error(err))
+			es.fail()
+			return
+		}
+	}
+	es.params = es.cfg.detectionParams().Merge(detection.ParamsFromProto(&gridParams)).Merge(detection.ParamsFromProto(es.eventReq.Params))
+	err = es.params.Validate()
+	if err != nil {
+		unilog.Logger().Error("invalid detection parameters", zap.Error(err))
+		es.fail()
+		return
+	}
+	if es.tags == nil {
+		tags := detection.NewTagModel()
+		es.tags = &tags
+	}
+	es.tags.Language = es.params.Language
+	bots, err := client.PullBots(es.ctx, es.eventReq.CityId)
+	if err != nil {
+		unilog.Logger().Error("unable to get bots from data storage", zap.Error(err))
+		es.fail()
+		return
+	}
+	es.filter = detection.NewFilter(es.cfg.Filter, bots)
+	locations, err := client.PullLocations(es.ctx, es.eventReq.CityId)
 	if err != nil {
-		unilog.Logger().Error("unable to generate intervals", zap.Error(err))
-		es.status = FailedStatus
+		unilog.Logger().Error("unable to get locations from data storage", zap.Error(err))
+		es.fail()
 		return
 	}
+	es.locs = make(map[string]string, len(locations))
+	for _, l := range locations {
+		es.locs[l.ID] = l.Title
+	}
+	windows := detection.Windows(es.eventReq.StartTime, es.eventReq.FinishTime, es.params.WindowLength, es.params.WindowStride, loc)
+	pending := []detection.Window{}
+	for _, w := range windows {
+		if !es.isDone(w.Start) {
+			pending = append(pending, w)
+		}
+	}
+	es.start(int64(len(windows)))
 
 	wg := &sync.WaitGroup{}
 	ewg := &sync.WaitGroup{}
-	evChan := make(chan []data.Event)
-	go loadEvents(evChan, es.cfg.DataStorageAddress, es.eventReq.CityId, ewg, &err)
+	evChan := make(chan intervalEvents)
+	var pushErr error
 	ewg.Add(1)
+	go es.loadEvents(evChan, pending, ewg, &pushErr)
 
-	timeChan := make(chan [2]time.Time)
+	winChan := make(chan detection.Window)
 	for w := 0; w < es.cfg.WorkersNumber; w++ {
 		wg.Add(1)
-		go es.eventWorker(wg, timeChan, evChan)
+		go es.eventWorker(wg, loc, winChan, evChan)
 	}
-	for _, t := range times {
-		timeChan <- t
+feed:
+	for _, w := range pending {
+		select {
+		case winChan <- w:
+		case <-es.ctx.Done():
+			break feed
+		}
 	}
-	close(timeChan)
+	close(winChan)
 	wg.Wait()
 	close(evChan)
 	ewg.Wait()
 
+	if pushErr != nil {
+		unilog.Logger().Error("error during pushing events to data storage", zap.Error(pushErr))
+		es.fail()
+		return
+	}
+	if es.ctx.Err() != nil {
+		return
+	}
+	err = es.detectVolume(client)
 	if err != nil {
-		unilog.Logger().Error("error during pushing events to data storage", zap.Error(err))
-		es.status = FailedStatus
+		es.fail()
+		return
+	}
+	if es.cfg.RollingRate > 0 {
+		err = es.updateGrids(client, loc)
+		if err != nil {
+			es.fail()
+			return
+		}
+	}
+	if es.hasSkipped() {
+		unilog.Logger().Error("some windows were skipped", zap.String("session", es.id))
+		es.fail()
 		return
 	}
-	es.status = FinishedStatus
+	es.setStatus(FinishedStatus)
 }
 
-func getTimes(start, finish int64, tz string) ([][2]time.Time, error) {
-	loc, err := time.LoadLocation(tz)
+// detectVolume searches for city-wide anomalies of the number of posts in the period of the session
+// and pushes them to data storage as alerts.
+func (es *eventSession) detectVolume(client service.Service) error {
+	p := es.cfg.volumeParams()
+	start, finish := es.eventReq.StartTime, es.eventReq.FinishTime
+	timeline, err := client.PullTimeline(es.ctx, es.eventReq.CityId, start-p.History(), finish)
 	if err != nil {
-		unilog.Logger().Error("unable to load timezone", zap.Error(err))
-		return nil, err
+		unilog.Logger().Error("unable to get timeline from data storage", zap.Error(err))
+		return err
 	}
-	res := [][2]time.Time{}
-	s := time.Unix(start, 0)
-	s = s.In(loc)
-	f := time.Unix(finish, 0)
-	f = f.In(loc)
-	c := s
-	for c.Before(f) {
-		var r [2]time.Time
-		if f.Sub(c) > time.Hour {
-			r = [2]time.Time{c, c.Add(time.Hour)}
-		} else {
-			r = [2]time.Time{c, f}
-		}
-		res = append(res, r)
-		c = c.Add(time.Hour)
+	alerts := detection.VolumeAlerts(timeline, p, start, finish)
+	if len(alerts) == 0 {
+		return nil
 	}
-	return res, nil
+	unilog.Logger().Info("found volume alerts", zap.String("session", es.id), zap.Int("num", len(alerts)))
+	err = client.PushAlerts(es.ctx, es.eventReq.CityId, alerts)
+	if err != nil {
+		unilog.Logger().Error("unable to push alerts to data storage", zap.Error(err))
+	}
+	return err
 }
 
-func generateGridIds(startDate, finishDate int64) []int64 {
-	startTime := time.Unix(startDate, 0)
-	finishTime := time.Unix(finishDate, 0)
+func generateGridIds(startDate, finishDate int64, loc *time.Location) []int64 {
+	startTime := time.Unix(startDate, 0).In(loc)
+	finishTime := time.Unix(finishDate, 0).In(loc)
 	t := startTime
 	res := []int64{}
 	for t.Before(finishTime) {
-		v := getGridNum(t.Month(), t.Weekday(), t.Hour())
+		v := detection.GridKey(t)
 		res = append(res, v)
 		t = t.Add(time.Hour)
 	}
 	return res
 }
 
-func getGridNum(month time.Month, day time.Weekday, hour int) int64 {
-	monthNum := int64(month)
-	var dayNum int64
-	switch day {
-	case time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday:
-		dayNum = 1
-	case time.Saturday, time.Sunday:
-		dayNum = 2
-	}
-	gridNum := monthNum*1000 + dayNum*100 + int64(hour)
-	return gridNum
-}
-
-func (es *eventSession) eventWorker(wg *sync.WaitGroup, timeChan chan [2]time.Time, eChan chan []data.Event) {
+// eventWorker searches for events in windows using the grids of the hours they cover. Thresholds of
+// hours without a grid are estimated from the other hours, windows mostly without grids are skipped.
+// Reading of posts is retried, windows which posts couldn't be read are sent as failed.
+func (es *eventSession) eventWorker(wg *sync.WaitGroup, loc *time.Location, winChan chan detection.Window, eChan chan intervalEvents) {
 	defer wg.Done()
-	conn, err := grpc.Dial(es.cfg.DataStorageAddress, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	cl, err := connect(es.cfg.DataStorageAddress)
 	if err != nil {
 		unilog.Logger().Error("unable to connect to data strorage", zap.Error(err))
+		es.fail()
 		return
 	}
-	cl := service.NewGRPCClient(conn)
-
-	for t := range timeChan {
-		timeNum := getGridNum(t[0].Month(), t[0].Weekday(), t[0].Hour())
-		buf := bytes.NewBuffer(es.grids[timeNum])
-		dec := gob.NewDecoder(buf)
 
-		var grid convtree.ConvTree
+	for w := range winChan {
+		grids := []convtree.ConvTree{}
+		shares := []float64{}
+		for _, slot := range w.Slots {
+			b, ok := es.grids[detection.GridKey(time.Unix(slot.Start, 0).In(loc))]
+			if !ok {
+				continue
+			}
+			var grid convtree.ConvTree
+			if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&grid); err != nil {
+				unilog.Logger().Error("unable to decode grid", zap.Error(err))
+				es.fail()
+				return
+			}
+			grids = append(grids, grid)
+			shares = append(shares, slot.Share)
+		}
+		shares, coverage, ok := detection.ScaleShares(w, shares)
+		if !ok {
+			unilog.Logger().Warn("window is skipped, there are no grids of most of its hours", zap.String("session", es.id),
+				zap.String("timestamp", time.Unix(w.Start, 0).In(loc).String()), zap.Float64("coverage", coverage))
+			eChan <- intervalEvents{start: w.Start}
+			continue
+		}
+		if coverage < 1 {
+			unilog.Logger().Warn("there are no grids of some hours of the window", zap.String("session", es.id),
+				zap.String("timestamp", time.Unix(w.Start, 0).In(loc).String()), zap.Float64("coverage", coverage))
+		}
 
-		if err := dec.Decode(&grid); err != nil {
-			unilog.Logger().Error("unable to decode grid", zap.Error(err))
-			es.status = FailedStatus
+		var posts []data.Post
+		err := retry(es.ctx, selectAttempts, func() (err error) {
+			posts, _, err = cl.SelectPosts(es.ctx, es.eventReq.CityId, w.Start, w.Finish)
+			return err
+		})
+		if es.ctx.Err() != nil {
 			return
 		}
-
-		startTime := t[0].Unix()
-		finishTime := t[1].Unix()
-		posts, _, err := cl.SelectPosts(context.Background(), es.eventReq.CityId, startTime, finishTime)
 		if err != nil {
 			unilog.Logger().Error("unable to get posts from data storage", zap.Error(err))
+			eChan <- intervalEvents{start: w.Start, failed: true}
 			continue
 		}
+		if es.cfg.RollingRate > 0 {
+			es.addHours(w, posts, loc)
+		}
 
-		filterTags := filterTags(es.eventReq.FilterTags)
-		evs, found := detection.FindEvents(grid, posts, es.cfg.MaxPoints, filterTags, startTime, finishTime)
+		opts := detection.Options{
+			FilterTags: filterTags(es.eventReq.FilterTags),
+			TagModel:   es.tags,
+			Filter:     es.filter,
+			Locations:  es.locs,
+		}
+		evs, found := detection.FindWindowEvents(grids, shares, posts, es.params, opts, w.Start, w.Finish)
 		if found {
 			unilog.Logger().Info("found events", zap.String("session", es.id),
-				zap.Int("num", len(evs)), zap.String("timestamp", t[0].String()))
-			eChan <- evs
+				zap.Int("num", len(evs)), zap.String("timestamp", time.Unix(w.Start, 0).In(loc).String()))
+		} else {
+			evs = nil
+		}
+		eChan <- intervalEvents{start: w.Start, posts: len(posts), events: evs}
+	}
+}
+
+// updateAttempts is the number of attempts to update the statistics of historic grids which were
+// changed concurrently by other sessions.
+const updateAttempts = 3
+
+// addHours keeps the positions of posts of the full hours of the window read by a worker, so that
+// they are not read again when the statistics of historic grids are updated. Hours are clock hours
+// of the location.
+func (es *eventSession) addHours(w detection.Window, posts []data.Post, loc *time.Location) {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	if es.hours == nil {
+		es.hours = map[int64][]data.Point{}
+	}
+	added := map[int64]bool{}
+	for _, slot := range w.Slots {
+		if slot.Share < 1 {
+			continue
+		}
+		if _, ok := es.hours[slot.Start]; !ok {
+			es.hours[slot.Start] = []data.Point{}
+			added[slot.Start] = true
+		}
+	}
+	for _, p := range posts {
+		h := detection.HourStart(p.Timestamp, loc)
+		if added[h] {
+			es.hours[h] = append(es.hours[h], data.Point{Lat: p.Lat, Lon: p.Lon})
+		}
+	}
+}
+
+// updateGrids adds posts of the full hours of the session to the statistics of their historic grids
+// and pushes the rebuilt grids to data storage. Hours with events are skipped, grids without
+// statistics are not updated. Posts read by workers are reused. If the statistics have been changed
+// by another session in the meantime, they are pulled again and the update is repeated.
+func (es *eventSession) updateGrids(client service.Service, loc *time.Location) error {
+	start, finish := es.eventReq.StartTime, es.eventReq.FinishTime
+	events, err := client.PullEventsTags(es.ctx, es.eventReq.CityId, nil, start, finish)
+	if err != nil {
+		unilog.Logger().Error("unable to get events from data storage", zap.Error(err))
+		return err
+	}
+	eventHours := map[int64]bool{}
+	for _, e := range events {
+		for h := detection.HourStart(e.Start, loc); h < e.Finish; h += 3600 {
+			eventHours[h] = true
+		}
+	}
+	hours := map[int64][]int64{}
+	ids := []int64{}
+	first := detection.HourStart(start, loc)
+	if first < start {
+		first += 3600
+	}
+	for h := first; h+3600 <= finish; h += 3600 {
+		if eventHours[h] {
+			continue
 		}
+		key := detection.GridKey(time.Unix(h, 0).In(loc))
+		if _, ok := es.grids[detection.StatsKey(key)]; !ok {
+			continue
+		}
+		if _, ok := hours[key]; !ok {
+			ids = append(ids, detection.StatsKey(key))
+		}
+		hours[key] = append(hours[key], h)
+	}
+	if len(hours) == 0 {
+		return nil
+	}
+	points, err := es.hourPoints(client, hours)
+	if err != nil {
+		return err
+	}
+	grids, versions := es.grids, es.versions
+	for i := 1; ; i++ {
+		err = es.pushStats(client, hours, points, grids, versions)
+		if err == nil || err.Error() != storage.ErrGridVersion.Error() || i >= updateAttempts {
+			return err
+		}
+		unilog.Logger().Warn("grid statistics have been changed by another session", zap.String("session", es.id),
+			zap.Int("attempt", i))
+		grids, versions, err = client.PullGrid(es.ctx, es.eventReq.CityId, "", ids)
+		if err != nil {
+			unilog.Logger().Error("unable to get grid statistics from data storage", zap.Error(err))
+			return err
+		}
+	}
+}
+
+// hourPoints returns the positions of posts of the hours of the keys. Hours which were not read by
+// workers, e.g. because their windows had been done before the session was restored, are read from
+// data storage.
+func (es *eventSession) hourPoints(client service.Service, hours map[int64][]int64) (map[int64][]data.Point, error) {
+	es.mut.Lock()
+	read := es.hours
+	es.mut.Unlock()
+	points := map[int64][]data.Point{}
+	for _, hs := range hours {
+		for _, h := range hs {
+			if ps, ok := read[h]; ok {
+				points[h] = ps
+				continue
+			}
+			posts, _, err := client.SelectPosts(es.ctx, es.eventReq.CityId, h, h+3600)
+			if err != nil {
+				unilog.Logger().Error("unable to get posts from data storage", zap.Error(err))
+				return nil, err
+			}
+			ps := make([]data.Point, 0, len(posts))
+			for _, p := range posts {
+				ps = append(ps, data.Point{Lat: p.Lat, Lon: p.Lon})
+			}
+			points[h] = ps
+		}
+	}
+	return points, nil
+}
+
+// pushStats updates the statistics of the keys with the posts of their hours and pushes them with the
+// rebuilt grids. The statistics are replaced only if their versions in data storage are still the
+// given ones.
+func (es *eventSession) pushStats(client service.Service, hours map[int64][]int64, points map[int64][]data.Point,
+	grids map[int64][]byte, versions map[int64]int64) error {
+	updated := map[int64][]byte{}
+	swap := map[int64]int64{}
+	for key, hs := range hours {
+		b, ok := grids[detection.StatsKey(key)]
+		if !ok {
+			continue
+		}
+		st := &detection.GridStats{}
+		err := gob.NewDecoder(bytes.NewReader(b)).Decode(st)
+		if err != nil {
+			unilog.Logger().Error("unable to decode grid statistics", zap.Int64("key", key), zap.Error(err))
+			return err
+		}
+		changed := false
+		for _, h := range hs {
+			if st.Update(points[h], h, es.cfg.RollingRate) {
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		grid, err := st.Grid()
+		if err != nil {
+			return err
+		}
+		var gridBuf, statsBuf bytes.Buffer
+		err = gob.NewEncoder(&gridBuf).Encode(grid)
+		if err == nil {
+			err = gob.NewEncoder(&statsBuf).Encode(st)
+		}
+		if err != nil {
+			unilog.Logger().Error("unable to encode grid", zap.Int64("key", key), zap.Error(err))
+			return err
+		}
+		updated[key] = gridBuf.Bytes()
+		updated[detection.StatsKey(key)] = statsBuf.Bytes()
+		swap[detection.StatsKey(key)] = versions[detection.StatsKey(key)]
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+	err := client.PushGrid(es.ctx, es.eventReq.CityId, "", updated, swap)
+	if err != nil {
+		unilog.Logger().Error("unable to push grids to data storage", zap.Error(err))
+		return err
 	}
+	unilog.Logger().Info("updated historic grids", zap.String("session", es.id), zap.Int("num", len(updated)/2))
+	return nil
 }
 
 func filterTags(tags []string) map[string]bool {
 	filterTags := map[string]bool{}
 	for _, t := range tags {
-		filterTags[t] = true
+		filterTags[tokenizer.Normalize(t)] = true
 	}
 	return filterTags
 }
 
-func loadEvents(eChan chan []data.Event, storageEp string, cityID string, wg *sync.WaitGroup, outErr *error) {
+// loadEvents pushes found events to data storage and marks their intervals as done, failed intervals
+// are recorded as skipped. Intervals are handled in the order of the windows, so that events found
+// again in overlapping windows are dropped. After an error it keeps draining the channel so that
+// workers are not blocked.
+func (es *eventSession) loadEvents(eChan chan intervalEvents, windows []detection.Window, wg *sync.WaitGroup, outErr *error) {
 	defer wg.Done()
-	conn, err := grpc.Dial(storageEp, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	client, err := connect(es.cfg.DataStorageAddress)
 	if err != nil {
 		unilog.Logger().Error("unable to connect to data storage", zap.Error(err))
-		outErr = &err
+		*outErr = err
+		for range eChan {
+		}
 		return
 	}
-	client := service.NewGRPCClient(conn)
-	for evs := range eChan {
-		err = client.PushEvents(context.Background(), cityID, evs)
-		if err != nil {
-			unilog.Logger().Error("unable to push events to data storage", zap.Error(err))
-			outErr = &err
+	dedup := &detection.Deduplicator{}
+	load := func(ie intervalEvents) {
+		if *outErr != nil {
 			return
 		}
+		if ie.failed {
+			es.skip(ie.start)
+			return
+		}
+		ie.events = dedup.Add(ie.start, ie.events)
+		if len(ie.events) > 0 {
+			err := client.PushEvents(es.ctx, es.eventReq.CityId, ie.events)
+			if err != nil {
+				unilog.Logger().Error("unable to push events to data storage", zap.Error(err))
+				*outErr = err
+				return
+			}
+		}
+		es.complete(ie)
+	}
+
+	buf := map[int64]intervalEvents{}
+	next := 0
+	for ie := range eChan {
+		buf[ie.start] = ie
+		for ; next < len(windows); next++ {
+			ready, ok := buf[windows[next].Start]
+			if !ok {
+				break
+			}
+			delete(buf, ready.start)
+			load(ready)
+		}
+	}
+	// windows which were not searched because of cancellation are skipped
+	for ; next < len(windows); next++ {
+		if ready, ok := buf[windows[next].Start]; ok {
+			load(ready)
+		}
 	}
 }
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	service "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	convtree "github.com/visheratin/conv-tree"
+)
+
+// storageStub is the data storage with a grid of every hour and without posts, reading of posts of
+// the failing hours fails. onSelect is called before posts are read. Methods which aren't overridden
+// panic.
+type storageStub struct {
+	service.Service
+	grids    map[int64][]byte
+	failing  map[int64]bool
+	onSelect func(start int64)
+	mu       sync.Mutex
+	selects  map[int64]int
+}
+
+func newStorageStub(t *testing.T, start, finish int64, failing ...int64) *storageStub {
+	var buf bytes.Buffer
+	grid := convtree.ConvTree{IsLeaf: true, TopLeft: convtree.Point{X: 0, Y: 1}, BottomRight: convtree.Point{X: 1, Y: 0}, MaxPoints: 10}
+	if err := gob.NewEncoder(&buf).Encode(grid); err != nil {
+		t.Fatal(err)
+	}
+	s := &storageStub{grids: map[int64][]byte{}, failing: map[int64]bool{}, selects: map[int64]int{}}
+	for h := start; h < finish; h += 3600 {
+		s.grids[detection.GridKey(time.Unix(h, 0).UTC())] = buf.Bytes()
+	}
+	for _, h := range failing {
+		s.failing[h] = true
+	}
+	return s
+}
+
+func (s *storageStub) PullGrid(_ context.Context, _, _ string, _ []int64) (map[int64][]byte, map[int64]int64, error) {
+	return s.grids, map[int64]int64{}, nil
+}
+
+func (s *storageStub) PullBots(context.Context, string) ([]data.Bot, error) {
+	return nil, nil
+}
+
+func (s *storageStub) PullLocations(context.Context, string) ([]data.Location, error) {
+	return nil, nil
+}
+
+func (s *storageStub) PullTimeline(context.Context, string, int64, int64) ([]data.Timestamp, error) {
+	return nil, nil
+}
+
+func (s *storageStub) SelectPosts(_ context.Context, _ string, start, _ int64) ([]data.Post, *data.Area, error) {
+	if s.onSelect != nil {
+		s.onSelect(start)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.selects[start]++
+	if s.failing[start] {
+		return nil, nil, errors.New("storage is unavailable")
+	}
+	return nil, nil, nil
+}
+
+func Test_eventSession_skipped(t *testing.T) {
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = time.Second }()
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	st, err := openSessionStore(filepath.Join(dir, "sessions.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	h := int64(1514764800)
+	ds := newStorageStub(t, h, h+4*3600, h+3600)
+	connect = func(string) (service.Service, error) { return ds, nil }
+	defer func() { connect = connectDataStorage }()
+
+	req := proto.EventRequest{CityId: "nyc", StartTime: h, FinishTime: h + 4*3600, Timezone: "UTC"}
+	es, err := newEventSession(Config{WorkersNumber: 2}, req, "e1", st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	es.detectEvents()
+
+	if want := map[int64]int{h: 1, h + 3600: selectAttempts, h + 2*3600: 1, h + 3*3600: 1}; !reflect.DeepEqual(ds.selects, want) {
+		t.Errorf("SelectPosts() calls = %v, want %v", ds.selects, want)
+	}
+	resp := es.statusResponse()
+	if resp.Status != FailedStatus.String() || resp.ProcessedIntervals != 3 || resp.TotalIntervals != 4 || resp.Err == "" {
+		t.Errorf("statusResponse() = %+v, want a failed session with 3 of 4 intervals and the skipped window", resp)
+	}
+	ers, err := st.loadEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ers) != 1 || !reflect.DeepEqual(ers[0].Skipped, []int64{h + 3600}) || len(ers[0].Done) != 3 {
+		t.Errorf("loadEvents() = %+v, want the skipped window which is not done", ers)
+	}
+}
+
+// Test_eventSession_restart interrupts the session after two of four windows, as if the node was
+// stopped, and restores it from the store. Only the pending windows are searched after the restart.
+func Test_eventSession_restart(t *testing.T) {
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = time.Second }()
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := Config{WorkersNumber: 1, SessionStorePath: filepath.Join(dir, "sessions.db"), Grids: GridsConfig{Workers: -1}}
+	st, err := openSessionStore(cfg.SessionStorePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := int64(1514764800)
+	req := proto.EventRequest{CityId: "nyc", StartTime: h, FinishTime: h + 4*3600, Timezone: "UTC"}
+	es, err := newEventSession(cfg, req, "e1", st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds := newStorageStub(t, h, h+4*3600)
+	ds.onSelect = func(start int64) {
+		if start == h+2*3600 {
+			es.cancel()
+		}
+	}
+	connect = func(string) (service.Service, error) { return ds, nil }
+	defer func() { connect = connectDataStorage }()
+	es.detectEvents()
+	st.Close()
+	if want := map[int64]int{h: 1, h + 3600: 1, h + 2*3600: 1}; !reflect.DeepEqual(ds.selects, want) {
+		t.Fatalf("SelectPosts() calls before the restart = %v, want %v", ds.selects, want)
+	}
+
+	ds = newStorageStub(t, h, h+4*3600)
+	svc, err := newEventService(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer svc.store.Close()
+	var resp proto.StatusResponse
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		resp, err = svc.EventsStatus(context.Background(), proto.StatusRequest{Id: "e1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != RunningStatus.String() {
+			break
+		}
+	}
+	if !resp.Finished || resp.ProcessedIntervals != 4 {
+		t.Errorf("EventsStatus() = %+v, want a finished session with all intervals", resp)
+	}
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if want := map[int64]int{h + 2*3600: 1, h + 3*3600: 1}; !reflect.DeepEqual(ds.selects, want) {
+		t.Errorf("SelectPosts() calls after the restart = %v, want only the pending windows %v", ds.selects, want)
+	}
+}
+
+func Test_addHours(t *testing.T) {
+	es := &eventSession{}
+	h := int64(1514764800)
+	// the window covers the second hour fully and the other two partly
+	w := detection.Windows(h+1800, h+3*3600+1800, 3*3600, 3*3600, time.UTC)[0]
+	posts := []data.Post{
+		{Timestamp: h + 1900, Lat: 1, Lon: 1},
+		{Timestamp: h + 3600, Lat: 2, Lon: 2},
+		{Timestamp: h + 2*3600 + 10, Lat: 3, Lon: 3},
+		{Timestamp: h + 3*3600 + 10, Lat: 4, Lon: 4},
+	}
+	es.addHours(w, posts, time.UTC)
+	if len(es.hours) != 2 || len(es.hours[h+3600]) != 1 || len(es.hours[h+2*3600]) != 1 {
+		t.Fatalf("addHours() hours = %v, want two full hours with a post each", es.hours)
+	}
+	// hours read by another window are kept as they are
+	es.addHours(detection.Windows(h+3600, h+3*3600, 2*3600, 2*3600, time.UTC)[0], posts[1:2], time.UTC)
+	if len(es.hours[h+2*3600]) != 1 {
+		t.Errorf("addHours() replaced the hour read before: %v", es.hours[h+2*3600])
+	}
+	// hours without posts are kept, so that they are not read again
+	es.addHours(detection.Windows(h+5*3600, h+6*3600, 3600, 3600, time.UTC)[0], nil, time.UTC)
+	if ps, ok := es.hours[h+5*3600]; !ok || len(ps) != 0 {
+		t.Errorf("addHours() hour without posts = %v, %v", ps, ok)
+	}
+	// hours of the location with a half-hour offset start at half past UTC hours, so the window
+	// covers three of them fully
+	loc := time.FixedZone("IST", 5*3600+1800)
+	es = &eventSession{}
+	es.addHours(detection.Windows(h+1800, h+3*3600+1800, 3*3600, 3*3600, loc)[0], posts, loc)
+	if len(es.hours) != 3 || len(es.hours[h+1800]) != 2 || len(es.hours[h+3600+1800]) != 1 || len(es.hours[h+2*3600+1800]) != 1 {
+		t.Errorf("addHours() hours = %v, want three local hours", es.hours)
+	}
+}
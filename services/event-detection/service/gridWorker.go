@@ -0,0 +1,235 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"time"
+
+	service "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/detection"
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// retryDelay is the delay before the first retry of a request to data storage, it is doubled for
+// every next retry.
+var retryDelay = time.Second
+
+var ErrNoArea = errors.New("historic request doesn't contain the city area")
+
+// gridWorker builds historic grids of the tasks leased from the queue in data storage. Tasks may
+// belong to sessions of any node, everything needed to build a grid is taken from the task spec.
+// Grids are added to the grid set of the session when their task is completed, the session publishes
+// the set when all grids are built. The lease of a task is renewed while its grid is being built.
+type gridWorker struct {
+	cfg    Config
+	gc     GridsConfig
+	owner  string
+	client service.GrpcService
+}
+
+// startGridWorkers starts the grid workers of the node, they run until ctx is canceled.
+func startGridWorkers(ctx context.Context, cfg Config) error {
+	gc := cfg.gridsConfig()
+	if gc.Workers < 0 {
+		return nil
+	}
+	conn, err := grpc.Dial(cfg.DataStorageAddress, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	if err != nil {
+		unilog.Logger().Error("unable to connect to data strorage", zap.Error(err))
+		return err
+	}
+	client := service.NewGRPCClient(conn)
+	for i := 0; i < gc.Workers; i++ {
+		w := &gridWorker{
+			cfg:    cfg,
+			gc:     gc,
+			owner:  fmt.Sprintf("%v#%v", gc.NodeID, i),
+			client: client,
+		}
+		go w.run(ctx)
+	}
+	unilog.Logger().Info("started grid workers", zap.String("node", gc.NodeID), zap.Int("workers", gc.Workers))
+	return nil
+}
+
+func (w *gridWorker) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		task, err := w.client.LeaseGridTask(ctx, w.owner, w.gc.Lease)
+		if err != nil {
+			unilog.Logger().Error("unable to lease grid task", zap.String("owner", w.owner), zap.Error(err))
+		}
+		if err != nil || task == nil {
+			select {
+			case <-time.After(time.Duration(w.gc.Poll) * time.Second):
+			case <-ctx.Done():
+			}
+			continue
+		}
+		w.process(ctx, *task)
+	}
+}
+
+// process builds the grid of the task and reports the result. A failed task is returned to the
+// queue until it runs out of attempts.
+func (w *gridWorker) process(ctx context.Context, task data.GridTask) {
+	status := data.GridTaskDone
+	var (
+		req   proto.HistoricRequest
+		posts int
+		grids map[int64][]byte
+	)
+	err := req.Unmarshal(task.Spec)
+	if err == nil && int(task.Attempts) > w.gc.Retries {
+		err = errors.New("task has run out of attempts")
+	}
+	if err == nil {
+		hctx, stop := context.WithCancel(ctx)
+		lost := make(chan struct{})
+		go w.heartbeat(hctx, task, func() {
+			close(lost)
+			stop()
+		})
+		posts, grids, err = w.build(hctx, task.Key, req)
+		stop()
+		select {
+		case <-lost:
+			return
+		default:
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	var msg string
+	if err != nil {
+		msg = err.Error()
+		status = data.GridTaskPending
+		if int(task.Attempts) >= w.gc.Retries {
+			status = data.GridTaskFailed
+		}
+		unilog.Logger().Error("unable to build grid", zap.String("session", task.Session), zap.Int64("key", task.Key),
+			zap.Int32("attempt", task.Attempts), zap.String("status", status), zap.Error(err))
+	}
+	err = retry(ctx, w.gc.Retries, func() error {
+		return w.client.CompleteGridTask(ctx, req.CityId, task.Session, task.Key, w.owner, status, int64(posts), msg, grids)
+	})
+	if isLeaseLost(err) {
+		unilog.Logger().Warn("grid task is no longer leased by the worker, its grid is dropped",
+			zap.String("session", task.Session), zap.Int64("key", task.Key), zap.String("owner", w.owner))
+		return
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to complete grid task", zap.String("session", task.Session),
+			zap.Int64("key", task.Key), zap.Error(err))
+	}
+}
+
+// heartbeat renews the lease of the task every third of the lease duration until ctx is done. If the
+// task is no longer leased by the worker, e.g. because it has been canceled, lost is called.
+func (w *gridWorker) heartbeat(ctx context.Context, task data.GridTask, lost func()) {
+	period := time.Duration(w.gc.Lease) * time.Second / 3
+	for {
+		select {
+		case <-time.After(period):
+		case <-ctx.Done():
+			return
+		}
+		err := w.client.RenewGridTask(ctx, task.Session, task.Key, w.owner, w.gc.Lease)
+		if isLeaseLost(err) {
+			unilog.Logger().Warn("grid task is no longer leased by the worker", zap.String("session", task.Session),
+				zap.Int64("key", task.Key), zap.String("owner", w.owner))
+			lost()
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			unilog.Logger().Error("unable to renew grid task lease", zap.String("session", task.Session),
+				zap.Int64("key", task.Key), zap.Error(err))
+		}
+	}
+}
+
+// build builds the grid and the statistics of the key together with the tag model of its posts. It
+// returns the number of posts of the key and the encoded grids, which are nil if there are no posts.
+func (w *gridWorker) build(ctx context.Context, key int64, req proto.HistoricRequest) (int, map[int64][]byte, error) {
+	if req.Area == nil || req.Area.TopLeft == nil || req.Area.BotRight == nil {
+		return 0, nil, ErrNoArea
+	}
+	intervals, err := getIntervals(req.StartTime, req.FinishTime, req.Timezone)
+	if err != nil {
+		return 0, nil, err
+	}
+	params := w.cfg.detectionParams().Merge(detection.ParamsFromProto(req.Params))
+	posts := []data.Post{}
+	for _, i := range intervals[key] {
+		var ps []data.Post
+		err = retry(ctx, w.gc.Retries, func() (err error) {
+			ps, _, err = w.client.SelectPosts(ctx, req.CityId, i[0], i[1])
+			return
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		posts = append(posts, ps...)
+	}
+	if len(posts) == 0 {
+		return 0, nil, nil
+	}
+	area := *req.Area
+	stats, err := detection.NewGridStats(posts, *area.TopLeft, *area.BotRight, params.MaxPoints, req.Timezone,
+		req.GridSize, params.Tree)
+	if err != nil {
+		return 0, nil, err
+	}
+	grid, err := stats.Grid()
+	if err != nil {
+		return 0, nil, err
+	}
+	tags := detection.NewTagModel()
+	tags.AddPosts(posts, *area.TopLeft, req.GridSize)
+	// the statistics are kept for rolling updates of the grid, the tag models of all keys are merged
+	// by the session when all grids are built
+	grids := map[int64][]byte{}
+	for k, v := range map[int64]interface{}{key: grid, detection.StatsKey(key): stats, detection.TagsKey(key): tags} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+			return 0, nil, err
+		}
+		grids[k] = buf.Bytes()
+	}
+	return len(posts), grids, nil
+}
+
+// isLeaseLost reports whether err is returned by data storage because the task is not leased by the
+// worker anymore.
+func isLeaseLost(err error) bool {
+	return err != nil && err.Error() == storage.ErrGridTaskLease.Error()
+}
+
+// retry calls f until it succeeds or is called attempts times, but at least once. The delay between
+// the calls grows exponentially. Calls are not repeated after a lost lease, which can't be fixed by a
+// retry. The last error is returned.
+func retry(ctx context.Context, attempts int, f func() error) error {
+	delay := retryDelay
+	var err error
+	for i := 1; ; i++ {
+		err = f()
+		if err == nil || i >= attempts || isLeaseLost(err) {
+			break
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
+)
+
+func Test_retry(t *testing.T) {
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = time.Second }()
+	errFail := errors.New("fail")
+	tests := []struct {
+		name      string
+		attempts  int
+		failures  int
+		wantCalls int
+		wantErr   error
+	}{
+		{"success", 3, 0, 1, nil},
+		{"retried", 3, 2, 3, nil},
+		{"exhausted", 3, 5, 3, errFail},
+		{"zero attempts", 0, 5, 1, errFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry(context.Background(), tt.attempts, func() error {
+				calls++
+				if calls <= tt.failures {
+					return errFail
+				}
+				return nil
+			})
+			if err != tt.wantErr {
+				t.Errorf("retry() error = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("retry() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_retry_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	err := retry(ctx, 3, func() error {
+		calls++
+		return errors.New("fail")
+	})
+	if err != context.Canceled || calls != 1 {
+		t.Errorf("retry() = %v after %v calls, want %v after 1 call", err, calls, context.Canceled)
+	}
+}
+
+func Test_retry_leaseLost(t *testing.T) {
+	calls := 0
+	err := retry(context.Background(), 3, func() error {
+		calls++
+		return errors.New(storage.ErrGridTaskLease.Error())
+	})
+	if !isLeaseLost(err) || calls != 1 {
+		t.Errorf("retry() = %v after %v calls, want a lost lease after 1 call", err, calls)
+	}
+}
+
+func TestConfig_gridsConfig(t *testing.T) {
+	cfg := Config{WorkersNumber: 4, Address: "localhost:8084", Grids: GridsConfig{NodeID: "node", Retries: 5}}
+	want := GridsConfig{NodeID: "node", Workers: 4, Lease: 600, Poll: 10, Retries: 5}
+	if got := cfg.gridsConfig(); got != want {
+		t.Errorf("gridsConfig() = %v, want %v", got, want)
+	}
+	cfg.Grids = GridsConfig{Workers: -1}
+	if got := cfg.gridsConfig(); got.Workers != -1 || got.NodeID == "" {
+		t.Errorf("gridsConfig() = %v, want disabled workers and a default node id", got)
+	}
+}
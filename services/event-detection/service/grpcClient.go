@@ -14,6 +14,8 @@ type Client struct {
 	HistoricStatus endpoint.Endpoint
 	FindEvents     endpoint.Endpoint
 	EventsStatus   endpoint.Endpoint
+	CancelSession  endpoint.Endpoint
+	ListSessions   endpoint.Endpoint
 }
 
 func NewClient(conn *grpc.ClientConn) Client {
@@ -63,5 +65,27 @@ func NewClient(conn *grpc.ClientConn) Client {
 		Timeout: TimeWaitingClient,
 	}))(eventsStatusEndpoint)
 
+	cancelSessionEndpoint := grpctransport.NewClient(
+		conn, "proto.EventDetection", "CancelSession",
+		encodeGRPCCancelSessionRequest,
+		decodeGRPCCancelSessionResponse,
+		proto.CancelResponse{},
+	).Endpoint()
+	svc.CancelSession = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "CancelSession",
+		Timeout: TimeWaitingClient,
+	}))(cancelSessionEndpoint)
+
+	listSessionsEndpoint := grpctransport.NewClient(
+		conn, "proto.EventDetection", "ListSessions",
+		encodeGRPCListSessionsRequest,
+		decodeGRPCListSessionsResponse,
+		proto.ListResponse{},
+	).Endpoint()
+	svc.ListSessions = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    "ListSessions",
+		Timeout: TimeWaitingClient,
+	}))(listSessionsEndpoint)
+
 	return svc
 }
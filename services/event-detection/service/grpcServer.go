@@ -17,6 +17,8 @@ type server struct {
 	historicStatus grpctransport.Handler
 	findEvents     grpctransport.Handler
 	eventsStatus   grpctransport.Handler
+	cancelSession  grpctransport.Handler
+	listSessions   grpctransport.Handler
 }
 
 func Server(svc Service) proto.EventDetectionServer {
@@ -41,6 +43,16 @@ func Server(svc Service) proto.EventDetectionServer {
 			decodeGRPCStatusRequest,
 			encodeGRPCStatusResponse,
 		),
+		cancelSession: grpctransport.NewServer(
+			makeCancelSessionEndpoint(svc),
+			decodeGRPCCancelSessionRequest,
+			encodeGRPCCancelSessionResponse,
+		),
+		listSessions: grpctransport.NewServer(
+			makeListSessionsEndpoint(svc),
+			decodeGRPCListSessionsRequest,
+			encodeGRPCListSessionsResponse,
+		),
 	}
 }
 
@@ -78,3 +90,21 @@ func (gs *server) EventsStatus(ctx context.Context, req *proto.StatusRequest) (*
 	tmp := rep.(proto.StatusResponse)
 	return &tmp, nil
 }
+
+func (gs *server) CancelSession(ctx context.Context, req *proto.CancelRequest) (*proto.CancelResponse, error) {
+	_, rep, err := gs.cancelSession.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	tmp := rep.(proto.CancelResponse)
+	return &tmp, nil
+}
+
+func (gs *server) ListSessions(ctx context.Context, req *proto.ListRequest) (*proto.ListResponse, error) {
+	_, rep, err := gs.listSessions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	tmp := rep.(proto.ListResponse)
+	return &tmp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/gob"
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,63 +18,336 @@ import (
 	"google.golang.org/grpc"
 )
 
+// tagsBatch is the number of tag models pulled from data storage at once.
+const tagsBatch = 24
+
+// historicSession builds historic grids of the city. Grids of the keys are built by grid workers
+// of all nodes, the session puts its keys to the task queue and tracks their completion. Workers add
+// grids to the grid set of the session in data storage, which replaces the grids of the city when
+// all keys are built. Only the detection parameters and the tag model are kept in the session grids.
 type historicSession struct {
-	id       string
-	status   StatusType
-	cfg      Config
-	histReq  proto.HistoricRequest
-	gridChan chan interval
-	grids    map[int64][]byte
-	mut      sync.Mutex
+	id      string
+	status  StatusType
+	cfg     Config
+	histReq proto.HistoricRequest
+	params  detection.Params
+	grids   map[int64][]byte
+	done    map[int64]bool
+	prog    progress
+	store   *sessionStore
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mut     sync.Mutex
+}
+
+func newHistoricSession(config Config, histReq proto.HistoricRequest, id string, store *sessionStore) (*historicSession, error) {
+	hs := &historicSession{
+		id:      id,
+		status:  RunningStatus,
+		cfg:     config,
+		histReq: histReq,
+		params:  config.detectionParams().Merge(detection.ParamsFromProto(histReq.Params)),
+		grids:   make(map[int64][]byte),
+		done:    make(map[int64]bool),
+		store:   store,
+	}
+	hs.ctx, hs.cancel = context.WithCancel(context.Background())
+	// the parameters are stored together with the grids, so event sessions use the same ones.
+	// Windows don't depend on the grids and are left to event sessions.
+	dp := hs.params.Proto()
+	dp.WindowLength, dp.WindowStride = 0, 0
+	b, err := dp.Marshal()
+	if err != nil {
+		unilog.Logger().Error("can't encode detection parameters", zap.String("session", id), zap.Error(err))
+		return nil, err
+	}
+	hs.grids[detection.ParamsKey] = b
+	err = store.saveHistoric(hs.record(), hs.grids)
+	if err != nil {
+		unilog.Logger().Error("unable to save historic session", zap.String("session", id), zap.Error(err))
+		return nil, err
+	}
+	return hs, nil
+}
+
+func restoreHistoricSession(config Config, r historicRecord, store *sessionStore) (*historicSession, error) {
+	grids, err := store.loadGrids(r.ID)
+	if err != nil {
+		unilog.Logger().Error("unable to load session grids", zap.String("session", r.ID), zap.Error(err))
+		return nil, err
+	}
+	done := make(map[int64]bool, len(r.Done))
+	for _, k := range r.Done {
+		done[k] = true
+	}
+	hs := &historicSession{
+		id:      r.ID,
+		status:  r.Status,
+		cfg:     config,
+		histReq: r.Request,
+		params:  config.detectionParams().Merge(detection.ParamsFromProto(r.Request.Params)),
+		grids:   grids,
+		done:    done,
+		prog:    r.Progress,
+		store:   store,
+	}
+	hs.ctx, hs.cancel = context.WithCancel(context.Background())
+	return hs, nil
+}
+
+// record must be called with hs.mut held.
+func (hs *historicSession) record() historicRecord {
+	done := make([]int64, 0, len(hs.done))
+	for k := range hs.done {
+		done = append(done, k)
+	}
+	return historicRecord{
+		ID:       hs.id,
+		Request:  hs.histReq,
+		Status:   hs.status,
+		Done:     done,
+		Progress: hs.prog,
+	}
+}
+
+func (hs *historicSession) getStatus() StatusType {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	return hs.status
+}
+
+func (hs *historicSession) statusResponse() proto.StatusResponse {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	resp := proto.StatusResponse{Status: hs.status.String(), Finished: hs.status == FinishedStatus}
+	hs.prog.fill(&resp, int64(len(hs.done)), time.Now())
+	return resp
+}
+
+func (hs *historicSession) info() *proto.SessionInfo {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	return &proto.SessionInfo{
+		Id:         hs.id,
+		Kind:       historicKind,
+		CityId:     hs.histReq.CityId,
+		StartTime:  hs.histReq.StartTime,
+		FinishTime: hs.histReq.FinishTime,
+		Status:     hs.status.String(),
+		Finished:   hs.status == FinishedStatus,
+	}
+}
+
+// setStatus moves a running session to the status s, sessions in other statuses are not changed.
+func (hs *historicSession) setStatus(s StatusType) error {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	if hs.status != RunningStatus {
+		return ErrSessionNotRunning
+	}
+	hs.status = s
+	if s != RunningStatus {
+		hs.prog.Finished = time.Now()
+	}
+	err := hs.store.saveHistoric(hs.record(), nil)
+	if err != nil {
+		unilog.Logger().Error("unable to save historic session", zap.String("session", hs.id), zap.Error(err))
+	}
+	return nil
 }
 
-func newHistoricSession(config Config, histReq proto.HistoricRequest, id string) *historicSession {
+// fail marks the session as failed and stops its workers.
+func (hs *historicSession) fail() {
+	hs.setStatus(FailedStatus)
+	hs.cancel()
+}
 
-	return &historicSession{
-		id:       id,
-		status:   RunningStatus,
-		cfg:      config,
-		histReq:  histReq,
-		gridChan: make(chan interval),
-		grids:    make(map[int64][]byte),
+func (hs *historicSession) stop() error {
+	err := hs.setStatus(CanceledStatus)
+	if err != nil {
+		return err
 	}
+	hs.cancel()
+	return nil
 }
 
+func (hs *historicSession) start(total int64) {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	hs.prog.Total = total
+	hs.prog.start(int64(len(hs.done)), time.Now())
+}
+
+func (hs *historicSession) isDone(key int64) bool {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	return hs.done[key]
+}
+
+// update updates the progress of the session from the status of its tasks in the queue.
+func (hs *historicSession) update(st data.GridTasksStatus) {
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	if int64(len(hs.done)) == st.Done && hs.prog.Posts == st.Posts {
+		return
+	}
+	for _, k := range st.DoneKeys {
+		hs.done[k] = true
+	}
+	hs.prog.Posts = st.Posts
+	err := hs.store.saveHistoric(hs.record(), nil)
+	if err != nil {
+		unilog.Logger().Error("unable to save historic session", zap.String("session", hs.id), zap.Error(err))
+	}
+}
+
+// generateGrids puts the grid keys of the session to the task queue in data storage and waits until
+// grid workers of all nodes build them. Then the tag models of the keys are merged, and the grids and
+// the statistics of the keys are published together with the merged tag model and the detection
+// parameters, so event searches never use grids of different sessions or without their parameters.
+// Tag models of the keys are deleted with the set.
 func (hs *historicSession) generateGrids() {
 	intervals, err := getIntervals(hs.histReq.StartTime, hs.histReq.FinishTime, hs.histReq.Timezone)
 	if err != nil {
 		unilog.Logger().Error("unable to generate intervals", zap.Error(err))
-		hs.status = FailedStatus
+		hs.fail()
 		return
 	}
-	area := hs.histReq.Area
-	wg := &sync.WaitGroup{}
-	for w := 1; w <= hs.cfg.WorkersNumber; w++ {
-		wg.Add(1)
-		go hs.gridWorker(wg, *area)
-	}
-	for k, ils := range intervals {
-		il := interval{
-			key:   k,
-			value: ils,
-		}
-		hs.gridChan <- il
-	}
-	close(hs.gridChan)
-	wg.Wait()
+	hs.start(int64(len(intervals)))
 	conn, err := grpc.Dial(hs.cfg.DataStorageAddress, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
 	if err != nil {
 		unilog.Logger().Error("unable to connect to data strorage", zap.Error(err))
+		hs.fail()
 		return
 	}
 	cl := service.NewGRPCClient(conn)
-	err = cl.PushGrid(context.Background(), hs.histReq.CityId, hs.grids)
+	spec, err := hs.histReq.Marshal()
+	if err != nil {
+		unilog.Logger().Error("can't encode historic request", zap.String("session", hs.id), zap.Error(err))
+		hs.fail()
+		return
+	}
+	keys := make([]int64, 0, len(intervals))
+	tasks := make([]data.GridTask, 0, len(intervals))
+	for k := range intervals {
+		keys = append(keys, k)
+		if !hs.isDone(k) {
+			tasks = append(tasks, data.GridTask{Session: hs.id, Key: k, Spec: spec})
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	err = cl.PushGridTasks(hs.ctx, tasks)
 	if err != nil {
-		unilog.Logger().Error("unable to push grid to data storage", zap.Error(err))
-		hs.status = FailedStatus
+		unilog.Logger().Error("unable to push grid tasks to data storage", zap.String("session", hs.id), zap.Error(err))
+		hs.fail()
 		return
 	}
-	hs.status = FinishedStatus
+
+	poll := time.Duration(hs.cfg.gridsConfig().Poll) * time.Second
+	for {
+		st, err := cl.PullGridTasksStatus(hs.ctx, hs.id)
+		if err == nil {
+			hs.update(st)
+			if st.Failed > 0 {
+				unilog.Logger().Error("grid tasks have failed", zap.String("session", hs.id), zap.Int64("failed", st.Failed))
+				hs.fail()
+			} else if st.Pending+st.Leased == 0 {
+				break
+			}
+		} else if hs.ctx.Err() == nil {
+			unilog.Logger().Error("unable to get grid tasks status", zap.String("session", hs.id), zap.Error(err))
+		}
+		select {
+		case <-time.After(poll):
+		case <-hs.ctx.Done():
+		}
+		if hs.ctx.Err() != nil {
+			hs.discard(cl)
+			return
+		}
+	}
+
+	grids, err := hs.mergeTags(cl, keys)
+	if err != nil {
+		hs.fail()
+		hs.discard(cl)
+		return
+	}
+	ids := make([]int64, 0, 2*len(keys))
+	for _, k := range keys {
+		ids = append(ids, k, detection.StatsKey(k))
+	}
+	err = cl.PublishGridSet(hs.ctx, hs.histReq.CityId, hs.id, ids, grids)
+	if err != nil {
+		unilog.Logger().Error("unable to publish grids in data storage", zap.String("session", hs.id), zap.Error(err))
+		hs.fail()
+		hs.discard(cl)
+		return
+	}
+	if hs.setStatus(FinishedStatus) != nil {
+		return
+	}
+	hs.mut.Lock()
+	hs.grids = make(map[int64][]byte)
+	hs.mut.Unlock()
+	err = hs.store.deleteGrids(hs.id)
+	if err != nil {
+		unilog.Logger().Error("unable to delete session grids", zap.String("session", hs.id), zap.Error(err))
+	}
+}
+
+// discard cancels grid tasks of the stopped session, so that workers of other nodes don't build its
+// grids, and deletes the grids which have been built. Workers which complete their tasks concurrently
+// add grids before the cancellation or not at all, so the set is deleted after the tasks are canceled.
+func (hs *historicSession) discard(cl service.GrpcService) {
+	err := cl.CancelGridTasks(context.Background(), hs.id)
+	if err != nil {
+		unilog.Logger().Error("unable to cancel grid tasks", zap.String("session", hs.id), zap.Error(err))
+	}
+	err = cl.DeleteGridSet(context.Background(), hs.histReq.CityId, hs.id)
+	if err != nil {
+		unilog.Logger().Error("unable to delete grid set", zap.String("session", hs.id), zap.Error(err))
+	}
+}
+
+// mergeTags merges the tag models of the grid keys built by grid workers. It returns the session
+// grids with the merged tag model.
+func (hs *historicSession) mergeTags(cl service.GrpcService, keys []int64) (map[int64][]byte, error) {
+	tags := detection.NewTagModel()
+	for i := 0; i < len(keys); i += tagsBatch {
+		ids := []int64{}
+		for j := i; j < i+tagsBatch && j < len(keys); j++ {
+			ids = append(ids, detection.TagsKey(keys[j]))
+		}
+		models, _, err := cl.PullGrid(hs.ctx, hs.histReq.CityId, hs.id, ids)
+		if err != nil {
+			unilog.Logger().Error("unable to pull tag models from data storage", zap.String("session", hs.id), zap.Error(err))
+			return nil, err
+		}
+		for _, b := range models {
+			m := detection.NewTagModel()
+			err = gob.NewDecoder(bytes.NewReader(b)).Decode(&m)
+			if err != nil {
+				unilog.Logger().Error("unable to decode tag model", zap.String("session", hs.id), zap.Error(err))
+				return nil, err
+			}
+			tags.Merge(m)
+		}
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(tags)
+	if err != nil {
+		unilog.Logger().Error("can't encode tag model", zap.String("session", hs.id), zap.Error(err))
+		return nil, err
+	}
+	hs.mut.Lock()
+	defer hs.mut.Unlock()
+	grids := make(map[int64][]byte, len(hs.grids)+1)
+	for k, v := range hs.grids {
+		grids[k] = v
+	}
+	grids[detection.TagModelKey] = buf.Bytes()
+	return grids, nil
 }
 
 func getIntervals(start, finish int64, tz string) (map[int64][][2]int64, error) {
@@ -115,50 +389,3 @@ func getIntervals(start, finish int64, tz string) (map[int64][][2]int64, error)
 	}
 	return res, nil
 }
-
-func (hs *historicSession) gridWorker(wg *sync.WaitGroup, area data.Area) {
-	conn, err := grpc.Dial(hs.cfg.DataStorageAddress, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
-	if err != nil {
-		unilog.Logger().Error("unable to connect to data strorage", zap.Error(err))
-		return
-	}
-	cl := service.NewGRPCClient(conn)
-	defer wg.Done()
-	for id := range hs.gridChan {
-		posts := []data.Post{}
-		for _, i := range id.value {
-			ps, _, err := cl.SelectPosts(context.Background(), hs.histReq.CityId, i[0], i[1])
-			if err != nil {
-				unilog.Logger().Error("unable to get posts from data strorage", zap.Error(err))
-				continue
-			}
-			posts = append(posts, ps...)
-		}
-		if len(posts) == 0 {
-			continue
-		}
-		grid, err := detection.HistoricGrid(posts, *area.TopLeft, *area.BotRight, hs.cfg.MaxPoints, hs.histReq.Timezone, hs.histReq.GridSize)
-		if err != nil {
-			unilog.Logger().Error("can't generate grid", zap.Error(err))
-			hs.status = FailedStatus
-			return
-		}
-		var buf bytes.Buffer
-		enc := gob.NewEncoder(&buf)
-
-		if err := enc.Encode(grid); err != nil {
-			hs.status = FailedStatus
-			unilog.Logger().Error("can't encode grid", zap.Error(err))
-			return
-		}
-
-		hs.mut.Lock()
-		hs.grids[id.key] = buf.Bytes()
-		hs.mut.Unlock()
-	}
-}
-
-type interval struct {
-	key   int64
-	value [][2]int64
-}
@@ -24,7 +24,14 @@ func Start(ctx context.Context, confPath string) {
 	}
 	//logger := setupLog(conf.LogPath)
 	var svc Service
-	svc = newEventService(conf)
+	svc, err = newEventService(conf)
+	if err != nil {
+		return
+	}
+	err = startGridWorkers(ctx, conf)
+	if err != nil {
+		return
+	}
 	// TODO: implement logging middleware
 	//svc = &loggingMiddleware{logger, svc}
 	grpcServer := Server(svc)
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+)
+
+// progress holds counters which describe the advancement of a session. It is persisted with
+// the session record, the run fields describe only the current run and are used for ETA.
+type progress struct {
+	Total    int64
+	Posts    int64
+	Events   int64
+	Started  time.Time
+	Finished time.Time
+	runStart time.Time
+	runDone  int64
+}
+
+// start marks the beginning of a run, done is the number of intervals processed before it.
+func (p *progress) start(done int64, now time.Time) {
+	if p.Started.IsZero() {
+		p.Started = now
+	}
+	p.runStart = now
+	p.runDone = done
+}
+
+// fill sets progress fields of the response, processed is the number of processed intervals.
+func (p progress) fill(resp *proto.StatusResponse, processed int64, now time.Time) {
+	resp.TotalIntervals = p.Total
+	resp.ProcessedIntervals = processed
+	resp.PostsRead = p.Posts
+	resp.EventsFound = p.Events
+	end := now
+	if !p.Finished.IsZero() {
+		end = p.Finished
+	}
+	if !p.Started.IsZero() {
+		resp.Elapsed = int64(end.Sub(p.Started).Seconds())
+	}
+	if p.Finished.IsZero() && processed > p.runDone && p.Total > processed {
+		perInterval := now.Sub(p.runStart).Seconds() / float64(processed-p.runDone)
+		resp.Eta = int64(perInterval * float64(p.Total-processed))
+	}
+}
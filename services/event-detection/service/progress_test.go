@@ -0,0 +1,58 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+)
+
+func Test_progress_fill(t *testing.T) {
+	start := time.Unix(1546290000, 0)
+	tests := []struct {
+		name      string
+		p         progress
+		processed int64
+		now       time.Time
+		want      proto.StatusResponse
+	}{
+		{
+			"not started",
+			progress{},
+			0,
+			start,
+			proto.StatusResponse{},
+		},
+		{
+			"running",
+			progress{Total: 10, Posts: 100, Started: start, runStart: start},
+			4,
+			start.Add(40 * time.Second),
+			proto.StatusResponse{TotalIntervals: 10, ProcessedIntervals: 4, PostsRead: 100, Elapsed: 40, Eta: 60},
+		},
+		{
+			"resumed",
+			progress{Total: 10, Started: start, runStart: start.Add(time.Hour), runDone: 6},
+			8,
+			start.Add(time.Hour + 20*time.Second),
+			proto.StatusResponse{TotalIntervals: 10, ProcessedIntervals: 8, Elapsed: 3620, Eta: 20},
+		},
+		{
+			"finished",
+			progress{Total: 10, Events: 3, Started: start, Finished: start.Add(time.Minute), runStart: start},
+			10,
+			start.Add(time.Hour),
+			proto.StatusResponse{TotalIntervals: 10, ProcessedIntervals: 10, EventsFound: 3, Elapsed: 60},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := proto.StatusResponse{}
+			tt.p.fill(&got, tt.processed, tt.now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fill() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
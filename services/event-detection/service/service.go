@@ -8,7 +8,9 @@ import (
 
 type Service interface {
 	HistoricGrids(ctx context.Context, histReq proto.HistoricRequest) (string, error)
-	HistoricStatus(context.Context, proto.StatusRequest) (string, bool, error)
+	HistoricStatus(context.Context, proto.StatusRequest) (proto.StatusResponse, error)
 	FindEvents(ctx context.Context, eventReq proto.EventRequest) (string, error)
-	EventsStatus(context.Context, proto.StatusRequest) (string, bool, error)
+	EventsStatus(context.Context, proto.StatusRequest) (proto.StatusResponse, error)
+	CancelSession(context.Context, proto.CancelRequest) error
+	ListSessions(context.Context, proto.ListRequest) ([]*proto.SessionInfo, error)
 }
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+	"github.com/visheratin/unilog"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+var (
+	historicBucket = []byte("historic")
+	eventsBucket   = []byte("events")
+	gridsBucket    = []byte("grids")
+	doneBucket     = []byte("done")
+)
+
+var (
+	ErrSessionNotFound   = errors.New("session was not found")
+	ErrSessionNotRunning = errors.New("session is not running")
+)
+
+// historicRecord is a persisted state of a historic session. Computed grids are stored separately
+// in the nested bucket of gridsBucket with the session ID as a name.
+type historicRecord struct {
+	ID       string
+	Request  proto.HistoricRequest
+	Status   StatusType
+	Done     []int64
+	Progress progress
+}
+
+// eventRecord is a persisted state of an event session. Done contains start timestamps of the
+// intervals which events were already pushed to data storage, they are stored separately in the
+// nested bucket of doneBucket with the session ID as a name, so completing an interval doesn't
+// rewrite all of them. Skipped contains start timestamps of the intervals which posts couldn't be
+// read.
+type eventRecord struct {
+	ID       string
+	Request  proto.EventRequest
+	Status   StatusType
+	Done     []int64
+	Skipped  []int64
+	Progress progress
+}
+
+type sessionStore struct {
+	db *bolt.DB
+}
+
+func openSessionStore(path string) (*sessionStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		unilog.Logger().Error("unable to open session store", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{historicBucket, eventsBucket, gridsBucket, doneBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		unilog.Logger().Error("unable to initialize session store", zap.String("path", path), zap.Error(err))
+		db.Close()
+		return nil, err
+	}
+	return &sessionStore{db: db}, nil
+}
+
+// saveHistoric writes the record and the given grids in one transaction.
+func (st *sessionStore) saveHistoric(r historicRecord, grids map[int64][]byte) error {
+	d, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(historicBucket).Put([]byte(r.ID), d); err != nil {
+			return err
+		}
+		if len(grids) == 0 {
+			return nil
+		}
+		b, err := tx.Bucket(gridsBucket).CreateBucketIfNotExists([]byte(r.ID))
+		if err != nil {
+			return err
+		}
+		for k, v := range grids {
+			if err := b.Put(gridKey(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (st *sessionStore) deleteGrids(id string) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(gridsBucket).DeleteBucket([]byte(id))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
+
+func (st *sessionStore) loadHistoric() ([]historicRecord, error) {
+	res := []historicRecord{}
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(historicBucket).ForEach(func(k, v []byte) error {
+			var r historicRecord
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			res = append(res, r)
+			return nil
+		})
+	})
+	return res, err
+}
+
+func (st *sessionStore) loadGrids(id string) (map[int64][]byte, error) {
+	res := map[int64][]byte{}
+	err := st.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(gridsBucket).Bucket([]byte(id))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			grid := make([]byte, len(v))
+			copy(grid, v)
+			res[int64(binary.BigEndian.Uint64(k))] = grid
+			return nil
+		})
+	})
+	return res, err
+}
+
+// saveEvent writes the record, its Done intervals are not written, see completeEvent.
+func (st *sessionStore) saveEvent(r eventRecord) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		return putEvent(tx, r)
+	})
+}
+
+// completeEvent writes the record and marks the interval which starts at start as done in one
+// transaction.
+func (st *sessionStore) completeEvent(r eventRecord, start int64) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		if err := putEvent(tx, r); err != nil {
+			return err
+		}
+		b, err := tx.Bucket(doneBucket).CreateBucketIfNotExists([]byte(r.ID))
+		if err != nil {
+			return err
+		}
+		return b.Put(gridKey(start), nil)
+	})
+}
+
+func putEvent(tx *bolt.Tx, r eventRecord) error {
+	r.Done = nil
+	d, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(eventsBucket).Put([]byte(r.ID), d)
+}
+
+// loadEvents returns records of event sessions with their Done intervals in ascending order. Done
+// intervals of records written before they were stored separately are moved to doneBucket.
+func (st *sessionStore) loadEvents() ([]eventRecord, error) {
+	res := []eventRecord{}
+	err := st.db.Update(func(tx *bolt.Tx) error {
+		legacy := []eventRecord{}
+		err := tx.Bucket(eventsBucket).ForEach(func(k, v []byte) error {
+			var r eventRecord
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			if len(r.Done) > 0 {
+				legacy = append(legacy, r)
+			}
+			if b := tx.Bucket(doneBucket).Bucket(k); b != nil {
+				err := b.ForEach(func(k, _ []byte) error {
+					r.Done = append(r.Done, int64(binary.BigEndian.Uint64(k)))
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+			res = append(res, r)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, r := range legacy {
+			b, err := tx.Bucket(doneBucket).CreateBucketIfNotExists([]byte(r.ID))
+			if err != nil {
+				return err
+			}
+			for _, start := range r.Done {
+				if err := b.Put(gridKey(start), nil); err != nil {
+					return err
+				}
+			}
+			if err := putEvent(tx, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return res, err
+}
+
+func (st *sessionStore) Close() error {
+	return st.db.Close()
+}
+
+func gridKey(k int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(k))
+	return b
+}
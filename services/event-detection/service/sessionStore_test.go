@@ -0,0 +1,122 @@
+package service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/event-detection/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_sessionStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	st, err := openSessionStore(filepath.Join(dir, "sessions.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+
+	hr := historicRecord{
+		ID:      "h1",
+		Request: proto.HistoricRequest{CityId: "nyc", Timezone: "America/New_York"},
+		Status:  RunningStatus,
+		Done:    []int64{1100, 1101},
+	}
+	if err := st.saveHistoric(hr, map[int64][]byte{1100: {1, 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.saveHistoric(hr, map[int64][]byte{1101: {3}}); err != nil {
+		t.Fatal(err)
+	}
+	er := eventRecord{ID: "e1", Request: proto.EventRequest{CityId: "nyc"}, Status: RunningStatus}
+	if err := st.saveEvent(er); err != nil {
+		t.Fatal(err)
+	}
+	er.Status = FinishedStatus
+	for _, start := range []int64{7200, 3600} {
+		if err := st.completeEvent(er, start); err != nil {
+			t.Fatal(err)
+		}
+	}
+	er.Done = []int64{3600, 7200}
+
+	hrs, err := st.loadHistoric()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hrs, []historicRecord{hr}) {
+		t.Errorf("loadHistoric() = %v, want %v", hrs, []historicRecord{hr})
+	}
+	grids, err := st.loadGrids("h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64][]byte{1100: {1, 2}, 1101: {3}}
+	if !reflect.DeepEqual(grids, want) {
+		t.Errorf("loadGrids() = %v, want %v", grids, want)
+	}
+	ers, err := st.loadEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ers, []eventRecord{er}) {
+		t.Errorf("loadEvents() = %v, want %v", ers, []eventRecord{er})
+	}
+
+	if err := st.deleteGrids("h1"); err != nil {
+		t.Fatal(err)
+	}
+	grids, err = st.loadGrids("h1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grids) != 0 {
+		t.Errorf("loadGrids() after delete = %v, want empty", grids)
+	}
+}
+
+// Test_sessionStore_legacyDone loads the event record with Done intervals written in the record
+// itself, they are moved to their own bucket and kept after the record is saved again.
+func Test_sessionStore_legacyDone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	st, err := openSessionStore(filepath.Join(dir, "sessions.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	er := eventRecord{ID: "e1", Status: RunningStatus, Done: []int64{3600, 7200}}
+	d, err := json.Marshal(er)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = st.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(eventsBucket).Put([]byte(er.ID), d)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		ers, err := st.loadEvents()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ers, []eventRecord{er}) {
+			t.Fatalf("loadEvents() = %v, want %v", ers, []eventRecord{er})
+		}
+		if err := st.saveEvent(ers[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
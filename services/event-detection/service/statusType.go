@@ -7,6 +7,7 @@ const (
 	RunningStatus
 	FinishedStatus
 	FailedStatus
+	CanceledStatus
 )
 
 func (s StatusType) String() string {
@@ -17,6 +18,8 @@ func (s StatusType) String() string {
 		return "finished"
 	case FailedStatus:
 		return "failed"
+	case CanceledStatus:
+		return "canceled"
 	default:
 		return ""
 	}
@@ -3,6 +3,8 @@ package crawler
 import (
 	"errors"
 	"fmt"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/fixture"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
 	"io/ioutil"
@@ -18,9 +20,14 @@ type client struct {
 	cookies   []*http.Cookie
 	token     string
 	sessionID string
+	limits    Limits
+	bucket    *limit.Bucket
+	tape      *fixture.Tape
+	transport func(http.RoundTripper) http.RoundTripper
 }
 
-func newClient(token string, sessionID string) *client {
+func newClient(token string, sessionID string, limits Limits, tape *fixture.Tape,
+	transport func(http.RoundTripper) http.RoundTripper) *client {
 	return &client{
 		cl: http.Client{
 			Timeout: 30 * time.Second,
@@ -28,37 +35,69 @@ func newClient(token string, sessionID string) *client {
 		cookies:   nil,
 		token:     token,
 		sessionID: sessionID,
+		limits:    limits,
+		bucket:    limit.NewBucket(limits.ClientRate, limits.ClientBurst),
+		tape:      tape,
+		transport: transport,
 	}
 }
 
+// makeRequest makes the authorized request. Requests which got 429 or 5xx responses are retried
+// with backoff, the bucket of the client is paused for the backoff, so other requests of the
+// client wait meanwhile.
 func (cl *client) makeRequest(request string, tid int) ([]byte, error) {
-	cl.mu.Lock()
-	defer cl.mu.Unlock()
+	backoff := cl.limits.backoff()
+	for attempt := 0; ; attempt++ {
+		cl.bucket.Wait()
+		body, retry, err := cl.do(request, tid)
+		if !retry || attempt >= cl.limits.Retries {
+			return body, err
+		}
+		d := backoff.Next()
+		unilog.Logger().Warn("authorized request is throttled", zap.String("URL", request),
+			zap.Int("thread", tid), zap.Duration("backoff", d), zap.Error(err))
+		cl.bucket.Pause(d)
+	}
+}
+
+// do makes the request once, retry is true if the request may succeed after a delay.
+func (cl *client) do(request string, tid int) (body []byte, retry bool, err error) {
 	req, err := http.NewRequest("GET", request, nil)
 	if err != nil {
 		unilog.Logger().Error("unable to create request", zap.String("URL", request), zap.Error(err))
-		return nil, err
+		return nil, false, err
 	}
+	cl.mu.Lock()
 	cookie := fmt.Sprintf("csrftoken=%v; sessionid=%v;", cl.token, cl.sessionID)
+	cl.mu.Unlock()
 	req.Header.Set("cookie", cookie)
-	resp, err := cl.cl.Do(req)
+	resp, err := cl.tape.Client(wrapClient(&cl.cl, cl.transport)).Do(req)
 	if err != nil {
 		unilog.Logger().Error("unable to make request", zap.String("URL", request), zap.Error(err))
-		return nil, err
+		return nil, true, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode == 404 {
 		msg := "entity page was not found"
 		unilog.Logger().Error(msg, zap.String("URL", request))
 		err = errors.New(msg)
-		return nil, err
+		return nil, false, err
+	}
+	if resp.StatusCode == 429 {
+		return nil, true, errors.New("too many authorized requests")
 	}
-	if resp.StatusCode == 500 {
+	if resp.StatusCode >= 500 {
 		msg := "error during request execution."
-		unilog.Logger().Error(msg, zap.String("URL", request))
+		unilog.Logger().Error(msg, zap.String("URL", request), zap.Int("code", resp.StatusCode))
 		err = errors.New(msg)
-		return nil, err
+		return nil, true, err
 	}
+	if resp.StatusCode != 200 {
+		unilog.Logger().Error("unexpected response status", zap.String("URL", request), zap.Int("code", resp.StatusCode))
+		return nil, false, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
 	for _, c := range resp.Cookies() {
 		if strings.ToLower(c.Name) == "csrftoken" {
 			cl.token = c.Value
@@ -71,7 +110,6 @@ func (cl *client) makeRequest(request string, tid int) ([]byte, error) {
 				zap.Int("thread", tid))
 		}
 	}
-	body, err := ioutil.ReadAll(resp.Body)
-	time.Sleep(100 * time.Millisecond)
-	return body, nil
+	body, err = ioutil.ReadAll(resp.Body)
+	return body, false, err
 }
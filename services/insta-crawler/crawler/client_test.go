@@ -0,0 +1,38 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_do(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  int
+		body  bool
+		retry bool
+		err   bool
+	}{
+		{name: "ok", code: 200, body: true},
+		{name: "forbidden", code: 403, err: true},
+		{name: "redirect", code: 302, err: true},
+		{name: "not found", code: 404, err: true},
+		{name: "throttled", code: 429, retry: true, err: true},
+		{name: "server error", code: 503, retry: true, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.code)
+				w.Write([]byte("page"))
+			}))
+			defer s.Close()
+			cl := newClient("", "", testLimits, nil, nil)
+			body, retry, err := cl.do(s.URL, 0)
+			if (len(body) != 0) != tt.body || retry != tt.retry || (err != nil) != tt.err {
+				t.Errorf("do() = %q, %v, %v, want body %v, retry %v, error %v", body, retry, err, tt.body, tt.retry, tt.err)
+			}
+		})
+	}
+}
@@ -1,21 +1,125 @@
 package crawler
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/BurntSushi/toml"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/fixture"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
 )
 
+// Configuration of the crawler. Proxies of all groups are checked with requests to ProxyCheckURL
+// every ProxyCheckInterval seconds. Requests of workers and authorized clients are recorded to or
+// replayed from files by Fixtures. The spool of every group holds at most SpoolPosts posts which
+// are not sent to the data storage yet. Transport isn't read from the file: if it is set, it wraps
+// transports of requests of workers and authorized clients, e.g. to send them to a fake server in
+// tests.
 type Configuration struct {
-	RootDir        string
-	DataStorageURL string
-	Groups         []Group
+	RootDir            string
+	DataStorageURL     string
+	ProxyCheckURL      string
+	ProxyCheckInterval int64
+	Groups             []Group
+	Discovery          DiscoveryConfig
+	Fixtures           fixture.Config
+	SpoolPosts         int
+	Transport          func(http.RoundTripper) http.RoundTripper `toml:"-"`
 }
 
+var ErrNoProxies = errors.New("group with workers must have proxies")
+
+// DiscoveryConfig configures discovery of locations. TileSize is the size of searched tiles in
+// degrees, SearchDelay is the pause between searches in milliseconds, Rate is the number of found
+// locations added to a session per minute and Interval is the pause between searches over the
+// whole area in minutes.
+type DiscoveryConfig struct {
+	TileSize    float64
+	SearchDelay int64
+	Rate        int
+	Interval    int64
+}
+
+// Group of workers which share the authorized client and the pool of proxies. TorPorts are SOCKS
+// ports of local Tor instances, Proxies are other proxies and Tor instances with control ports.
+// Workers is the number of workers, it is the number of proxies if it isn't set.
 type Group struct {
 	TorPorts  []int
+	Proxies   []proxy.Config
+	Workers   int
 	Token     string
 	SessionID string
+	Limits    Limits
+}
+
+func (g Group) proxies() []proxy.Config {
+	res := make([]proxy.Config, 0, len(g.TorPorts)+len(g.Proxies))
+	for _, p := range g.TorPorts {
+		res = append(res, proxy.Config{URL: "socks5://127.0.0.1:" + strconv.Itoa(p)})
+	}
+	return append(res, g.Proxies...)
+}
+
+// Limits of requests of a group. WorkerRate and ClientRate are the numbers of requests per second
+// of every worker and of the authorized client, bursts are the sizes of their token buckets.
+// Requests which got 429 or 5xx responses are retried Retries times after delays growing from
+// MinBackoff to MaxBackoff milliseconds. A worker is parked for ParkTime seconds after
+// BreakerFailures failed requests in a row. Negative Retries disable retries.
+type Limits struct {
+	WorkerRate      float64
+	WorkerBurst     int
+	ClientRate      float64
+	ClientBurst     int
+	MinBackoff      int64
+	MaxBackoff      int64
+	Retries         int
+	BreakerFailures uint32
+	ParkTime        int64
+}
+
+func (l Limits) withDefaults() Limits {
+	if l.WorkerRate <= 0 {
+		l.WorkerRate = 1
+	}
+	if l.WorkerBurst <= 0 {
+		l.WorkerBurst = 3
+	}
+	if l.ClientRate <= 0 {
+		l.ClientRate = 2
+	}
+	if l.ClientBurst <= 0 {
+		l.ClientBurst = 2
+	}
+	if l.MinBackoff <= 0 {
+		l.MinBackoff = 1000
+	}
+	if l.MaxBackoff < l.MinBackoff {
+		l.MaxBackoff = 60 * l.MinBackoff
+	}
+	if l.Retries == 0 {
+		l.Retries = 3
+	} else if l.Retries < 0 {
+		l.Retries = 0
+	}
+	if l.BreakerFailures == 0 {
+		l.BreakerFailures = 5
+	}
+	if l.ParkTime <= 0 {
+		l.ParkTime = 300
+	}
+	return l
+}
+
+func (l Limits) backoff() *limit.Backoff {
+	return &limit.Backoff{
+		Min: time.Duration(l.MinBackoff) * time.Millisecond,
+		Max: time.Duration(l.MaxBackoff) * time.Millisecond,
+	}
 }
 
 func readConfig(path string) (cfg Configuration, err error) {
@@ -25,3 +129,57 @@ func readConfig(path string) (cfg Configuration, err error) {
 	}
 	return
 }
+
+func (cfg Configuration) withDefaults() Configuration {
+	if cfg.ProxyCheckURL == "" {
+		cfg.ProxyCheckURL = "https://www.instagram.com/robots.txt"
+	}
+	if cfg.ProxyCheckInterval <= 0 {
+		cfg.ProxyCheckInterval = 60
+	}
+	if cfg.SpoolPosts <= 0 {
+		cfg.SpoolPosts = 1000000
+	}
+	for i := range cfg.Groups {
+		g := &cfg.Groups[i]
+		g.Limits = g.Limits.withDefaults()
+		if g.Workers <= 0 {
+			g.Workers = len(g.TorPorts) + len(g.Proxies)
+		}
+	}
+	d := &cfg.Discovery
+	if d.TileSize <= 0 {
+		d.TileSize = 0.005
+	}
+	if d.SearchDelay <= 0 {
+		d.SearchDelay = 2500
+	}
+	if d.Rate <= 0 {
+		d.Rate = 10
+	}
+	if d.Interval <= 0 {
+		d.Interval = 24 * 60
+	}
+	return cfg
+}
+
+// validate checks the config with defaults. Anonymous requests of workers are made only through
+// proxies, so every group with workers must have them.
+func (cfg Configuration) validate() error {
+	for _, g := range cfg.Groups {
+		if g.Workers > 0 && len(g.proxies()) == 0 {
+			return ErrNoProxies
+		}
+	}
+	return nil
+}
+
+// wrapClient returns the client with its transport wrapped by wrap, cl is returned if wrap is nil.
+func wrapClient(cl *http.Client, wrap func(http.RoundTripper) http.RoundTripper) *http.Client {
+	if wrap == nil {
+		return cl
+	}
+	res := *cl
+	res.Transport = wrap(cl.Transport)
+	return &res
+}
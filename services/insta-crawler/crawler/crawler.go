@@ -3,22 +3,35 @@ package crawler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
-	storagesvc "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
 	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/fixture"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
 )
 
+// Crawler runs threads of groups of workers, which crawl sessions. ctx is canceled and wg waits
+// for threads and spool replays when the crawler is closed.
 type Crawler struct {
 	config  Configuration
 	mu      sync.Mutex
 	cnt     int
 	threads []*thread
+	store   *store.Store
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
 }
 
 func NewCrawler(confPath string) (*Crawler, error) {
@@ -26,10 +39,37 @@ func NewCrawler(confPath string) (*Crawler, error) {
 	if err != nil {
 		return nil, err
 	}
+	return New(conf)
+}
+
+// New starts the crawler with the config, defaults are set for missing values.
+func New(conf Configuration) (*Crawler, error) {
+	conf = conf.withDefaults()
+	err := conf.validate()
+	if err != nil {
+		unilog.Logger().Error("invalid config", zap.Error(err))
+		return nil, err
+	}
+	err = os.MkdirAll(conf.RootDir, 0777)
+	if err != nil {
+		unilog.Logger().Error("unable to create root directory", zap.String("path", conf.RootDir), zap.Error(err))
+		return nil, err
+	}
+	st, err := store.Open(path.Join(conf.RootDir, storePath))
+	if err != nil {
+		return nil, err
+	}
+	tape, err := fixture.Open(conf.Fixtures)
+	if err != nil {
+		unilog.Logger().Error("unable to open fixtures", zap.String("dir", conf.Fixtures.Dir), zap.Error(err))
+		return nil, err
+	}
 	cr := &Crawler{
 		config:  conf,
 		threads: make([]*thread, len(conf.Groups)),
+		store:   st,
 	}
+	cr.ctx, cr.cancel = context.WithCancel(context.Background())
 	for gi, g := range conf.Groups {
 		t := thread{
 			id:          gi,
@@ -40,19 +80,37 @@ func NewCrawler(confPath string) (*Crawler, error) {
 			entitiesCh:  make(chan data.Entity),
 			mediaCh:     make(chan []data.Media),
 			checkpoints: map[string]string{},
-			cl:          newClient(g.Token, g.SessionID),
+			cl:          newClient(g.Token, g.SessionID, g.Limits, tape, conf.Transport),
+			store:       st,
+			storageURL:  conf.DataStorageURL,
 			rootDir:     cr.config.RootDir,
+			discovery:   cr.config.Discovery,
+			limits:      g.Limits,
+			ctx:         cr.ctx,
+			quit:        make(chan struct{}),
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		conn, err := grpc.DialContext(ctx, conf.DataStorageURL, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(storagesvc.MaxMsgSize)))
+		t.dataStorage, err = dialStorage(conf.DataStorageURL)
 		if err != nil {
 			unilog.Logger().Error("unable to connect to storage service", zap.Error(err))
-		} else {
-			t.dataStorage = storagesvc.NewGRPCClient(conn)
 		}
-		t.workers = make([]*worker, len(g.TorPorts))
-		for i, p := range g.TorPorts {
+		t.spool, err = spool.Open(path.Join(conf.RootDir, fmt.Sprintf(spoolPath, gi)), conf.SpoolPosts)
+		if err != nil {
+			return nil, err
+		}
+		cr.wg.Add(1)
+		go func(t *thread, b *limit.Backoff) {
+			defer cr.wg.Done()
+			t.spool.Replay(cr.ctx, t.pushBatch, b)
+		}(&t, g.Limits.backoff())
+		t.pool, err = proxy.NewPool(g.proxies(), conf.ProxyCheckURL, int(g.Limits.BreakerFailures),
+			time.Duration(g.Limits.ParkTime)*time.Second, 30*time.Second)
+		if err != nil {
+			unilog.Logger().Error("unable to create proxy pool", zap.Int("group", gi), zap.Error(err))
+			return nil, err
+		}
+		go t.pool.Run(cr.ctx, time.Duration(conf.ProxyCheckInterval)*time.Second)
+		t.workers = make([]*worker, g.Workers)
+		for i := range t.workers {
 			t.workers[i] = &worker{
 				id:         i,
 				tid:        gi,
@@ -62,11 +120,25 @@ func NewCrawler(confPath string) (*Crawler, error) {
 				entitiesCh: t.entitiesCh,
 				mediaCh:    t.mediaCh,
 				paramsCh:   make(chan Parameters),
+				pool:       t.pool,
 				cl:         t.cl,
+				tape:       tape,
+				transport:  conf.Transport,
+				quit:       t.quit,
 			}
-			t.workers[i].init(p)
+			t.workers[i].init(g.Limits)
 			go t.workers[i].start()
 		}
+		// discovery makes its requests with its own limits and proxy, so it doesn't slow workers down
+		t.searcher = &worker{
+			id:        g.Workers,
+			tid:       gi,
+			pool:      t.pool,
+			cl:        t.cl,
+			tape:      tape,
+			transport: conf.Transport,
+		}
+		t.searcher.init(g.Limits)
 		cr.threads[gi] = &t
 	}
 	cr.restoreSessions()
@@ -77,28 +149,70 @@ func NewCrawler(confPath string) (*Crawler, error) {
 
 func (cr *Crawler) start() {
 	for _, t := range cr.threads {
-		go t.start()
+		cr.wg.Add(1)
+		go func(t *thread) {
+			defer cr.wg.Done()
+			t.start()
+		}(t)
 	}
 }
 
+// Close stops the crawler. Threads finish the current rounds of their sessions, which continue
+// from their checkpoints when the crawler is started again, then spools and the store are closed.
+func (cr *Crawler) Close() error {
+	cr.mu.Lock()
+	cr.cancel()
+	for _, t := range cr.threads {
+		t.mu.Lock()
+		for _, s := range t.sessions {
+			s.discovered.halt()
+		}
+		t.mu.Unlock()
+	}
+	cr.mu.Unlock()
+	cr.wg.Wait()
+	for _, t := range cr.threads {
+		if err := t.spool.Close(); err != nil {
+			unilog.Logger().Error("unable to close spool", zap.Int("group", t.id), zap.Error(err))
+		}
+	}
+	unilog.Logger().Info("crawler has stopped")
+	return cr.store.Close()
+}
+
+// restoreSessions loads sessions from the store. Sessions dumped to TOML files by old versions of
+// the crawler are moved to the store.
 func (cr *Crawler) restoreSessions() {
 	cr.mu.Lock()
 	defer cr.mu.Unlock()
-	sessionDirs, err := ioutil.ReadDir(cr.config.RootDir)
+	cr.importSessions()
+	records, err := cr.store.Sessions()
 	if err != nil {
-		unilog.Logger().Error("unable to read sessions directory", zap.Error(err))
+		unilog.Logger().Error("unable to read sessions", zap.Error(err))
+		return
+	}
+	if len(cr.threads) == 0 {
 		return
 	}
 	c := 0
-	for _, dir := range sessionDirs {
-		sess, err := readSession(dir.Name(), cr.config.RootDir)
-		if err != nil || sess.Status == nil {
+	for _, r := range records {
+		cps, err := cr.store.Checkpoints(r.ID)
+		if err != nil {
+			unilog.Logger().Error("unable to read checkpoints", zap.String("id", r.ID), zap.Error(err))
+			continue
+		}
+		sess, err := loadSession(r, cps)
+		if err != nil {
+			unilog.Logger().Error("unable to decode session", zap.String("id", r.ID), zap.Error(err))
 			continue
 		}
 		if sess.Status.Status == FailedStatus {
 			continue
 		}
 		cr.threads[c].sessions = append(cr.threads[c].sessions, &sess)
+		if sess.Params.Discover {
+			go cr.threads[c].discover(sess.discovered.begin(), &sess)
+		}
 		if c == (len(cr.threads) - 1) {
 			c = 0
 		} else {
@@ -108,6 +222,34 @@ func (cr *Crawler) restoreSessions() {
 	cr.cnt = c
 }
 
+func (cr *Crawler) importSessions() {
+	files, err := ioutil.ReadDir(cr.config.RootDir)
+	if err != nil {
+		unilog.Logger().Error("unable to read sessions directory", zap.Error(err))
+		return
+	}
+	for _, f := range files {
+		if f.IsDir() || path.Ext(f.Name()) != ".toml" {
+			continue
+		}
+		id := strings.TrimSuffix(f.Name(), ".toml")
+		if _, err := cr.store.Session(id); err != store.ErrSessionNotFound {
+			continue
+		}
+		sess, err := readSession(path.Join(cr.config.RootDir, f.Name()))
+		if err != nil || sess.Status == nil || sess.ID != id {
+			continue
+		}
+		if sess.save(cr.store) != nil {
+			continue
+		}
+		for eid, cp := range sess.Params.Checkpoints {
+			cr.store.CommitPage(id, eid, store.Checkpoint{Cursor: cp}, sess.Status.counters())
+		}
+		unilog.Logger().Info("imported session", zap.String("id", id))
+	}
+}
+
 func (cr *Crawler) NewSession(p Parameters) (string, error) {
 	cr.mu.Lock()
 	defer cr.mu.Unlock()
@@ -123,28 +265,219 @@ func (cr *Crawler) NewSession(p Parameters) (string, error) {
 	return id, nil
 }
 
+var (
+	ErrSessionNotFound = errors.New("session was not found")
+	ErrNotRunning      = errors.New("session is not running")
+	ErrNotPaused       = errors.New("session is not paused")
+)
+
+// ListFilter selects sessions in List by their status, city and crawling type, empty fields match
+// all sessions.
+type ListFilter struct {
+	Status string
+	CityID string
+	Type   string
+}
+
+func (f ListFilter) match(st OutStatus) bool {
+	return (f.Status == "" || f.Status == st.Status) &&
+		(f.CityID == "" || f.CityID == st.CityID) &&
+		(f.Type == "" || f.Type == st.Type)
+}
+
+// find returns the session with the ID and its thread, cr.mu must be held.
+func (cr *Crawler) find(id string) (*thread, *Session, error) {
+	for _, t := range cr.threads {
+		for _, s := range t.sessions {
+			if s.ID == id {
+				return t, s, nil
+			}
+		}
+	}
+	return nil, nil, ErrSessionNotFound
+}
+
 func (cr *Crawler) Status(id string) (OutStatus, error) {
 	cr.mu.Lock()
 	defer cr.mu.Unlock()
+	t, s, err := cr.find(id)
+	if err != nil {
+		return OutStatus{}, err
+	}
+	return t.status(s), nil
+}
+
+// List returns statuses of sessions which match the filter.
+func (cr *Crawler) List(f ListFilter) []OutStatus {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	res := []OutStatus{}
 	for _, t := range cr.threads {
 		for _, s := range t.sessions {
-			if s.ID == id {
-				return s.status(), nil
+			st := t.status(s)
+			if f.match(st) {
+				res = append(res, st)
 			}
 		}
 	}
-	return OutStatus{}, errors.New("session was not found")
+	return res
 }
 
 func (cr *Crawler) Stop(id string) (bool, error) {
 	cr.mu.Lock()
 	defer cr.mu.Unlock()
-	for _, t := range cr.threads {
-		for _, s := range t.sessions {
-			ok, err := s.stop()
-			s.dump(cr.config.RootDir)
-			return ok, err
+	t, s, err := cr.find(id)
+	if err != nil {
+		return false, err
+	}
+	ok, err := s.stop()
+	s.discovered.halt()
+	t.saveIdle(s)
+	return ok, err
+}
+
+// Pause pauses the running session. The thread leaves the session at the start of the next round,
+// checkpoints of the session are kept.
+func (cr *Crawler) Pause(id string) (bool, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, s, err := cr.find(id)
+	if err != nil {
+		return false, err
+	}
+	if !s.Status.transit(RunningStatus, PausedStatus) {
+		return false, ErrNotRunning
+	}
+	s.discovered.halt()
+	return true, t.saveIdle(s)
+}
+
+// Resume continues the paused session from its checkpoints.
+func (cr *Crawler) Resume(id string) (bool, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, s, err := cr.find(id)
+	if err != nil {
+		return false, err
+	}
+	if !s.Status.transit(PausedStatus, RunningStatus) {
+		return false, ErrNotPaused
+	}
+	if s.Params.Discover {
+		go t.discover(s.discovered.begin(), s)
+	}
+	return true, t.saveIdle(s)
+}
+
+// Delete removes the session from the crawler and the store. Files of the session and posts sent
+// to the data storage are kept.
+func (cr *Crawler) Delete(id string) (bool, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, s, err := cr.find(id)
+	if err != nil {
+		return false, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range t.sessions {
+		if t.sessions[i] == s {
+			t.sessions = append(t.sessions[:i], t.sessions[i+1:]...)
+			break
+		}
+	}
+	s.Status.delete()
+	s.discovered.halt()
+	// the session which is crawled now is removed from the store when the thread leaves it
+	if t.active == s {
+		return true, nil
+	}
+	err = cr.store.DeleteSession(id)
+	if err != nil {
+		unilog.Logger().Error("unable to delete session", zap.String("id", id), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// Edit changes parameters of the session, see Edit for details.
+func (cr *Crawler) Edit(id string, e Edit) (bool, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, s, err := cr.find(id)
+	if err != nil {
+		return false, err
+	}
+	err = t.edit(s, e)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetPriority changes the priority of the session.
+func (cr *Crawler) SetPriority(id string, priority int) (bool, error) {
+	return cr.Edit(id, Edit{Priority: &priority})
+}
+
+// DeadBatch is a batch of posts of a session which the data storage rejected. Created is the time
+// when the batch was spooled, Error is the error of its push.
+type DeadBatch struct {
+	ID      uint64
+	CityID  string
+	Posts   int
+	Created int64
+	Error   string
+}
+
+// DeadBatches returns batches of posts of the session which the data storage rejected.
+func (cr *Crawler) DeadBatches(id string) ([]DeadBatch, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, _, err := cr.find(id)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := t.spool.Dead()
+	if err != nil {
+		unilog.Logger().Error("unable to read dead batches", zap.String("id", id), zap.Error(err))
+		return nil, err
+	}
+	res := []DeadBatch{}
+	for _, b := range bs {
+		if b.Session == id {
+			res = append(res, DeadBatch{ID: b.ID, CityID: b.CityID, Posts: len(b.Posts), Created: b.Created, Error: b.Error})
 		}
 	}
-	return false, errors.New("session was not found")
+	return res, nil
+}
+
+// Requeue pushes dead batches of the session with the IDs again, all dead batches of the session
+// are pushed if ids is empty. It returns the number of requeued batches.
+func (cr *Crawler) Requeue(id string, ids []uint64) (int, error) {
+	dead, err := cr.DeadBatches(id)
+	if err != nil {
+		return 0, err
+	}
+	want := map[uint64]bool{}
+	for _, bid := range ids {
+		want[bid] = true
+	}
+	var res []uint64
+	for _, b := range dead {
+		if len(ids) == 0 || want[b.ID] {
+			res = append(res, b.ID)
+		}
+	}
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	t, _, err := cr.find(id)
+	if err != nil {
+		return 0, err
+	}
+	n, err := t.spool.Requeue(res)
+	if err != nil {
+		unilog.Logger().Error("unable to requeue dead batches", zap.String("id", id), zap.Error(err))
+	}
+	return n, err
 }
@@ -0,0 +1,77 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+)
+
+func TestCrawler_Requeue(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	ds := &storageStub{err: errors.New(storage.ErrInvalidPosts.Error())}
+	th := newTestThread(st, ds, dir)
+	sp, err := spool.Open(filepath.Join(dir, "spool.db"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sp.Close()
+	th.spool = sp
+	th.sessions = []*Session{{ID: "s1", Status: &Status{Status: RunningStatus}}, {ID: "s2", Status: &Status{}}}
+	cr := &Crawler{threads: []*thread{th}}
+	for _, id := range []string{"s1", "s2", "s1"} {
+		if err := th.sendPostsToDataStorage(testPosts(2), id, "spb"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sp.Replay(ctx, th.pushBatch, testLimits.backoff())
+	waitStats := func(cond func(spool.Stats) bool) spool.Stats {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			if cond(sp.Stats()) {
+				break
+			}
+		}
+		return sp.Stats()
+	}
+	if st := waitStats(func(st spool.Stats) bool { return st.Dead == 3 }); st.Dead != 3 {
+		t.Fatalf("Stats() = %+v, want 3 dead batches", st)
+	}
+
+	dead, err := cr.DeadBatches("s1")
+	if err != nil {
+		t.Fatalf("DeadBatches() error = %v", err)
+	}
+	if len(dead) != 2 || dead[0].Posts != 2 || dead[0].Error != storage.ErrInvalidPosts.Error() {
+		t.Fatalf("DeadBatches() = %+v, want 2 batches of the session with their errors", dead)
+	}
+	if _, err := cr.DeadBatches("s3"); err != ErrSessionNotFound {
+		t.Errorf("DeadBatches() error = %v, want %v", err, ErrSessionNotFound)
+	}
+
+	ds.mu.Lock()
+	ds.err = nil
+	ds.mu.Unlock()
+	n, err := cr.Requeue("s1", []uint64{dead[1].ID})
+	if err != nil || n != 1 {
+		t.Fatalf("Requeue() = %v, %v, want 1 batch", n, err)
+	}
+	if st := waitStats(func(st spool.Stats) bool { return st.Batches == 0 }); st.Dead != 2 || len(ds.posts) != 2 {
+		t.Errorf("Stats() = %+v with %v pushed posts, want the requeued batch pushed", st, len(ds.posts))
+	}
+	// all dead batches of the session are requeued without IDs
+	if n, err := cr.Requeue("s1", nil); err != nil || n != 1 {
+		t.Errorf("Requeue() = %v, %v, want the last batch of the session", n, err)
+	}
+	if st := waitStats(func(st spool.Stats) bool { return st.Batches == 0 }); st.Dead != 1 || len(ds.posts) != 4 {
+		t.Errorf("Stats() = %+v with %v pushed posts, want only the batch of the other session dead", st, len(ds.posts))
+	}
+}
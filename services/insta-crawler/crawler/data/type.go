@@ -13,6 +13,7 @@ const (
 	ProfilesType
 	InternalProfilesType
 	StoriesType
+	HashtagsType
 )
 
 func parseType(s string) CrawlingType {
@@ -25,6 +26,8 @@ func parseType(s string) CrawlingType {
 		return InternalProfilesType
 	case "stories":
 		return StoriesType
+	case "hashtags":
+		return HashtagsType
 	default:
 		return InvalidType
 	}
@@ -40,6 +43,8 @@ func (s CrawlingType) String() string {
 		return "profiles-internal"
 	case StoriesType:
 		return "stories"
+	case HashtagsType:
+		return "hashtags"
 	default:
 		return ""
 	}
@@ -0,0 +1,201 @@
+package crawler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/discovery"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// discovered holds locations found by discovery until they are added to the session. cancel stops
+// the discovery routine of the session which runs now.
+type discovered struct {
+	mu        sync.Mutex
+	known     map[string]bool
+	locations []data.Location
+	cancel    context.CancelFunc
+}
+
+func newDiscovered(ls []data.Location) *discovered {
+	d := &discovered{known: map[string]bool{}}
+	for _, l := range ls {
+		d.known[l.ID] = true
+	}
+	return d
+}
+
+func (d *discovered) ids() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := make([]string, 0, len(d.known))
+	for id := range d.known {
+		res = append(res, id)
+	}
+	return res
+}
+
+func (d *discovered) add(l data.Location) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.known[l.ID] {
+		return false
+	}
+	d.known[l.ID] = true
+	d.locations = append(d.locations, l)
+	return true
+}
+
+// know marks locations as known, so they aren't added by discovery.
+func (d *discovered) know(ls []data.Location) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, l := range ls {
+		d.known[l.ID] = true
+	}
+}
+
+// begin stops the discovery routine of the session if it runs and returns the context of the new
+// one. It is called before the routine is started, so halt stops the routine even if it hasn't run
+// yet.
+func (d *discovered) begin() context.Context {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cancel != nil {
+		d.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	return ctx
+}
+
+// halt stops the discovery routine of the session if it runs.
+func (d *discovered) halt() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cancel != nil {
+		d.cancel()
+		d.cancel = nil
+	}
+}
+
+func (d *discovered) take() []data.Location {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := d.locations
+	d.locations = nil
+	return res
+}
+
+// discover searches for new locations in the area of the session while it is running. Found
+// locations are added to the session at the configured rate, the area is searched again after the
+// configured interval. Only one routine runs for the session: it is stopped when the session is
+// stopped, paused or deleted, and started again when the session is resumed. ctx must be returned
+// by begin of the session.
+func (th *thread) discover(ctx context.Context, sess *Session) {
+	src, err := source.New(sess.Params.Source, sess.Params.FeedURL)
+	if err != nil {
+		unilog.Logger().Error("unable to create source", zap.String("session", sess.ID), zap.Error(err))
+		return
+	}
+	searcher, ok := src.(source.LocationSearcher)
+	if !ok {
+		unilog.Logger().Error("source doesn't support location search", zap.String("session", sess.ID),
+			zap.String("source", sess.Params.Source))
+		return
+	}
+	if th.searcher == nil {
+		return
+	}
+	cfg := th.discovery
+	pause := time.Minute / time.Duration(cfg.Rate)
+	for ctx.Err() == nil && sess.Status.running() {
+		known := sess.discovered.ids()
+		if ds := th.storage(); ds != nil {
+			ls, err := ds.PullLocations(ctx, sess.Params.CityID)
+			if err != nil {
+				unilog.Logger().Error("unable to pull locations", zap.String("city", sess.Params.CityID), zap.Error(err))
+			}
+			for _, l := range ls {
+				known = append(known, l.ID)
+			}
+		}
+		d := discovery.New(searcher, th.searcher, sess.Params.TopLeft, sess.Params.BottomRight, cfg.TileSize,
+			time.Duration(cfg.SearchDelay)*time.Millisecond, known)
+		out := make(chan data.Location)
+		go func() {
+			d.Run(ctx, out)
+			close(out)
+		}()
+		num := 0
+		for l := range out {
+			if ctx.Err() == nil && sess.discovered.add(l) {
+				num++
+				wait(ctx, pause)
+			}
+		}
+		unilog.Logger().Info("locations discovered", zap.String("session", sess.ID), zap.Int("locations", num))
+		wait(ctx, time.Duration(cfg.Interval)*time.Minute)
+	}
+}
+
+// wait sleeps for the duration or until the context is done.
+func wait(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
+
+// addDiscovered adds locations found by discovery to the session and to the entities of the next
+// round of the session.
+func (th *thread) addDiscovered(sess *Session, entities []string) []string {
+	ls := sess.discovered.take()
+	if len(ls) == 0 {
+		return entities
+	}
+	sess.Params.Locations = append(sess.Params.Locations, ls...)
+	for i := range ls {
+		entities = append(entities, ls[i].ID)
+	}
+	th.pushLocations(sess, ls)
+	sess.Status.updateEntitiesLeft(len(ls))
+	for j := range th.workers {
+		th.workers[j].paramsCh <- sess.Params
+	}
+	unilog.Logger().Info("added discovered locations", zap.String("session", sess.ID), zap.Int("locations", len(ls)))
+	return entities
+}
+
+// pushLocations sends locations added to the session to the data storage.
+func (th *thread) pushLocations(sess *Session, ls []data.Location) {
+	ds := th.storage()
+	if ds == nil || len(ls) == 0 {
+		return
+	}
+	pls := make([]protodata.Location, len(ls))
+	for i := range ls {
+		pls[i] = convertToProtoLocation(&ls[i])
+	}
+	err := ds.PushLocations(context.Background(), sess.Params.CityID, pls)
+	if err != nil {
+		unilog.Logger().Error("unable to push locations", zap.String("session", sess.ID), zap.Error(err))
+		sess.Status.setError(err.Error())
+	}
+}
@@ -0,0 +1,112 @@
+package discovery
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+)
+
+// Discoverer finds locations of an area by searching for locations around centres of tiles of the
+// area. Locations with known IDs and locations outside the area are skipped.
+type Discoverer struct {
+	searcher    source.LocationSearcher
+	fetcher     source.Fetcher
+	topLeft     protodata.Point
+	bottomRight protodata.Point
+	tileSize    float64
+	delay       time.Duration
+	known       map[string]bool
+}
+
+// New returns the discoverer of the area between topLeft and bottomRight. tileSize is the size of
+// tiles in degrees, delay is the pause between searches.
+func New(searcher source.LocationSearcher, fetcher source.Fetcher, topLeft, bottomRight protodata.Point,
+	tileSize float64, delay time.Duration, known []string) *Discoverer {
+	d := &Discoverer{
+		searcher:    searcher,
+		fetcher:     fetcher,
+		topLeft:     topLeft,
+		bottomRight: bottomRight,
+		tileSize:    tileSize,
+		delay:       delay,
+		known:       map[string]bool{},
+	}
+	for _, id := range known {
+		d.known[id] = true
+	}
+	return d
+}
+
+// Run searches around all tiles and sends new locations to out. It returns when all tiles are
+// searched or the context is canceled.
+func (d *Discoverer) Run(ctx context.Context, out chan<- data.Location) error {
+	for i, c := range Tiles(d.topLeft, d.bottomRight, d.tileSize) {
+		if i > 0 && d.delay > 0 {
+			select {
+			case <-time.After(d.delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		ls, err := d.searcher.SearchLocations(d.fetcher, c.Lat, c.Lon)
+		if err != nil {
+			unilog.Logger().Error("unable to search locations", zap.Float64("lat", c.Lat),
+				zap.Float64("lon", c.Lon), zap.Error(err))
+			continue
+		}
+		for _, l := range ls {
+			if d.known[l.ID] || !d.inArea(l) {
+				continue
+			}
+			d.known[l.ID] = true
+			select {
+			case out <- l:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+func (d *Discoverer) inArea(l data.Location) bool {
+	minLat, maxLat := math.Min(d.topLeft.Lat, d.bottomRight.Lat), math.Max(d.topLeft.Lat, d.bottomRight.Lat)
+	minLon, maxLon := math.Min(d.topLeft.Lon, d.bottomRight.Lon), math.Max(d.topLeft.Lon, d.bottomRight.Lon)
+	return l.Lat >= minLat && l.Lat <= maxLat && l.Lon >= minLon && l.Lon <= maxLon
+}
+
+// Tiles returns centres of square tiles of the size covering the area, rows go from the top left
+// corner of the area.
+func Tiles(topLeft, bottomRight protodata.Point, size float64) []protodata.Point {
+	minLat, maxLat := math.Min(topLeft.Lat, bottomRight.Lat), math.Max(topLeft.Lat, bottomRight.Lat)
+	minLon, maxLon := math.Min(topLeft.Lon, bottomRight.Lon), math.Max(topLeft.Lon, bottomRight.Lon)
+	if size <= 0 {
+		return []protodata.Point{{Lat: (minLat + maxLat) / 2, Lon: (minLon + maxLon) / 2}}
+	}
+	rows := int(math.Ceil((maxLat - minLat) / size))
+	cols := int(math.Ceil((maxLon - minLon) / size))
+	if rows == 0 {
+		rows = 1
+	}
+	if cols == 0 {
+		cols = 1
+	}
+	res := make([]protodata.Point, 0, rows*cols)
+	for i := 0; i < rows; i++ {
+		lat := maxLat - size*(float64(i)+0.5)
+		for j := 0; j < cols; j++ {
+			lon := minLon + size*(float64(j)+0.5)
+			res = append(res, protodata.Point{Lat: lat, Lon: lon})
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package discovery
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func TestTiles(t *testing.T) {
+	got := Tiles(protodata.Point{Lat: 60, Lon: 30}, protodata.Point{Lat: 59, Lon: 31.5}, 1)
+	want := []protodata.Point{{Lat: 59.5, Lon: 30.5}, {Lat: 59.5, Lon: 31.5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tiles() = %v, want %v", got, want)
+	}
+	got = Tiles(protodata.Point{Lat: 60, Lon: 30}, protodata.Point{Lat: 59, Lon: 31}, 0)
+	want = []protodata.Point{{Lat: 59.5, Lon: 30.5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tiles() = %v, want %v", got, want)
+	}
+}
+
+func TestDiscoverer_Run(t *testing.T) {
+	stub := source.Stub{
+		Locations: []data.Location{
+			{ID: "1", Lat: 59.9, Lon: 30.1},
+			{ID: "2", Lat: 59.5, Lon: 30.5},
+			{ID: "3", Lat: 59.2, Lon: 30.9},
+			{ID: "4", Lat: 58.9, Lon: 30.9},
+		},
+		Radius: 0.5,
+	}
+	d := New(stub, nil, protodata.Point{Lat: 60, Lon: 30}, protodata.Point{Lat: 59, Lon: 31}, 0.5, 0, []string{"1"})
+	out := make(chan data.Location)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- d.Run(context.Background(), out)
+		close(out)
+	}()
+	got := []string{}
+	for l := range out {
+		got = append(got, l.ID)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	// the known location and the location outside the area are skipped, others are sent once
+	want := []string{"2", "3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+}
+
+func TestDiscoverer_Run_canceled(t *testing.T) {
+	stub := source.Stub{Locations: []data.Location{{ID: "1", Lat: 59.5, Lon: 30.5}}, Radius: 1}
+	d := New(stub, nil, protodata.Point{Lat: 60, Lon: 30}, protodata.Point{Lat: 59, Lon: 31}, 1, 0, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := d.Run(ctx, make(chan data.Location)); err != context.Canceled {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,190 @@
+package e2e
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/instatest"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+)
+
+// config returns the config of the crawler with one group of two workers behind the proxy. The
+// data storage is unavailable, so collected posts stay in the spool.
+func config(dir, proxyURL string) crawler.Configuration {
+	return crawler.Configuration{
+		RootDir:        filepath.Join(dir, "sessions"),
+		DataStorageURL: "127.0.0.1:1",
+		Groups: []crawler.Group{{
+			Proxies: []proxy.Config{{URL: proxyURL}},
+			Workers: 2,
+			Limits: crawler.Limits{
+				WorkerRate:      100,
+				WorkerBurst:     10,
+				ClientRate:      100,
+				ClientBurst:     10,
+				MinBackoff:      10,
+				MaxBackoff:      100,
+				Retries:         3,
+				BreakerFailures: 10,
+				ParkTime:        1,
+			},
+		}},
+	}
+}
+
+// TestCrawler crawls paginated location feeds of the fake Instagram, which throttles and fails
+// some requests. The fake Instagram is the proxy of the workers too.
+func TestCrawler(t *testing.T) {
+	if testing.Short() {
+		t.Skip("rounds of the crawler take several seconds")
+	}
+	s := instatest.NewServer(map[string]int{"1": 120, "2": 30})
+	defer s.Close()
+	s.Throttle(2)
+	s.Fail(1)
+
+	dir, err := ioutil.TempDir("", "crawler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	conf := config(dir, s.URL)
+	conf.ProxyCheckURL = s.URL + "/robots.txt"
+	conf.Transport = s.Wrap
+	cr, err := crawler.New(conf)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	id, err := cr.NewSession(crawler.Parameters{
+		CityID:    "spb",
+		Locations: []data.Location{{ID: "1"}, {ID: "2"}},
+	})
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+
+	want := map[string]int{"1": 120, "2": 30}
+	var st crawler.OutStatus
+	for deadline := time.Now().Add(2 * time.Minute); time.Now().Before(deadline); time.Sleep(500 * time.Millisecond) {
+		st, err = cr.Status(id)
+		if err != nil {
+			t.Fatalf("Status() error = %v", err)
+		}
+		if reflect.DeepEqual(st.LocationPosts, want) {
+			break
+		}
+	}
+	if !reflect.DeepEqual(st.LocationPosts, want) {
+		t.Fatalf("posts per location = %v, want %v", st.LocationPosts, want)
+	}
+	if st.PostsCollected != 150 || st.Spool.Posts != 150 {
+		t.Errorf("Status() = %+v, want 150 collected posts in the spool", st)
+	}
+	// three pages of the first location and one of the second one, throttled and failed requests
+	// are retried
+	if s.Requests() < 7 {
+		t.Errorf("Requests() = %v, want at least 7", s.Requests())
+	}
+	if s.Proxied() != s.Requests() {
+		t.Errorf("Proxied() = %v, want all %v requests through the proxy", s.Proxied(), s.Requests())
+	}
+
+	// the closed crawler releases its databases, so it can be started again with the session
+	if err := cr.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	cr, err = crawler.New(conf)
+	if err != nil {
+		t.Fatalf("New() after Close() error = %v", err)
+	}
+	defer cr.Close()
+	if st, err := cr.Status(id); err != nil || st.Spool.Posts != 150 {
+		t.Errorf("Status() after restart = %+v, %v, want 150 posts in the spool", st, err)
+	}
+}
+
+// TestCrawler_fileDrop crawls a file drop which is appended to between passes, every post must be
+// collected once.
+func TestCrawler_fileDrop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("rounds of the crawler take several seconds")
+	}
+	dir, err := ioutil.TempDir("", "crawler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	drop := filepath.Join(dir, "a.ndjson")
+	// posts are newer than the start of the pass, so they are not filtered out in the next pass
+	ts := time.Now().Unix() + 3600
+	appendPosts := func(from, to int) {
+		f, err := os.OpenFile(drop, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		for i := from; i < to; i++ {
+			fmt.Fprintf(f, "{\"id\":\"p%v\",\"timestamp\":%v,\"lat\":59.9,\"lon\":30.3}\n", i, ts+int64(i))
+		}
+	}
+	appendPosts(0, 3)
+
+	// the file drop is read without requests, so the proxy is never used
+	cr, err := crawler.New(config(dir, "http://127.0.0.1:1"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer cr.Close()
+	id, err := cr.NewSession(crawler.Parameters{
+		CityID:    "spb",
+		Locations: []data.Location{{ID: "a"}},
+		Source:    "feed",
+		FeedURL:   "file://" + filepath.Join(dir, "{id}.ndjson"),
+	})
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+	spooled := func(want int) crawler.OutStatus {
+		var st crawler.OutStatus
+		for deadline := time.Now().Add(time.Minute); time.Now().Before(deadline); time.Sleep(500 * time.Millisecond) {
+			st, err = cr.Status(id)
+			if err != nil {
+				t.Fatalf("Status() error = %v", err)
+			}
+			if st.Spool.Posts >= want {
+				break
+			}
+		}
+		return st
+	}
+	if st := spooled(3); st.Spool.Posts != 3 {
+		t.Fatalf("posts in the spool = %v, want 3", st.Spool.Posts)
+	}
+	appendPosts(3, 5)
+	spooled(5)
+	// the next passes must not read the drop from the start again
+	time.Sleep(15 * time.Second)
+	if st := spooled(5); st.Spool.Posts != 5 {
+		t.Errorf("posts in the spool = %v, want 5", st.Spool.Posts)
+	}
+}
+
+func TestNew_noProxies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crawler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	conf := config(dir, "")
+	conf.Groups[0].Proxies = nil
+	if _, err := crawler.New(conf); err != crawler.ErrNoProxies {
+		t.Errorf("New() error = %v, want %v", err, crawler.ErrNoProxies)
+	}
+}
@@ -0,0 +1,81 @@
+package crawler
+
+import (
+	"errors"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+// Edit is a change of parameters of a session. Locations can be added to and removed from
+// sessions of the locations type only, nil fields are kept unchanged.
+type Edit struct {
+	AddLocations    []data.Location
+	RemoveLocations []string
+	DetailedPosts   *bool
+	LoadMedia       *bool
+	Priority        *int
+}
+
+var ErrEditLocations = errors.New("locations can be edited only in sessions of the locations type")
+
+func (e Edit) validate(p Parameters) error {
+	if (len(e.AddLocations) > 0 || len(e.RemoveLocations) > 0) && p.crawlingType() != data.LocationsType {
+		return ErrEditLocations
+	}
+	return nil
+}
+
+// apply changes parameters of the session. It returns locations which were added and IDs of
+// locations which were removed, locations which the session already has are skipped.
+func (e Edit) apply(sess *Session) (added []data.Location, removed []string) {
+	p := &sess.Params
+	if len(e.RemoveLocations) > 0 {
+		rm := map[string]bool{}
+		for _, id := range e.RemoveLocations {
+			rm[id] = true
+		}
+		ls := make([]data.Location, 0, len(p.Locations))
+		for _, l := range p.Locations {
+			if rm[l.ID] {
+				removed = append(removed, l.ID)
+				delete(p.Checkpoints, l.ID)
+				delete(p.Offsets, l.ID)
+				continue
+			}
+			ls = append(ls, l)
+		}
+		p.Locations = ls
+	}
+	known := map[string]bool{}
+	for _, l := range p.Locations {
+		known[l.ID] = true
+	}
+	for _, l := range e.AddLocations {
+		if l.ID == "" || known[l.ID] {
+			continue
+		}
+		known[l.ID] = true
+		p.Locations = append(p.Locations, l)
+		added = append(added, l)
+	}
+	if e.DetailedPosts != nil {
+		p.DetailedPosts = *e.DetailedPosts
+	}
+	if e.LoadMedia != nil {
+		p.LoadMedia = *e.LoadMedia
+	}
+	if e.Priority != nil {
+		p.Priority = *e.Priority
+	}
+	// removed locations are kept in the known ones, so discovery doesn't add them back
+	sess.discovered.know(added)
+	left := len(added)
+	for _, id := range removed {
+		if !sess.finished[id] {
+			left--
+		}
+		delete(sess.finished, id)
+	}
+	sess.Status.updateEntitiesLeft(left)
+	return
+}
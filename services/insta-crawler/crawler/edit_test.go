@@ -0,0 +1,67 @@
+package crawler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+func TestEdit_apply(t *testing.T) {
+	detailed := true
+	sess := &Session{
+		ID: "s1",
+		Params: Parameters{
+			Locations:   []data.Location{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+			Checkpoints: map[string]string{"1": "c1", "2": "c2"},
+			Discover:    true,
+		},
+		Status:   &Status{Status: RunningStatus, EntitiesLeft: 2},
+		finished: map[string]bool{"3": true},
+	}
+	sess.discovered = newDiscovered(sess.Params.Locations)
+	e := Edit{
+		AddLocations:    []data.Location{{ID: "4"}, {ID: "1"}, {ID: "4"}, {}},
+		RemoveLocations: []string{"2", "3", "5"},
+		DetailedPosts:   &detailed,
+	}
+	if err := e.validate(sess.Params); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+
+	added, removed := e.apply(sess)
+	if want := []data.Location{{ID: "4"}}; !reflect.DeepEqual(added, want) {
+		t.Errorf("apply() added = %v, want %v", added, want)
+	}
+	if want := []string{"2", "3"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("apply() removed = %v, want %v", removed, want)
+	}
+	if want := []string{"1", "4"}; !reflect.DeepEqual(sess.Params.entities(), want) {
+		t.Errorf("entities() = %v, want %v", sess.Params.entities(), want)
+	}
+	if want := map[string]string{"1": "c1"}; !reflect.DeepEqual(sess.Params.Checkpoints, want) {
+		t.Errorf("Checkpoints = %v, want %v", sess.Params.Checkpoints, want)
+	}
+	// the finished location wasn't left in the pass, the removed unfinished one was
+	if sess.Status.EntitiesLeft != 2 || len(sess.finished) != 0 {
+		t.Errorf("EntitiesLeft = %v, finished = %v, want 2 and none", sess.Status.EntitiesLeft, sess.finished)
+	}
+	if !sess.Params.DetailedPosts || sess.Params.LoadMedia {
+		t.Errorf("DetailedPosts = %v, LoadMedia = %v, want true and false", sess.Params.DetailedPosts, sess.Params.LoadMedia)
+	}
+	// removed locations are not discovered again
+	if sess.discovered.add(data.Location{ID: "2"}) || sess.discovered.add(data.Location{ID: "4"}) {
+		t.Errorf("discovered.add() = true for a removed or an added location")
+	}
+}
+
+func TestEdit_validate(t *testing.T) {
+	e := Edit{AddLocations: []data.Location{{ID: "1"}}}
+	if err := e.validate(Parameters{Type: data.ProfilesType}); err != ErrEditLocations {
+		t.Errorf("validate() error = %v, want %v", err, ErrEditLocations)
+	}
+	priority := 3
+	if err := (Edit{Priority: &priority}).validate(Parameters{Type: data.ProfilesType}); err != nil {
+		t.Errorf("validate() error = %v, want nil for a priority edit", err)
+	}
+}
@@ -1,7 +1,10 @@
 package crawler
 
+// entity is an entity of a session sent to workers. err is the error of the last page of the
+// entity returned by the worker.
 type entity struct {
 	id         string
 	checkpoint string
 	finished   bool
+	err        string
 }
@@ -0,0 +1,224 @@
+package fixture
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	RecordMode = "record"
+	ReplayMode = "replay"
+)
+
+var (
+	ErrMode      = errors.New("fixture mode is not supported")
+	ErrNoFixture = errors.New("fixture was not found")
+)
+
+// secretHeaders are headers of responses which are not recorded.
+var secretHeaders = []string{"Set-Cookie", "Cookie", "Authorization", "Proxy-Authorization", "Www-Authenticate",
+	"Proxy-Authenticate"}
+
+// Config of fixtures. In the record mode responses to requests are written to Dir, in the replay
+// mode requests are served from Dir without network. Fixtures are off if Mode is empty.
+// Recording starts the file of every request anew, and headers with cookies and credentials are
+// not written.
+type Config struct {
+	Mode string
+	Dir  string
+}
+
+// Fixture is a recorded response to the request. Body is encoded with base64 if Base64 is set,
+// which is the case for binary bodies like images.
+type Fixture struct {
+	Method string
+	URL    string
+	Status int
+	Header http.Header
+	Body   string
+	Base64 bool `json:",omitempty"`
+}
+
+// Tape records and replays fixtures. Responses to the same request are kept in one file in the
+// order of recording, the replay serves them in the same order and repeats the last one.
+type Tape struct {
+	mode     string
+	dir      string
+	mu       sync.Mutex
+	fixtures map[string][]Fixture
+	pos      map[string]int
+}
+
+// Open returns the tape of the config, it returns nil if fixtures are off.
+func Open(cfg Config) (*Tape, error) {
+	switch cfg.Mode {
+	case "":
+		return nil, nil
+	case RecordMode:
+		err := os.MkdirAll(cfg.Dir, 0777)
+		if err != nil {
+			return nil, err
+		}
+	case ReplayMode:
+	default:
+		return nil, ErrMode
+	}
+	t := &Tape{
+		mode:     cfg.Mode,
+		dir:      cfg.Dir,
+		fixtures: map[string][]Fixture{},
+		pos:      map[string]int{},
+	}
+	// old fixtures are not loaded for recording, so their files are overwritten
+	if cfg.Mode == RecordMode {
+		return t, nil
+	}
+	files, err := filepath.Glob(filepath.Join(cfg.Dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		d, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var fs []Fixture
+		err = json.Unmarshal(d, &fs)
+		if err != nil {
+			return nil, fmt.Errorf("fixture %v: %v", f, err)
+		}
+		if len(fs) > 0 {
+			t.fixtures[key(fs[0].Method, fs[0].URL)] = fs
+		}
+	}
+	return t, nil
+}
+
+func key(method, url string) string {
+	h := sha1.Sum([]byte(method + " " + url))
+	return hex.EncodeToString(h[:])
+}
+
+// Client returns the client which records or replays requests of cl, cl is returned if the tape
+// is nil.
+func (t *Tape) Client(cl *http.Client) *http.Client {
+	if t == nil {
+		return cl
+	}
+	res := *cl
+	res.Transport = &transport{tape: t, next: cl.Transport}
+	return &res
+}
+
+type transport struct {
+	tape *Tape
+	next http.RoundTripper
+}
+
+func (tr *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if tr.tape.mode == ReplayMode {
+		return tr.tape.replay(req)
+	}
+	next := tr.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	f := Fixture{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: stripSecrets(resp.Header),
+	}
+	if utf8.Valid(body) {
+		f.Body = string(body)
+	} else {
+		f.Body = base64.StdEncoding.EncodeToString(body)
+		f.Base64 = true
+	}
+	err = tr.tape.record(f)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func stripSecrets(h http.Header) http.Header {
+	res := h.Clone()
+	for _, k := range secretHeaders {
+		res.Del(k)
+	}
+	return res
+}
+
+// record appends the fixture to the file of its request.
+func (t *Tape) record(f Fixture) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	k := key(f.Method, f.URL)
+	t.fixtures[k] = append(t.fixtures[k], f)
+	d, err := json.MarshalIndent(t.fixtures[k], "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(t.dir, k+".json"), d, 0644)
+}
+
+func (t *Tape) replay(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	k := key(req.Method, req.URL.String())
+	fs := t.fixtures[k]
+	if len(fs) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("%v %v: %w", req.Method, req.URL, ErrNoFixture)
+	}
+	i := t.pos[k]
+	if i < len(fs)-1 {
+		t.pos[k]++
+	}
+	f := fs[i]
+	t.mu.Unlock()
+	body := []byte(f.Body)
+	if f.Base64 {
+		var err error
+		body, err = base64.StdEncoding.DecodeString(f.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	header := f.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
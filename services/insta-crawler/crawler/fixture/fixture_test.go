@@ -0,0 +1,137 @@
+package fixture
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func get(t *testing.T, cl *http.Client, url string) (int, string) {
+	resp, err := cl.Get(url)
+	if err != nil {
+		t.Fatalf("Get(%v) error = %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestTape(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/feed":
+			// the first request is throttled
+			if calls == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "sessionid", Value: "secret"})
+			fmt.Fprint(w, `{"page": 1}`)
+		case "/img":
+			w.Write([]byte{0xff, 0xd8, 0x00})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	rec, err := Open(Config{Mode: RecordMode, Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := rec.Client(&http.Client{})
+	get(t, cl, server.URL+"/feed")
+	get(t, cl, server.URL+"/feed")
+	get(t, cl, server.URL+"/img")
+	server.Close()
+
+	// responses are replayed in the order of recording without the server
+	rep, err := Open(Config{Mode: ReplayMode, Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl = rep.Client(&http.Client{})
+	want := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/feed", http.StatusTooManyRequests, ""},
+		{"/feed", http.StatusOK, `{"page": 1}`},
+		{"/feed", http.StatusOK, `{"page": 1}`},
+		{"/img", http.StatusOK, "\xff\xd8\x00"},
+	}
+	for _, w := range want {
+		code, body := get(t, cl, server.URL+w.path)
+		if code != w.code || body != w.body {
+			t.Errorf("Get(%v) = %v, %q, want %v, %q", w.path, code, body, w.code, w.body)
+		}
+	}
+	// cookies of responses are not written to fixtures
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		d, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(d), "secret") {
+			t.Errorf("fixture %v contains the cookie: %s", f, d)
+		}
+	}
+	if _, err := cl.Get(server.URL + "/other"); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNoFixture)
+	}
+	if tape, err := Open(Config{}); tape != nil || err != nil {
+		t.Errorf("Open() = %v, %v, want nil tape", tape, err)
+	}
+}
+
+func TestTape_rerecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	page := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page++
+		fmt.Fprintf(w, `{"page": %d}`, page)
+	}))
+	defer server.Close()
+	for i := 0; i < 2; i++ {
+		rec, err := Open(Config{Mode: RecordMode, Dir: dir})
+		if err != nil {
+			t.Fatal(err)
+		}
+		get(t, rec.Client(&http.Client{}), server.URL+"/feed")
+	}
+	// the second recording replaces the first one instead of being appended to it
+	rep, err := Open(Config{Mode: ReplayMode, Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := rep.Client(&http.Client{})
+	for i := 0; i < 2; i++ {
+		if _, body := get(t, cl, server.URL+"/feed"); body != `{"page": 2}` {
+			t.Errorf("Get() = %q, want the second page", body)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package instatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FirstTimestamp is the timestamp of the oldest post of every location, posts are a minute apart.
+const FirstTimestamp = 1500000000
+
+// Server is a fake Instagram which serves generated paginated location feeds. Location IDs are
+// mapped to numbers of their posts. The next requests are answered with 429 or 500 after
+// Throttle and Fail. The server works as an HTTP proxy to itself too, so its URL can be used as
+// the URL of a proxy.
+type Server struct {
+	*httptest.Server
+	mu        sync.Mutex
+	locations map[string]int
+	throttle  int
+	fail      int
+	requests  int
+	proxied   int
+}
+
+func NewServer(locations map[string]int) *Server {
+	s := &Server{locations: locations}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Throttle makes the server answer the next n requests with 429.
+func (s *Server) Throttle(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttle = n
+}
+
+// Fail makes the server answer the next n requests with 500, after throttled ones.
+func (s *Server) Fail(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = n
+}
+
+// Requests returns the number of requests to the server.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Proxied returns the number of requests which were sent to the server as to a proxy.
+func (s *Server) Proxied() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.proxied
+}
+
+// Transport returns the transport which sends requests to any host to the server, so sources
+// with Instagram URLs read from it.
+func (s *Server) Transport() http.RoundTripper {
+	return s.Wrap(s.Client().Transport)
+}
+
+// Wrap returns the transport which sends requests to any host to the server through next, e.g.
+// through the transport of a proxy. http.DefaultTransport is used if next is nil.
+func (s *Server) Wrap(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	u, _ := url.Parse(s.URL)
+	return &redirect{target: u, next: next}
+}
+
+type redirect struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (r *redirect) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+	req.Host = r.target.Host
+	return r.next.RoundTrip(req)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	// requests to proxies carry absolute URLs
+	if strings.HasPrefix(r.RequestURI, "http://") {
+		s.proxied++
+	}
+	code := 0
+	if s.throttle > 0 {
+		s.throttle--
+		code = http.StatusTooManyRequests
+	} else if s.fail > 0 {
+		s.fail--
+		code = http.StatusInternalServerError
+	}
+	s.mu.Unlock()
+	if code != 0 {
+		w.WriteHeader(code)
+		return
+	}
+	if r.URL.Path != "/graphql/query/" {
+		http.NotFound(w, r)
+		return
+	}
+	var vars struct {
+		ID    string `json:"id"`
+		First int    `json:"first"`
+		After string `json:"after"`
+	}
+	err := json.Unmarshal([]byte(r.URL.Query().Get("variables")), &vars)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	num, ok := s.locations[vars.ID]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(locationPage(vars.ID, num, vars.First, vars.After))
+}
+
+// PostID returns the ID of the i-th post of the location.
+func PostID(location string, i int) string {
+	return location + "_" + strconv.Itoa(i)
+}
+
+// locationPage returns the page of the location feed after the cursor. Feeds start from the newest
+// post, the cursor is the ID of the last post of the previous page.
+func locationPage(id string, num, first int, after string) map[string]interface{} {
+	if first <= 0 {
+		first = 50
+	}
+	start := num - 1
+	if after != "" {
+		i, err := strconv.Atoi(strings.TrimPrefix(after, id+"_"))
+		if err == nil {
+			start = i - 1
+		}
+	}
+	edges := []interface{}{}
+	i := start
+	for ; i >= 0 && len(edges) < first; i-- {
+		edges = append(edges, map[string]interface{}{"node": post(id, i)})
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"location": map[string]interface{}{
+				"id":   id,
+				"name": "Location " + id,
+				"slug": "location-" + id,
+				"lat":  59.9,
+				"lng":  30.3,
+				"edge_location_to_media": map[string]interface{}{
+					"page_info": map[string]interface{}{"has_next_page": i >= 0},
+					"edges":     edges,
+				},
+			},
+		},
+	}
+}
+
+func post(location string, i int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    PostID(location, i),
+		"shortcode":             fmt.Sprintf("c%v_%v", location, i),
+		"display_url":           "https://scontent.cdninstagram.com/" + PostID(location, i) + ".jpg",
+		"is_video":              false,
+		"edge_media_to_caption": map[string]interface{}{"edges": []interface{}{}},
+		"taken_at_timestamp":    FirstTimestamp + 60*i,
+		"edge_media_to_comment": map[string]interface{}{"count": i % 3},
+		"edge_liked_by":         map[string]interface{}{"count": i % 7},
+		"owner":                 map[string]interface{}{"id": "owner" + strconv.Itoa(i%5)},
+	}
+}
@@ -0,0 +1,99 @@
+package instatest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
+)
+
+// fetcher makes requests of the source to the server.
+type fetcher struct {
+	cl *http.Client
+}
+
+func (f fetcher) Get(url string, anonymous bool) ([]byte, error) {
+	resp, err := f.cl.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("response status %d", resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func TestServer(t *testing.T) {
+	s := NewServer(map[string]int{"1": 120})
+	defer s.Close()
+	f := fetcher{cl: &http.Client{Transport: s.Transport()}}
+	src := source.Instagram{}
+
+	seen := map[string]bool{}
+	pages := 0
+	cursor := ""
+	for {
+		raw, err := src.Fetch(f, "1", cursor)
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		page, err := src.Parse(raw)
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		pages++
+		for _, p := range page.Posts {
+			if seen[p.ID] {
+				t.Errorf("post %v is returned twice", p.ID)
+			}
+			seen[p.ID] = true
+		}
+		if !page.HasNext {
+			if page.Oldest != FirstTimestamp {
+				t.Errorf("oldest post timestamp = %v, want %v", page.Oldest, FirstTimestamp)
+			}
+			break
+		}
+		cursor = page.Cursor
+	}
+	if pages != 3 || len(seen) != 120 {
+		t.Errorf("got %v pages and %v posts, want 3 pages and 120 posts", pages, len(seen))
+	}
+
+	s.Throttle(1)
+	s.Fail(1)
+	for _, want := range []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusNotFound} {
+		resp, err := f.cl.Get("https://www.instagram.com/graphql/query/?variables=%7B%22id%22%3A%222%22%7D")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("status = %v, want %v", resp.StatusCode, want)
+		}
+	}
+	if s.Requests() != pages+3 {
+		t.Errorf("Requests() = %v, want %v", s.Requests(), pages+3)
+	}
+}
+
+func TestServer_Wrap(t *testing.T) {
+	s := NewServer(map[string]int{"1": 10})
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the server is the proxy of requests sent to it
+	f := fetcher{cl: &http.Client{Transport: s.Wrap(&http.Transport{Proxy: http.ProxyURL(u)})}}
+	if _, err := (source.Instagram{}).Fetch(f, "1", ""); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if s.Proxied() != 1 || s.Requests() != 1 {
+		t.Errorf("Proxied() = %v, Requests() = %v, want 1 and 1", s.Proxied(), s.Requests())
+	}
+}
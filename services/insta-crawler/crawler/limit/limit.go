@@ -0,0 +1,116 @@
+package limit
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Bucket is a token bucket limiter. Tokens are added at the rate per second up to the burst, every
+// request takes one token.
+type Bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	paused time.Time
+	now    func() time.Time
+	sleep  func(time.Duration)
+}
+
+// NewBucket returns the full bucket. The bucket doesn't limit requests if the rate isn't positive.
+func NewBucket(rate float64, burst int) *Bucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Bucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// Wait blocks until a token is available and takes it.
+func (b *Bucket) Wait() {
+	if d := b.reserve(); d > 0 {
+		b.sleep(d)
+	}
+}
+
+// Pause makes requests wait until the duration passes, tokens are still added meanwhile.
+func (b *Bucket) Pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := b.now().Add(d); until.After(b.paused) {
+		b.paused = until
+	}
+}
+
+// reserve takes a token and returns the time to wait until the token is available and the bucket
+// isn't paused.
+func (b *Bucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	var pause time.Duration
+	if b.paused.After(now) {
+		pause = b.paused.Sub(now)
+	}
+	if b.rate <= 0 {
+		return pause
+	}
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return pause
+	}
+	if d := time.Duration(-b.tokens / b.rate * float64(time.Second)); d > pause {
+		return d
+	}
+	return pause
+}
+
+// Backoff returns exponentially growing delays with jitter. Delays start from Min and are doubled
+// up to Max, the jitter is up to a half of the delay.
+type Backoff struct {
+	Min     time.Duration
+	Max     time.Duration
+	attempt uint
+}
+
+// Next returns the delay before the next attempt.
+func (b *Backoff) Next() time.Duration {
+	d := b.Min
+	for i := uint(0); i < b.attempt && d < b.Max; i++ {
+		d *= 2
+	}
+	if d > b.Max {
+		d = b.Max
+	}
+	b.attempt++
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Reset starts delays from Min again.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// Retryable checks if the request with the response status code should be retried after a delay,
+// which is the case for 429 and 5xx responses.
+func Retryable(code int) bool {
+	return code == 429 || code >= 500
+}
@@ -0,0 +1,83 @@
+package limit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucket_reserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBucket(2, 2)
+	b.now = func() time.Time { return now }
+	want := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i, w := range want {
+		if got := b.reserve(); got != w {
+			t.Errorf("reserve() #%d = %v, want %v", i, got, w)
+		}
+	}
+	// tokens are added with time, but not above the burst
+	now = now.Add(10 * time.Second)
+	want = []time.Duration{0, 0, 500 * time.Millisecond}
+	for i, w := range want {
+		if got := b.reserve(); got != w {
+			t.Errorf("reserve() after pause #%d = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestBucket_unlimited(t *testing.T) {
+	b := NewBucket(0, 1)
+	for i := 0; i < 10; i++ {
+		if got := b.reserve(); got != 0 {
+			t.Fatalf("reserve() = %v, want 0", got)
+		}
+	}
+}
+
+func TestBucket_Pause(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBucket(2, 2)
+	b.now = func() time.Time { return now }
+	b.Pause(3 * time.Second)
+	// a shorter pause doesn't cut the longer one
+	b.Pause(time.Second)
+	want := []time.Duration{3 * time.Second, 3 * time.Second, 3 * time.Second}
+	for i, w := range want {
+		if got := b.reserve(); got != w {
+			t.Errorf("reserve() #%d = %v, want %v", i, got, w)
+		}
+	}
+	now = now.Add(3 * time.Second)
+	if got := b.reserve(); got != 0 {
+		t.Errorf("reserve() after the pause = %v, want 0", got)
+	}
+	u := NewBucket(0, 1)
+	u.now = b.now
+	u.Pause(time.Second)
+	if got := u.reserve(); got != time.Second {
+		t.Errorf("reserve() of the unlimited bucket = %v, want %v", got, time.Second)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	b := Backoff{Min: time.Second, Max: 5 * time.Second}
+	bounds := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, max := range bounds {
+		got := b.Next()
+		if got < max/2 || got > max {
+			t.Errorf("Next() #%d = %v, want in [%v, %v]", i, got, max/2, max)
+		}
+	}
+	b.Reset()
+	if got := b.Next(); got > time.Second {
+		t.Errorf("Next() after Reset() = %v, want at most %v", got, time.Second)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	for code, want := range map[int]bool{200: false, 404: false, 429: true, 500: true, 503: true} {
+		if got := Retryable(code); got != want {
+			t.Errorf("Retryable(%d) = %v, want %v", code, got, want)
+		}
+	}
+}
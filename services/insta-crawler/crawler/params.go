@@ -1,19 +1,108 @@
 package crawler
 
 import (
+	"errors"
+	"math"
+	"strings"
+
 	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
 	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
 )
 
+// Parameters of a crawling session. Type is the type of crawled entities, sessions of the
+// locations type crawl Locations, sessions of the profiles type crawl Profiles, which are user
+// names, and sessions of the hashtags type crawl Hashtags, keeping only posts inside the area
+// between TopLeft and BottomRight. Locations are crawled if the type isn't set. Sessions of the
+// locations type with Discover set search for new locations in the area and crawl them too.
+// Source is the kind of the source of posts, Instagram is used if it is empty. FeedURL is the URL
+// template of the feed source. Sessions with higher Priority are crawled first in every cycle over
+// sessions of the thread. Offsets are cursors where the next pass over entities starts for sources
+// which tail their feeds, e.g. file drops.
 type Parameters struct {
+	Type            data.CrawlingType
 	CityID          string
 	InitCity        bool
 	TopLeft         protodata.Point
 	BottomRight     protodata.Point
 	Description     string
 	Locations       []data.Location
+	Profiles        []string
+	Hashtags        []string
+	Discover        bool
 	FinishTimestamp int64
 	DetailedPosts   bool
 	LoadMedia       bool
 	Checkpoints     map[string]string
+	Offsets         map[string]string
+	Source          string
+	FeedURL         string
+	Priority        int
+}
+
+var (
+	ErrCrawlingType = errors.New("crawling type is not supported")
+	ErrArea         = errors.New("area of the session is empty")
+)
+
+func (p Parameters) crawlingType() data.CrawlingType {
+	if p.Type == data.InvalidType {
+		return data.LocationsType
+	}
+	return p.Type
+}
+
+func (p Parameters) validate() error {
+	switch p.crawlingType() {
+	case data.LocationsType:
+		if p.Discover && (p.TopLeft.Lat == p.BottomRight.Lat || p.TopLeft.Lon == p.BottomRight.Lon) {
+			return ErrArea
+		}
+		return nil
+	case data.ProfilesType:
+		return nil
+	case data.HashtagsType:
+		if p.TopLeft.Lat == p.BottomRight.Lat || p.TopLeft.Lon == p.BottomRight.Lon {
+			return ErrArea
+		}
+		return nil
+	default:
+		return ErrCrawlingType
+	}
+}
+
+// entities returns IDs of the entities crawled in the session.
+func (p Parameters) entities() []string {
+	switch p.crawlingType() {
+	case data.ProfilesType:
+		return append([]string{}, p.Profiles...)
+	case data.HashtagsType:
+		res := make([]string, len(p.Hashtags))
+		for i := range p.Hashtags {
+			res[i] = strings.TrimPrefix(p.Hashtags[i], "#")
+		}
+		return res
+	}
+	res := make([]string, len(p.Locations))
+	for i := range p.Locations {
+		res[i] = p.Locations[i].ID
+	}
+	return res
+}
+
+// tails reports whether the source of the session tails feeds of entities.
+func (p Parameters) tails() bool {
+	src, err := source.New(p.Source, p.FeedURL)
+	if err != nil {
+		return false
+	}
+	t, ok := src.(source.Tailer)
+	return ok && t.Tails()
+}
+
+// inArea checks if the point is inside the area of the session.
+func (p Parameters) inArea(lat, lon float64) bool {
+	minLat, maxLat := math.Min(p.TopLeft.Lat, p.BottomRight.Lat), math.Max(p.TopLeft.Lat, p.BottomRight.Lat)
+	minLon, maxLon := math.Min(p.TopLeft.Lon, p.BottomRight.Lon), math.Max(p.TopLeft.Lon, p.BottomRight.Lon)
+	return lat >= minLat && lat <= maxLat && lon >= minLon && lon <= maxLon
 }
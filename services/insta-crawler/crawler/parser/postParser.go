@@ -109,14 +109,17 @@ func ParseFromPostRequest(input []byte) (data.Post, error) {
 	post.AuthorID = rawOwnerID.(string)
 
 	rawLocation, succeed := rawNode.(map[string]interface{})["location"]
-	if !succeed || rawLocation == nil {
+	if !succeed {
 		return data.Post{}, fmt.Errorf("Unable to get 'location' element")
 	}
-	rawLocationID, succeed := rawOwner.(map[string]interface{})["id"]
-	if !succeed || rawLocationID == nil {
-		return data.Post{}, fmt.Errorf("Unable to get 'id' element")
+	// posts without a location have the null location
+	if rawLocation != nil {
+		rawLocationID, succeed := rawLocation.(map[string]interface{})["id"]
+		if !succeed || rawLocationID == nil {
+			return data.Post{}, fmt.Errorf("Unable to get 'id' element")
+		}
+		post.LocationID = rawLocationID.(string)
 	}
-	post.LocationID = rawLocationID.(string)
 
 	rawTaggedNode, succeed := rawNode.(map[string]interface{})["edge_media_to_tagged_user"]
 	if !succeed || rawTaggedNode == nil {
@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+const postResponse = `{"graphql": {"shortcode_media": {
+	"id": "1", "shortcode": "abc", "display_url": "https://example.com/1.jpg", "is_video": false,
+	"edge_media_to_caption": {"edges": [{"node": {"text": "#spb"}}]},
+	"taken_at_timestamp": 1577836800,
+	"edge_media_to_comment": {"count": 2}, "edge_media_preview_like": {"count": 3},
+	"owner": {"id": "10"}, "location": LOCATION,
+	"edge_media_to_tagged_user": {"edges": []}}}}`
+
+func TestParseFromPostRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		want     string
+	}{
+		{"location", `{"id": "100", "name": "Hermitage"}`, "100"},
+		{"null location", "null", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := strings.Replace(postResponse, "LOCATION", tt.location, 1)
+			post, err := ParseFromPostRequest([]byte(raw))
+			if err != nil {
+				t.Fatalf("ParseFromPostRequest() error = %v", err)
+			}
+			if post.LocationID != tt.want || post.AuthorID != "10" || post.Shortcode != "abc" {
+				t.Errorf("ParseFromPostRequest() = %+v, want location %q", post, tt.want)
+			}
+		})
+	}
+}
@@ -93,20 +93,17 @@ func ParseFromProfileRequest(input []byte) ([]data.Post, data.Profile, string, b
 	if !succeed || rawPageInfo == nil {
 		return nil, data.Profile{}, "", false, timestamp, fmt.Errorf("Unable to get 'page_info' element")
 	}
-	// rawCursor, succeed := rawPageInfo.(map[string]interface{})["end_cursor"]
-	// if !succeed {
-	// 	return nil, data.Profile{}, "", false, timestamp, fmt.Errorf("Unable to get 'end_cursor' element: %s", string(input))
-	// }
 	rawNextPage, succeed := rawPageInfo.(map[string]interface{})["has_next_page"]
 	if !succeed || rawNextPage == nil {
 		return nil, data.Profile{}, "", false, timestamp, fmt.Errorf("Unable to get 'has_next_page' element")
 	}
 	nextPage := rawNextPage.(bool)
 	endCursor := ""
-	// endCursor := ""
-	// if rawCursor != nil {
-	// 	endCursor = rawCursor.(string)
-	// }
+	// profile feeds are paginated with the end cursor, the ID of the last post is used if there is no cursor
+	pageCursor := ""
+	if rawCursor, succeed := rawPageInfo.(map[string]interface{})["end_cursor"].(string); succeed {
+		pageCursor = rawCursor
+	}
 
 	rawEdgesArray, succeed := rawEdges.(map[string]interface{})["edges"]
 	if !succeed || rawEdgesArray == nil {
@@ -175,6 +172,18 @@ func ParseFromProfileRequest(input []byte) ([]data.Post, data.Profile, string, b
 			timestamp = timeInt
 			post.Timestamp = timeInt
 
+			if rawLocation, succeed := rawNode.(map[string]interface{})["location"].(map[string]interface{}); succeed {
+				if rawLocID, succeed := rawLocation["id"].(string); succeed {
+					post.LocationID = rawLocID
+				}
+				if rawLocLat, succeed := rawLocation["lat"].(float64); succeed {
+					post.Lat = rawLocLat
+				}
+				if rawLocLon, succeed := rawLocation["lng"].(float64); succeed {
+					post.Lon = rawLocLon
+				}
+			}
+
 			rawComment, succeed := rawNode.(map[string]interface{})["edge_media_to_comment"]
 			if !succeed || rawComment == nil {
 				continue
@@ -218,5 +227,8 @@ func ParseFromProfileRequest(input []byte) ([]data.Post, data.Profile, string, b
 		}
 
 	}
+	if pageCursor != "" {
+		endCursor = pageCursor
+	}
 	return posts, profile, endCursor, nextPage, timestamp, nil
 }
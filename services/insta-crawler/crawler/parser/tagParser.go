@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+func ParseFromTagRequest(input []byte) ([]data.Post, string, bool, int64, error) {
+	var d map[string]interface{}
+	var timestamp int64
+	err := json.Unmarshal(input, &d)
+	if err != nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to parse response: %s", err)
+	}
+	root, succeed := d["data"]
+	if !succeed || root == nil {
+		root, succeed = d["graphql"]
+		if !succeed || root == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'graphql' element: %s", string(input))
+		}
+	}
+	var rawEntity interface{}
+	rawEntity, succeed = root.(map[string]interface{})["hashtag"]
+	if !succeed || rawEntity == nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to get 'hashtag' element: %s", string(input))
+	}
+
+	var rawEdges interface{}
+	rawEdges, succeed = rawEntity.(map[string]interface{})["edge_hashtag_to_media"]
+	if !succeed || rawEdges == nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to get 'edge_hashtag_to_media' element")
+	}
+	rawPageInfo, succeed := rawEdges.(map[string]interface{})["page_info"]
+	if !succeed || rawPageInfo == nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to get 'page_info' element")
+	}
+	rawNextPage, succeed := rawPageInfo.(map[string]interface{})["has_next_page"]
+	if !succeed || rawNextPage == nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to get 'has_next_page' element")
+	}
+	nextPage := rawNextPage.(bool)
+	endCursor := ""
+	if rawCursor, succeed := rawPageInfo.(map[string]interface{})["end_cursor"].(string); succeed {
+		endCursor = rawCursor
+	}
+
+	rawEdgesArray, succeed := rawEdges.(map[string]interface{})["edges"]
+	if !succeed || rawEdgesArray == nil {
+		return nil, "", false, timestamp, fmt.Errorf("Unable to get 'edges' element")
+	}
+	edgesArray := rawEdgesArray.([]interface{})
+	posts := []data.Post{}
+	for _, edge := range edgesArray {
+		post := data.Post{}
+		rawNode, succeed := edge.(map[string]interface{})["node"]
+		if !succeed || rawNode == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'node' element")
+		}
+		rawID, succeed := rawNode.(map[string]interface{})["id"]
+		if !succeed || rawID == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'id' element")
+		}
+		post.ID = rawID.(string)
+
+		rawCode, succeed := rawNode.(map[string]interface{})["shortcode"]
+		if !succeed || rawCode == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'shortcode' element")
+		}
+		post.Shortcode = rawCode.(string)
+
+		rawURL, succeed := rawNode.(map[string]interface{})["display_url"]
+		if !succeed || rawURL == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'display_url' element")
+		}
+		post.ImageURL = rawURL.(string)
+
+		rawIsVideo, succeed := rawNode.(map[string]interface{})["is_video"]
+		if !succeed || rawIsVideo == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'is_video' element")
+		}
+		post.IsVideo = rawIsVideo.(bool)
+
+		rawCaptionNode, succeed := rawNode.(map[string]interface{})["edge_media_to_caption"]
+		if !succeed || rawCaptionNode == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'edge_media_to_caption' element")
+		}
+		rawCaptionEdges, succeed := rawCaptionNode.(map[string]interface{})["edges"]
+		if !succeed || rawCaptionEdges == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'edges' element")
+		}
+		captionEdgesArray := rawCaptionEdges.([]interface{})
+		if len(captionEdgesArray) > 0 {
+			rawCaptionEdge, succeed := captionEdgesArray[0].(map[string]interface{})["node"]
+			if !succeed || rawCaptionEdge == nil {
+				return nil, "", false, timestamp, fmt.Errorf("Unable to get 'node' element")
+			}
+			rawCaption, succeed := rawCaptionEdge.(map[string]interface{})["text"]
+			if !succeed || rawCaption == nil {
+				return nil, "", false, timestamp, fmt.Errorf("Unable to get 'text' element")
+			}
+			post.Caption = rawCaption.(string)
+		}
+
+		rawTimestamp, succeed := rawNode.(map[string]interface{})["taken_at_timestamp"]
+		if !succeed || rawTimestamp == nil {
+			return nil, "", false, timestamp, fmt.Errorf("Unable to get 'taken_at_timestamp' element")
+		}
+		timeString := rawTimestamp.(float64)
+		timeInt := int64(timeString)
+		timestamp = timeInt
+		post.Timestamp = timeInt
+
+		// tag feeds contain posts from any places, the location is set only for some of them
+		if rawLocation, succeed := rawNode.(map[string]interface{})["location"].(map[string]interface{}); succeed {
+			if rawLocID, succeed := rawLocation["id"].(string); succeed {
+				post.LocationID = rawLocID
+			}
+			if rawLocLat, succeed := rawLocation["lat"].(float64); succeed {
+				post.Lat = rawLocLat
+			}
+			if rawLocLon, succeed := rawLocation["lng"].(float64); succeed {
+				post.Lon = rawLocLon
+			}
+		}
+
+		if rawComment, succeed := rawNode.(map[string]interface{})["edge_media_to_comment"].(map[string]interface{}); succeed {
+			if rawCount, succeed := rawComment["count"].(float64); succeed {
+				post.CommentsCount = int(rawCount)
+			}
+		}
+		rawLikes, succeed := rawNode.(map[string]interface{})["edge_liked_by"].(map[string]interface{})
+		if !succeed {
+			rawLikes, _ = rawNode.(map[string]interface{})["edge_media_preview_like"].(map[string]interface{})
+		}
+		if rawCount, succeed := rawLikes["count"].(float64); succeed {
+			post.LikesCount = int(rawCount)
+		}
+		if rawOwner, succeed := rawNode.(map[string]interface{})["owner"].(map[string]interface{}); succeed {
+			if rawOwnerID, succeed := rawOwner["id"].(string); succeed {
+				post.AuthorID = rawOwnerID
+			}
+		}
+
+		posts = append(posts, post)
+	}
+	// the ID of the last post is used if there is no cursor
+	if endCursor == "" && len(posts) > 0 {
+		endCursor = posts[len(posts)-1].ID
+	}
+	return posts, endCursor, nextPage, timestamp, nil
+}
@@ -0,0 +1,280 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+	netproxy "golang.org/x/net/proxy"
+)
+
+// renewDelay is the minimal time between requests of new circuits of a Tor proxy, Tor ignores
+// more frequent requests.
+var renewDelay = 10 * time.Second
+
+var ErrProxyURL = errors.New("proxy URL must be a socks5, http or https URL")
+
+// Config of a proxy. URL is the address of a SOCKS5 or HTTP proxy, e.g. socks5://127.0.0.1:9050.
+// Control is the address of the control port of a Tor proxy, new circuits are requested through
+// it when the exit is throttled. Password authenticates on the control port.
+type Config struct {
+	URL      string
+	Control  string
+	Password string
+}
+
+// Stats of a proxy. Workers is the number of workers assigned to the proxy, Throttled is the
+// number of 429 and 403 responses and Renewals is the number of new Tor circuits.
+type Stats struct {
+	URL       string
+	Healthy   bool
+	Workers   int
+	Requests  int64
+	Successes int64
+	Throttled int64
+	Failures  int64
+	Renewals  int64
+}
+
+// Proxy is a proxy of the pool. A throttled or blocked proxy stays unhealthy until the time in
+// blocked passes and a health check succeeds, or until a new circuit is requested for it.
+type Proxy struct {
+	cfg      Config
+	client   *http.Client
+	stats    Stats
+	failures int
+	blocked  time.Time
+	renewed  time.Time
+	renewing bool
+}
+
+// Client returns the HTTP client which makes requests through the proxy.
+func (px *Proxy) Client() *http.Client {
+	return px.client
+}
+
+// Pool manages proxies shared by workers. Workers are assigned to the healthy proxy with the
+// fewest workers, proxies are marked unhealthy after maxFailures failed or throttled requests in a
+// row and become healthy again after a successful health check. Proxies which were marked
+// unhealthy because of 429 or 403 responses are not healthy before the cooldown passes.
+type Pool struct {
+	mu          sync.Mutex
+	proxies     []*Proxy
+	checkURL    string
+	maxFailures int
+	cooldown    time.Duration
+}
+
+func NewPool(cfgs []Config, checkURL string, maxFailures int, cooldown, timeout time.Duration) (*Pool, error) {
+	p := &Pool{
+		proxies:     make([]*Proxy, 0, len(cfgs)),
+		checkURL:    checkURL,
+		maxFailures: maxFailures,
+		cooldown:    cooldown,
+	}
+	for _, cfg := range cfgs {
+		client, err := newClient(cfg.URL, timeout)
+		if err != nil {
+			return nil, err
+		}
+		p.proxies = append(p.proxies, &Proxy{
+			cfg:    cfg,
+			client: client,
+			stats:  Stats{URL: cfg.URL, Healthy: true},
+		})
+	}
+	return p, nil
+}
+
+func newClient(proxyURL string, timeout time.Duration) (*http.Client, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	var transport *http.Transport
+	switch u.Scheme {
+	case "socks5":
+		dialer, err := netproxy.FromURL(u, netproxy.Direct)
+		if err != nil {
+			return nil, err
+		}
+		transport = &http.Transport{
+			Dial:                dialer.Dial,
+			MaxIdleConnsPerHost: 1,
+		}
+	case "http", "https":
+		transport = &http.Transport{
+			Proxy:               http.ProxyURL(u),
+			MaxIdleConnsPerHost: 1,
+		}
+	default:
+		return nil, ErrProxyURL
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+// Acquire releases the previous proxy of the worker, which may be nil, and returns the healthy
+// proxy with the fewest workers. The previous proxy is kept if it is still the best one. If all
+// proxies are unhealthy, the proxy with the fewest workers is returned. Acquire returns nil if
+// the pool is empty.
+func (p *Pool) Acquire(prev *Proxy) *Proxy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if prev != nil {
+		prev.stats.Workers--
+	}
+	var best *Proxy
+	for _, px := range p.proxies {
+		if best == nil || better(px, best, prev) {
+			best = px
+		}
+	}
+	if best != nil {
+		best.stats.Workers++
+	}
+	return best
+}
+
+func better(a, b, prev *Proxy) bool {
+	if a.stats.Healthy != b.stats.Healthy {
+		return a.stats.Healthy
+	}
+	if a.stats.Workers != b.stats.Workers {
+		return a.stats.Workers < b.stats.Workers
+	}
+	return a == prev
+}
+
+// Healthy checks if the proxy can be used.
+func (p *Pool) Healthy(px *Proxy) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return px.stats.Healthy
+}
+
+// Report updates statistics of the proxy with the result of the request made through it, code is
+// the status code of the response. A new circuit is requested if a Tor proxy is throttled.
+func (p *Pool) Report(px *Proxy, code int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	px.stats.Requests++
+	throttled := false
+	switch {
+	case err != nil:
+		px.stats.Failures++
+		px.failures++
+	case throttledStatus(code):
+		throttled = true
+		px.stats.Throttled++
+		px.failures++
+		if px.cfg.Control != "" && !px.renewing && time.Since(px.renewed) >= renewDelay {
+			px.renewing = true
+			go p.renew(px)
+		}
+	case code >= 500:
+		px.stats.Failures++
+	default:
+		px.stats.Successes++
+		px.failures = 0
+	}
+	if p.maxFailures > 0 && px.failures >= p.maxFailures && px.stats.Healthy {
+		px.stats.Healthy = false
+		if throttled {
+			px.blocked = time.Now().Add(p.cooldown)
+		}
+		unilog.Logger().Warn("proxy is unhealthy", zap.String("proxy", px.cfg.URL), zap.Int("failures", px.failures))
+	}
+}
+
+// throttledStatus checks if the response status code means that requests through the proxy are
+// throttled or blocked.
+func throttledStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusForbidden
+}
+
+func (p *Pool) renew(px *Proxy) {
+	err := NewIdentity(px.cfg.Control, px.cfg.Password)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	px.renewing = false
+	px.renewed = time.Now()
+	if err != nil {
+		unilog.Logger().Error("unable to request new circuit", zap.String("proxy", px.cfg.URL), zap.Error(err))
+		return
+	}
+	px.stats.Renewals++
+	px.failures = 0
+	px.blocked = time.Time{}
+	px.stats.Healthy = true
+	unilog.Logger().Info("requested new circuit", zap.String("proxy", px.cfg.URL))
+}
+
+// Check makes the health check request through every proxy. Proxies which respond without errors
+// and throttling are healthy after their cooldown.
+func (p *Pool) Check() {
+	p.mu.Lock()
+	proxies := append([]*Proxy{}, p.proxies...)
+	p.mu.Unlock()
+	var wg sync.WaitGroup
+	for _, px := range proxies {
+		wg.Add(1)
+		go func(px *Proxy) {
+			defer wg.Done()
+			err := p.check(px)
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			healthy := err == nil && !time.Now().Before(px.blocked)
+			if healthy != px.stats.Healthy {
+				unilog.Logger().Info("proxy health changed", zap.String("proxy", px.cfg.URL),
+					zap.Bool("healthy", healthy), zap.Error(err))
+			}
+			px.stats.Healthy = healthy
+			if healthy {
+				px.failures = 0
+			}
+		}(px)
+	}
+	wg.Wait()
+}
+
+func (p *Pool) check(px *Proxy) error {
+	resp, err := px.client.Get(p.checkURL)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 500 || throttledStatus(resp.StatusCode) {
+		return errors.New(resp.Status)
+	}
+	return nil
+}
+
+// Run checks proxies with the interval until the context is canceled.
+func (p *Pool) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		p.Check()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Stats returns statistics of all proxies of the pool.
+func (p *Pool) Stats() []Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res := make([]Stats, len(p.proxies))
+	for i, px := range p.proxies {
+		res[i] = px.stats
+	}
+	return res
+}
@@ -0,0 +1,168 @@
+package proxy
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool_Acquire(t *testing.T) {
+	p, err := NewPool([]Config{{URL: "socks5://127.0.0.1:9050"}, {URL: "http://127.0.0.1:3128"}}, "", 2, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("NewPool() error = %v", err)
+	}
+	a := p.Acquire(nil)
+	b := p.Acquire(nil)
+	if a == b {
+		t.Fatalf("Acquire() returned the same proxy for two workers")
+	}
+	// the worker keeps its proxy while it is as good as others
+	if got := p.Acquire(a); got != a {
+		t.Errorf("Acquire() = %v, want the previous proxy", got.cfg.URL)
+	}
+	p.Report(a, http.StatusTooManyRequests, nil)
+	p.Report(a, 0, net.ErrWriteToConnected)
+	if p.Healthy(a) {
+		t.Fatalf("Healthy() = true after failures")
+	}
+	// the worker of the unhealthy proxy is moved to the healthy one
+	if got := p.Acquire(a); got != b {
+		t.Errorf("Acquire() = %v, want %v", got.cfg.URL, b.cfg.URL)
+	}
+	stats := p.Stats()
+	if stats[0].Workers != 0 || stats[1].Workers != 2 {
+		t.Errorf("Stats() workers = %d, %d, want 0, 2", stats[0].Workers, stats[1].Workers)
+	}
+	if stats[0].Requests != 2 || stats[0].Throttled != 1 || stats[0].Failures != 1 || stats[0].Healthy {
+		t.Errorf("Stats() = %+v, want 2 requests, 1 throttled and 1 failure", stats[0])
+	}
+}
+
+func TestPool_Check(t *testing.T) {
+	// the test server works as an HTTP proxy which responds to all requests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	p, err := NewPool([]Config{{URL: server.URL}, {URL: "http://127.0.0.1:1"}}, "http://check.invalid/", 1, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("NewPool() error = %v", err)
+	}
+	p.proxies[0].stats.Healthy = false
+	p.Check()
+	stats := p.Stats()
+	if !stats[0].Healthy || stats[1].Healthy {
+		t.Errorf("Stats() health = %v, %v, want true, false", stats[0].Healthy, stats[1].Healthy)
+	}
+	if _, err := NewPool([]Config{{URL: "ftp://host"}}, "", 1, time.Minute, time.Second); err != ErrProxyURL {
+		t.Errorf("NewPool() error = %v, want %v", err, ErrProxyURL)
+	}
+}
+
+func TestPool_Check_cooldown(t *testing.T) {
+	code := int32(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&code)))
+	}))
+	defer server.Close()
+	p, err := NewPool([]Config{{URL: server.URL}}, "http://check.invalid/", 1, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("NewPool() error = %v", err)
+	}
+	px := p.Acquire(nil)
+	p.Report(px, http.StatusForbidden, nil)
+	// the health check doesn't clear the mark of the blocked proxy before the cooldown
+	p.Check()
+	if p.Healthy(px) {
+		t.Fatalf("Healthy() = true before the cooldown passed")
+	}
+	px.blocked = time.Now().Add(-time.Second)
+	p.Check()
+	if !p.Healthy(px) {
+		t.Fatalf("Healthy() = false after the cooldown passed")
+	}
+	atomic.StoreInt32(&code, http.StatusTooManyRequests)
+	p.Check()
+	if p.Healthy(px) {
+		t.Errorf("Healthy() = true after the throttled health check")
+	}
+}
+
+// controlServer accepts one connection of the Tor control protocol and records commands.
+func controlServer(t *testing.T, password string) (string, chan []string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := make(chan []string, 1)
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		got := []string{}
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				break
+			}
+			line = strings.TrimSpace(line)
+			got = append(got, line)
+			if line == "QUIT" {
+				break
+			}
+			if strings.HasPrefix(line, "AUTHENTICATE") && line != "AUTHENTICATE \""+password+"\"" {
+				conn.Write([]byte("515 Authentication failed\r\n"))
+				break
+			}
+			conn.Write([]byte("250 OK\r\n"))
+		}
+		cmds <- got
+	}()
+	return l.Addr().String(), cmds
+}
+
+func TestNewIdentity(t *testing.T) {
+	addr, cmds := controlServer(t, "pwd")
+	if err := NewIdentity(addr, "pwd"); err != nil {
+		t.Fatalf("NewIdentity() error = %v", err)
+	}
+	got := strings.Join(<-cmds, "; ")
+	if want := `AUTHENTICATE "pwd"; SIGNAL NEWNYM; QUIT`; got != want {
+		t.Errorf("commands = %v, want %v", got, want)
+	}
+
+	addr, _ = controlServer(t, "pwd")
+	if err := NewIdentity(addr, "wrong"); err == nil {
+		t.Errorf("NewIdentity() error = nil, want authentication error")
+	}
+}
+
+func TestPool_Report_renew(t *testing.T) {
+	addr, cmds := controlServer(t, "")
+	p, err := NewPool([]Config{{URL: "socks5://127.0.0.1:9050", Control: addr}}, "", 1, time.Minute, time.Second)
+	if err != nil {
+		t.Fatalf("NewPool() error = %v", err)
+	}
+	px := p.Acquire(nil)
+	p.Report(px, http.StatusTooManyRequests, nil)
+	select {
+	case <-cmds:
+	case <-time.After(5 * time.Second):
+		t.Fatal("new circuit was not requested")
+	}
+	for i := 0; i < 50 && p.Stats()[0].Renewals == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if st := p.Stats()[0]; st.Renewals != 1 || !st.Healthy {
+		t.Errorf("Stats() = %+v, want 1 renewal and the healthy proxy", st)
+	}
+}
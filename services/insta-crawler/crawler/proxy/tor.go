@@ -0,0 +1,40 @@
+package proxy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// NewIdentity asks Tor to switch to new circuits with the NEWNYM signal on the control port at
+// the address.
+func NewIdentity(address, password string) error {
+	conn, err := net.DialTimeout("tcp", address, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	r := bufio.NewReader(conn)
+	cmds := []string{
+		fmt.Sprintf("AUTHENTICATE %q", password),
+		"SIGNAL NEWNYM",
+	}
+	for _, cmd := range cmds {
+		_, err = fmt.Fprintf(conn, "%s\r\n", cmd)
+		if err != nil {
+			return err
+		}
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(line, "250") {
+			return fmt.Errorf("tor control: %s", strings.TrimSpace(line))
+		}
+	}
+	fmt.Fprint(conn, "QUIT\r\n")
+	return nil
+}
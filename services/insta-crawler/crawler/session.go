@@ -1,25 +1,33 @@
 package crawler
 
 import (
+	"encoding/json"
 	"os"
 	"path"
 
 	"github.com/BurntSushi/toml"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
 )
 
 const (
-	sessPath = "session.toml"
+	storePath = "sessions.db"
 )
 
+// Session is a crawling session. finished contains entities which were read to the end in the
+// current pass over entities, edits are changes of parameters which are applied when the thread
+// starts the next round of the session.
 type Session struct {
-	ID     string
-	Params Parameters
-	Status *Status
+	ID         string
+	Params     Parameters
+	Status     *Status
+	discovered *discovered
+	finished   map[string]bool
+	edits      []Edit
 }
 
-func newSession(id string, p Parameters, rootDir string) (Session, error) {
+func newSession(id string, p Parameters, rootDir string, st *store.Store) (Session, error) {
 	sPath := path.Join(rootDir, id)
 	err := os.MkdirAll(sPath, 0777)
 	if err != nil {
@@ -33,44 +41,167 @@ func newSession(id string, p Parameters, rootDir string) (Session, error) {
 			Status: RunningStatus,
 		},
 	}
-	sess.Status.EntitiesLeft = len(p.Locations)
-	err = sess.dump(rootDir)
+	sess.Status.EntitiesLeft = len(p.entities())
+	if p.Discover {
+		sess.discovered = newDiscovered(p.Locations)
+	}
+	err = sess.save(st)
 	if err != nil {
 		return Session{}, err
 	}
 	return sess, nil
 }
 
-func readSession(id, rootDir string) (sess Session, err error) {
-	p := path.Join(rootDir, id)
-	_, err = toml.DecodeFile(p, &sess)
+// readSession reads the session from the TOML file written by old versions of the crawler.
+func readSession(fpath string) (sess Session, err error) {
+	_, err = toml.DecodeFile(fpath, &sess)
 	if err != nil {
-		unilog.Logger().Error("unable to read session file", zap.String("path", p), zap.Error(err))
+		unilog.Logger().Error("unable to read session file", zap.String("path", fpath), zap.Error(err))
 	}
 	return
 }
 
-func (s Session) dump(rootDir string) error {
-	fpath := path.Join(rootDir, s.ID+".toml")
-	f, err := os.Create(fpath)
+// loadSession restores the session from the store record and checkpoints of its entities.
+func loadSession(r store.Session, cps map[string]store.Checkpoint) (Session, error) {
+	var p Parameters
+	err := json.Unmarshal(r.Params, &p)
 	if err != nil {
-		unilog.Logger().Error("unable to create session dump file", zap.String("path", fpath), zap.Error(err))
-		return err
+		return Session{}, err
+	}
+	p.Checkpoints = map[string]string{}
+	finished := map[string]bool{}
+	tails := p.tails()
+	for id, cp := range cps {
+		if cp.Finished {
+			finished[id] = true
+			// the offset is committed with the last page before the session record is saved
+			if tails && cp.Cursor != "" {
+				if p.Offsets == nil {
+					p.Offsets = map[string]string{}
+				}
+				p.Offsets[id] = cp.Cursor
+			}
+		} else if cp.Cursor != "" {
+			p.Checkpoints[id] = cp.Cursor
+		}
+	}
+	sess := Session{
+		ID:     r.ID,
+		Params: p,
+		Status: &Status{
+			Status:          StatusType(r.Status),
+			EntitiesLeft:    r.Counters.EntitiesLeft,
+			PostsCollected:  r.Counters.PostsCollected,
+			PostsTotal:      r.Counters.PostsTotal,
+			FinishTimestamp: r.Counters.FinishTimestamp,
+			PassStart:       r.Counters.PassStart,
+			LocationPosts:   r.Counters.LocationPosts,
+		},
+		finished: finished,
 	}
-	defer f.Close()
-	err = toml.NewEncoder(f).Encode(s)
+	if p.Discover {
+		sess.discovered = newDiscovered(p.Locations)
+	}
+	return sess, nil
+}
+
+// record returns the store record of the session. Checkpoints are committed separately with
+// every page.
+func (s *Session) record() (store.Session, error) {
+	p := s.Params
+	p.Checkpoints = nil
+	d, err := json.Marshal(p)
 	if err != nil {
-		unilog.Logger().Error("unable to encode session", zap.Any("session", s), zap.Error(err))
-		return err
+		return store.Session{}, err
+	}
+	return store.Session{
+		ID:       s.ID,
+		Params:   d,
+		Status:   int(s.Status.status()),
+		Counters: s.Status.counters(),
+	}, nil
+}
+
+// save writes the session to the store, deleted sessions are skipped.
+func (s *Session) save(st *store.Store) error {
+	if s.Status.removed() {
+		return nil
+	}
+	r, err := s.record()
+	if err == nil {
+		err = st.PutSession(r)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to save session", zap.String("id", s.ID), zap.Error(err))
+	}
+	return err
+}
+
+// removeEntities saves the session and removes checkpoints of the removed entities.
+func (s *Session) removeEntities(st *store.Store, ids []string) error {
+	if s.Status.removed() {
+		return nil
+	}
+	r, err := s.record()
+	if err == nil {
+		err = st.RemoveEntities(r, ids)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to save session", zap.String("id", s.ID), zap.Error(err))
+	}
+	return err
+}
+
+// finishPass saves the session and removes checkpoints of its entities.
+func (s *Session) finishPass(st *store.Store) error {
+	s.finished = nil
+	if s.Status.removed() {
+		return nil
+	}
+	r, err := s.record()
+	if err == nil {
+		err = st.FinishPass(r)
+	}
+	if err != nil {
+		unilog.Logger().Error("unable to save session", zap.String("id", s.ID), zap.Error(err))
+	}
+	return err
+}
+
+// pending returns IDs of entities which are not read to the end in the current pass.
+func (s *Session) pending() []string {
+	ids := s.Params.entities()
+	if len(s.finished) == 0 {
+		return ids
+	}
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !s.finished[id] {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+func (s *Session) markFinished(id string) {
+	if s.finished == nil {
+		s.finished = map[string]bool{}
 	}
-	return nil
+	s.finished[id] = true
 }
 
-func (s Session) status() OutStatus {
-	return s.Status.get()
+func (s *Session) status() OutStatus {
+	st := s.Status.get()
+	st.ID = s.ID
+	st.CityID = s.Params.CityID
+	st.Type = s.Params.crawlingType().String()
+	st.Priority = s.Params.Priority
+	return st
 }
 
 func (s *Session) stop() (bool, error) {
+	s.Status.mu.Lock()
+	defer s.Status.mu.Unlock()
 	s.Status.Status = FinishedStatus
 	return true, nil
 }
@@ -1,36 +1,59 @@
 package crawler
 
-import "testing"
+import (
+	"os"
+	"reflect"
+	"testing"
 
-func Test_convertSession(t *testing.T) {
-	type args struct {
-		sess    Session
-		rootDir string
-	}
-	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
-	}{
-		{
-			name: "simple test",
-			args: args{
-				sess: Session{
-					ID: "test session",
-					Params: Parameters{
-						CityID:   "spb",
-						Entities: []string{"123", "456"},
-					},
-				},
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if err := convertSession(tt.args.sess, tt.args.rootDir); (err != nil) != tt.wantErr {
-				t.Errorf("convertSession() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
+)
+
+func TestLoadSession(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	p := Parameters{
+		CityID:    "spb",
+		Locations: []data.Location{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+		Source:    "feed",
+		FeedURL:   "file:///drop/{id}.ndjson",
+	}
+	sess, err := newSession("s1", p, dir, st)
+	if err != nil {
+		t.Fatalf("newSession() error = %v", err)
+	}
+	sess.Status.updatePostsCollected(10)
+	if err := st.CommitPage("s1", "1", store.Checkpoint{Cursor: "c1"}, sess.Status.counters()); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CommitPage("s1", "2", store.Checkpoint{Cursor: "42", Finished: true}, sess.Status.counters()); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := st.Session("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cps, err := st.Checkpoints("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := loadSession(r, cps)
+	if err != nil {
+		t.Fatalf("loadSession() error = %v", err)
+	}
+	if want := map[string]string{"1": "c1"}; !reflect.DeepEqual(got.Params.Checkpoints, want) {
+		t.Errorf("Checkpoints = %v, want %v", got.Params.Checkpoints, want)
+	}
+	// the file drop continues the finished location from its offset in the next pass
+	if want := map[string]string{"2": "42"}; !reflect.DeepEqual(got.Params.Offsets, want) {
+		t.Errorf("Offsets = %v, want %v", got.Params.Offsets, want)
+	}
+	if want := []string{"1", "3"}; !reflect.DeepEqual(got.pending(), want) {
+		t.Errorf("pending() = %v, want %v", got.pending(), want)
+	}
+	if got.Status.PostsTotal != 10 || got.Status.EntitiesLeft != 3 || !got.Status.running() {
+		t.Errorf("Status = %+v, want running with 10 posts and 3 entities", got.Status.get())
 	}
 }
@@ -0,0 +1,227 @@
+package source
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+// feedPageSize is the number of lines of a file drop returned in one page.
+const feedPageSize = 500
+
+var ErrFeedURL = errors.New("feed URL must be an http, https or file URL")
+
+// Feed collects geotagged posts from a generic feed. The feed URL is a template where {id} is
+// replaced with the entity ID and {cursor} with the cursor of the page. HTTP feeds return either
+// a JSON page
+//
+//	{"location": {...}, "posts": [...], "cursor": "...", "has_next": true}
+//
+// or posts as NDJSON, which is a single page. A file URL points to a file drop with posts as
+// NDJSON, it is read in pages of lines and the cursor is the offset of the next line.
+type Feed struct {
+	url  string
+	file bool
+}
+
+type feedPage struct {
+	Location *feedLocation `json:"location"`
+	Posts    []feedPost    `json:"posts"`
+	Cursor   string        `json:"cursor"`
+	HasNext  bool          `json:"has_next"`
+}
+
+type feedLocation struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	Lat   float64 `json:"lat"`
+	Lon   float64 `json:"lon"`
+	Slug  string  `json:"slug"`
+}
+
+type feedPost struct {
+	ID         string  `json:"id"`
+	Shortcode  string  `json:"shortcode"`
+	ImageURL   string  `json:"image_url"`
+	IsVideo    bool    `json:"is_video"`
+	Caption    string  `json:"caption"`
+	Comments   int     `json:"comments"`
+	Likes      int     `json:"likes"`
+	Timestamp  int64   `json:"timestamp"`
+	AuthorID   string  `json:"author_id"`
+	LocationID string  `json:"location_id"`
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+}
+
+func NewFeed(feedURL string) (Feed, error) {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return Feed{}, err
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return Feed{url: feedURL}, nil
+	case "file":
+		return Feed{url: strings.TrimPrefix(feedURL, "file://"), file: true}, nil
+	default:
+		return Feed{}, ErrFeedURL
+	}
+}
+
+func (fd Feed) Fetch(f Fetcher, id, cursor string) ([]byte, error) {
+	if fd.file {
+		return fd.readFile(strings.Replace(fd.url, "{id}", id, -1), cursor)
+	}
+	r := strings.NewReplacer("{id}", url.QueryEscape(id), "{cursor}", url.QueryEscape(cursor))
+	return f.Get(r.Replace(fd.url), false)
+}
+
+// readFile returns the page of the file drop starting at the offset as a JSON page.
+func (fd Feed) readFile(path, cursor string) ([]byte, error) {
+	var offset int64
+	if cursor != "" {
+		var err error
+		offset, err = strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(file)
+	lines := [][]byte{}
+	hasNext := false
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// an incomplete line is still being written, it will be read with the next page
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(len(line))
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, line)
+		}
+		if len(lines) == feedPageSize {
+			_, err = r.Peek(1)
+			hasNext = err == nil
+			break
+		}
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"posts":[`)
+	buf.Write(bytes.Join(lines, []byte(",")))
+	buf.WriteString(`],"cursor":"` + strconv.FormatInt(offset, 10) + `","has_next":` + strconv.FormatBool(hasNext) + `}`)
+	return buf.Bytes(), nil
+}
+
+// Tails reports whether the feed is a file drop, which is only appended to.
+func (fd Feed) Tails() bool {
+	return fd.file
+}
+
+func (Feed) Parse(raw []byte) (Page, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	var first json.RawMessage
+	err := dec.Decode(&first)
+	if err == io.EOF {
+		return Page{Posts: []data.Post{}}, nil
+	}
+	if err != nil {
+		return Page{}, err
+	}
+	if !dec.More() {
+		var fp feedPage
+		err = json.Unmarshal(first, &fp)
+		if err == nil && fp.Posts != nil {
+			return fp.page(), nil
+		}
+	}
+	fp := feedPage{Posts: []feedPost{}}
+	for msg := first; ; {
+		var p feedPost
+		err = json.Unmarshal(msg, &p)
+		if err != nil {
+			return Page{}, err
+		}
+		fp.Posts = append(fp.Posts, p)
+		msg = nil
+		err = dec.Decode(&msg)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Page{}, err
+		}
+	}
+	return fp.page(), nil
+}
+
+// page converts the feed page. Posts without coordinates get the coordinates of the location.
+func (fp feedPage) page() Page {
+	page := Page{
+		Posts:   make([]data.Post, 0, len(fp.Posts)),
+		Cursor:  fp.Cursor,
+		HasNext: fp.HasNext,
+	}
+	var loc *data.Location
+	if fp.Location != nil {
+		l := fp.Location
+		loc = &data.Location{ID: l.ID, Title: l.Title, Lat: l.Lat, Lon: l.Lon, Slug: l.Slug}
+		page.Entity = loc
+	}
+	sorted := true
+	for i, p := range fp.Posts {
+		post := data.Post{
+			ID:            p.ID,
+			Shortcode:     p.Shortcode,
+			ImageURL:      p.ImageURL,
+			IsVideo:       p.IsVideo,
+			Caption:       p.Caption,
+			CommentsCount: p.Comments,
+			Timestamp:     p.Timestamp,
+			LikesCount:    p.Likes,
+			AuthorID:      p.AuthorID,
+			LocationID:    p.LocationID,
+			Lat:           p.Lat,
+			Lon:           p.Lon,
+		}
+		if post.Shortcode == "" {
+			post.Shortcode = post.ID
+		}
+		if loc != nil {
+			if post.LocationID == "" {
+				post.LocationID = loc.ID
+			}
+			if post.Lat == 0 && post.Lon == 0 && post.LocationID == loc.ID {
+				post.Lat, post.Lon = loc.Lat, loc.Lon
+			}
+		}
+		if i > 0 && post.Timestamp > page.Posts[i-1].Timestamp {
+			sorted = false
+		}
+		page.Posts = append(page.Posts, post)
+	}
+	if sorted && len(page.Posts) > 0 {
+		page.Oldest = page.Posts[len(page.Posts)-1].Timestamp
+	}
+	return page
+}
@@ -0,0 +1,153 @@
+package source
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+type httpFetcher struct{}
+
+func (httpFetcher) Get(url string, _ bool) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// crawl reads all pages of the entity feed.
+func crawl(t *testing.T, src Source, id string) []Page {
+	pages := []Page{}
+	cursor := ""
+	for {
+		raw, err := src.Fetch(httpFetcher{}, id, cursor)
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		page, err := src.Parse(raw)
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		pages = append(pages, page)
+		if !page.HasNext || len(pages) > 10 {
+			return pages
+		}
+		cursor = page.Cursor
+	}
+}
+
+func TestFeed_http(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") != "42" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{"location": {"id": "42", "title": "Park", "lat": 59.9, "lon": 30.3},
+				"posts": [{"id": "p3", "timestamp": 300, "caption": "#park"}, {"id": "p2", "timestamp": 200, "lat": 59.8, "lon": 30.2}],
+				"cursor": "p2", "has_next": true}`))
+		case "p2":
+			w.Write([]byte(`{"id": "p1", "timestamp": 100, "location_id": "42", "author_id": "a"}
+{"id": "p0", "timestamp": 150, "location_id": "43"}
+`))
+		}
+	}))
+	defer server.Close()
+	src, err := New(FeedKind, server.URL+"/feed?id={id}&after={cursor}")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got := crawl(t, src, "42")
+	want := []Page{
+		{
+			Posts: []data.Post{
+				{ID: "p3", Shortcode: "p3", Timestamp: 300, Caption: "#park", LocationID: "42", Lat: 59.9, Lon: 30.3},
+				{ID: "p2", Shortcode: "p2", Timestamp: 200, LocationID: "42", Lat: 59.8, Lon: 30.2},
+			},
+			Entity:  &data.Location{ID: "42", Title: "Park", Lat: 59.9, Lon: 30.3},
+			Cursor:  "p2",
+			HasNext: true,
+			Oldest:  200,
+		},
+		{
+			Posts: []data.Post{
+				{ID: "p1", Shortcode: "p1", Timestamp: 100, LocationID: "42", AuthorID: "a"},
+				{ID: "p0", Shortcode: "p0", Timestamp: 150, LocationID: "43"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("crawl() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFeed_file(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "42.ndjson")
+	err = ioutil.WriteFile(path, []byte("{\"id\": \"p1\", \"timestamp\": 100}\n\n{\"id\": \"p2\", \"timestamp\": 200}\n{\"id\": \"p3\""), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := New(FeedKind, "file://"+filepath.Join(dir, "{id}.ndjson"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got := crawl(t, src, "42")
+	want := []Page{{
+		Posts: []data.Post{
+			{ID: "p1", Shortcode: "p1", Timestamp: 100},
+			{ID: "p2", Shortcode: "p2", Timestamp: 200},
+		},
+		Cursor: "63",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("crawl() = %+v, want %+v", got, want)
+	}
+
+	// the incomplete line is read after it is finished
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(", \"timestamp\": 300}\n")
+	f.Close()
+	raw, err := src.Fetch(httpFetcher{}, "42", "63")
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	page, err := src.Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(page.Posts) != 1 || page.Posts[0].ID != "p3" || page.Cursor != "94" {
+		t.Errorf("Parse() = %+v, want post p3 and cursor 94", page)
+	}
+	if tl, ok := src.(Tailer); !ok || !tl.Tails() {
+		t.Errorf("file drop doesn't tail its feed")
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("twitter", ""); err != ErrUnknownSource {
+		t.Errorf("New() error = %v, want %v", err, ErrUnknownSource)
+	}
+	if _, err := New(FeedKind, "ftp://host/feed"); err != ErrFeedURL {
+		t.Errorf("New() error = %v, want %v", err, ErrFeedURL)
+	}
+	if src, err := New("", ""); err != nil || src != (Instagram{}) {
+		t.Errorf("New() = %v, %v, want Instagram", src, err)
+	}
+}
@@ -0,0 +1,160 @@
+package source
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/parser"
+)
+
+// locationURL is the address of the graphql query for location feeds, Fetch appends the query
+// variables to it.
+var locationURL = "https://www.instagram.com/graphql/query/?query_hash=1b84447a4d8b6d6d0426fefb34514485&variables="
+
+// profileURL is the address of profile pages, FetchProfile appends the user name to it.
+var profileURL = "https://www.instagram.com/"
+
+// profileFeedURL is the address of the graphql query for profile feeds, FetchProfile appends the
+// query variables to it.
+var profileFeedURL = "https://www.instagram.com/graphql/query/?query_hash=e769aa130647d2354c40ea6a439bfc08&variables="
+
+// tagURL is the address of the graphql query for tag feeds, FetchTag appends the query variables
+// to it.
+var tagURL = "https://www.instagram.com/graphql/query/?query_hash=174a5243287c5f3a7de741089750ab3b&variables="
+
+// searchURL is the address of the location search, SearchLocations appends the coordinates to it.
+var searchURL = "https://www.instagram.com/location_search/"
+
+// postURL is the address of post pages, Instagram.DetailedPost appends the shortcode to it.
+var postURL = "https://www.instagram.com/Params/"
+
+var ErrCursor = errors.New("invalid cursor of the profile feed")
+
+// Instagram collects posts from location, profile and tag feeds of Instagram.
+type Instagram struct{}
+
+func (Instagram) Fetch(f Fetcher, id, cursor string) ([]byte, error) {
+	id = url.QueryEscape(id)
+	var req string
+	if cursor == "" {
+		req = locationURL + "%7B%22id%22%3A%22" + id + "%22%2C%22first%22%3A50%7D"
+	} else {
+		req = locationURL + "%7B%22id%22%3A%22" + id + "%22%2C%22first%22%3A50%2C%22after%22%3A%22" + cursor + "%22%7D"
+	}
+	return f.Get(req, true)
+}
+
+func (Instagram) Parse(raw []byte) (Page, error) {
+	posts, location, cursor, hasNext, timestamp, err := parser.ParseFromLocationRequest(raw)
+	if err != nil {
+		return Page{}, err
+	}
+	return Page{
+		Posts:   posts,
+		Entity:  &location,
+		Cursor:  cursor,
+		HasNext: hasNext,
+		Oldest:  timestamp,
+	}, nil
+}
+
+func (Instagram) DetailedPost(f Fetcher, post data.Post) (data.Post, error) {
+	raw, err := f.Get(postURL+post.Shortcode+"?__a=1", false)
+	if err != nil {
+		return data.Post{}, err
+	}
+	return parser.ParseFromPostRequest(raw)
+}
+
+// FetchProfile loads the profile page with the first posts of the feed, next pages are loaded
+// with the graphql query which needs the profile ID. So the cursor of profile feeds consists of
+// the profile ID and the end cursor of the page separated by a colon.
+func (Instagram) FetchProfile(f Fetcher, username, cursor string) ([]byte, error) {
+	if cursor == "" {
+		return f.Get(profileURL+url.PathEscape(username)+"/?__a=1", true)
+	}
+	parts := strings.SplitN(cursor, ":", 2)
+	if len(parts) != 2 {
+		return nil, ErrCursor
+	}
+	vars := fmt.Sprintf(`{"id":%q,"first":50,"after":%q}`, parts[0], parts[1])
+	return f.Get(profileFeedURL+url.QueryEscape(vars), true)
+}
+
+func (Instagram) ParseProfile(raw []byte) (Page, error) {
+	posts, profile, cursor, hasNext, timestamp, err := parser.ParseFromProfileRequest(raw)
+	if err != nil {
+		return Page{}, err
+	}
+	page := Page{
+		Posts:   posts,
+		HasNext: hasNext,
+		Oldest:  timestamp,
+	}
+	id := profile.ID
+	if id != "" {
+		page.Entity = &profile
+	} else if len(posts) > 0 {
+		id = posts[0].AuthorID
+	}
+	if cursor != "" && id != "" {
+		page.Cursor = id + ":" + cursor
+	}
+	return page, nil
+}
+
+func (Instagram) FetchTag(f Fetcher, tag, cursor string) ([]byte, error) {
+	var vars string
+	if cursor == "" {
+		vars = fmt.Sprintf(`{"tag_name":%q,"first":50}`, tag)
+	} else {
+		vars = fmt.Sprintf(`{"tag_name":%q,"first":50,"after":%q}`, tag, cursor)
+	}
+	return f.Get(tagURL+url.QueryEscape(vars), true)
+}
+
+func (Instagram) ParseTag(raw []byte) (Page, error) {
+	posts, cursor, hasNext, timestamp, err := parser.ParseFromTagRequest(raw)
+	if err != nil {
+		return Page{}, err
+	}
+	return Page{
+		Posts:   posts,
+		Cursor:  cursor,
+		HasNext: hasNext,
+		Oldest:  timestamp,
+	}, nil
+}
+
+type searchResponse struct {
+	Venues []struct {
+		ExternalID string  `json:"external_id"`
+		Name       string  `json:"name"`
+		Lat        float64 `json:"lat"`
+		Lng        float64 `json:"lng"`
+	} `json:"venues"`
+}
+
+func (Instagram) SearchLocations(f Fetcher, lat, lon float64) ([]data.Location, error) {
+	raw, err := f.Get(fmt.Sprintf("%v?latitude=%v&longitude=%v", searchURL, lat, lon), true)
+	if err != nil {
+		return nil, err
+	}
+	var resp searchResponse
+	err = json.Unmarshal(raw, &resp)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]data.Location, 0, len(resp.Venues))
+	for _, v := range resp.Venues {
+		if v.ExternalID == "" {
+			continue
+		}
+		res = append(res, data.Location{ID: v.ExternalID, Title: v.Name, Lat: v.Lat, Lon: v.Lng})
+	}
+	return res, nil
+}
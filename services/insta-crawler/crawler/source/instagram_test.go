@@ -0,0 +1,186 @@
+package source
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+const locationPageTemplate = `{"data": {"location": {"id": "42", "name": "Park", "slug": "park", "lat": 59.9, "lng": 30.3,
+	"edge_location_to_media": {"page_info": {"has_next_page": %v}, "edges": [{"node": {"id": "%v", "shortcode": "c%v",
+	"display_url": "http://img", "is_video": false, "edge_media_to_caption": {"edges": [{"node": {"text": "hello"}}]},
+	"taken_at_timestamp": %v, "edge_media_to_comment": {"count": 1}, "edge_liked_by": {"count": 2},
+	"owner": {"id": "a"}}}]}}}}`
+
+func TestInstagram(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("variables") {
+		case `{"id":"42","first":50}`:
+			fmt.Fprintf(w, locationPageTemplate, true, "2", "2", 200)
+		case `{"id":"42","first":50,"after":"2"}`:
+			fmt.Fprintf(w, locationPageTemplate, false, "1", "1", 100)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer func(u string) { locationURL = u }(locationURL)
+	locationURL = server.URL + "/graphql/query/?query_hash=1&variables="
+
+	got := crawl(t, Instagram{}, "42")
+	loc := &data.Location{ID: "42", Title: "Park", Slug: "park", Lat: 59.9, Lon: 30.3}
+	post := func(id string, ts int64) data.Post {
+		return data.Post{ID: id, Shortcode: "c" + id, ImageURL: "http://img", Caption: "hello", CommentsCount: 1,
+			LikesCount: 2, Timestamp: ts, AuthorID: "a", LocationID: "42", Lat: 59.9, Lon: 30.3}
+	}
+	want := []Page{
+		{Posts: []data.Post{post("2", 200)}, Entity: loc, Cursor: "2", HasNext: true, Oldest: 200},
+		{Posts: []data.Post{post("1", 100)}, Entity: loc, Cursor: "1", Oldest: 100},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("crawl() = %+v, want %+v", got, want)
+	}
+}
+
+const profilePageTemplate = `{"%v": {"user": {%v"edge_owner_to_timeline_media": {"page_info": {"has_next_page": %v,
+	"end_cursor": "%v"}, "edges": [{"node": {"id": "%v", "shortcode": "c%v", "display_url": "http://img",
+	"is_video": false, "edge_media_to_caption": {"edges": []}, "taken_at_timestamp": %v,
+	"location": {"id": "42", "lat": 59.9, "lng": 30.3}, "edge_media_to_comment": {"count": 1},
+	"edge_liked_by": {"count": 2}, "owner": {"id": "7"}}}]}}}}`
+
+const profileInfo = `"id": "7", "biography": "bio", "username": "user", "full_name": "User", "is_verified": false,
+	"is_private": false, "edge_followed_by": {"count": 10}, "edge_follow": {"count": 5}, `
+
+func TestInstagram_profile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user/":
+			fmt.Fprintf(w, profilePageTemplate, "graphql", profileInfo, true, "QVF", "2", "2", 200)
+		case r.URL.Query().Get("variables") == `{"id":"7","first":50,"after":"QVF"}`:
+			fmt.Fprintf(w, profilePageTemplate, "data", "", false, "", "1", "1", 100)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer func(u, f string) { profileURL, profileFeedURL = u, f }(profileURL, profileFeedURL)
+	profileURL = server.URL + "/"
+	profileFeedURL = server.URL + "/graphql/query/?query_hash=1&variables="
+
+	var got []Page
+	cursor := ""
+	for {
+		raw, err := Instagram{}.FetchProfile(httpFetcher{}, "user", cursor)
+		if err != nil {
+			t.Fatalf("FetchProfile() error = %v", err)
+		}
+		page, err := Instagram{}.ParseProfile(raw)
+		if err != nil {
+			t.Fatalf("ParseProfile() error = %v", err)
+		}
+		got = append(got, page)
+		if !page.HasNext || len(got) > 10 {
+			break
+		}
+		cursor = page.Cursor
+	}
+	post := func(id string, ts int64) data.Post {
+		return data.Post{ID: id, Shortcode: "c" + id, ImageURL: "http://img", CommentsCount: 1, LikesCount: 2,
+			Timestamp: ts, AuthorID: "7", LocationID: "42", Lat: 59.9, Lon: 30.3}
+	}
+	want := []Page{
+		{
+			Posts:   []data.Post{post("2", 200)},
+			Entity:  &data.Profile{ID: "7", Username: "user", FullName: "User", Biography: "bio", FollowersCount: 10, FollowsCount: 5},
+			Cursor:  "7:QVF",
+			HasNext: true,
+			Oldest:  200,
+		},
+		{Posts: []data.Post{post("1", 100)}, Cursor: "7:1", Oldest: 100},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("crawl() = %+v, want %+v", got, want)
+	}
+
+	if _, err := (Instagram{}).FetchProfile(httpFetcher{}, "user", "QVF"); err != ErrCursor {
+		t.Errorf("FetchProfile() error = %v, want %v", err, ErrCursor)
+	}
+}
+
+const tagPageTemplate = `{"data": {"hashtag": {"name": "park", "edge_hashtag_to_media": {"count": 2,
+	"page_info": {"has_next_page": %v, "end_cursor": "%v"}, "edges": [{"node": {"id": "%v", "shortcode": "c%v",
+	"display_url": "http://img", "is_video": false, "edge_media_to_caption": {"edges": [{"node": {"text": "#park"}}]},
+	"taken_at_timestamp": %v, "edge_media_to_comment": {"count": 1}, "edge_media_preview_like": {"count": 2},
+	"owner": {"id": "a"}}}]}}}}`
+
+func TestInstagram_tag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("variables") {
+		case `{"tag_name":"park","first":50}`:
+			fmt.Fprintf(w, tagPageTemplate, true, "QVF", "2", "2", 200)
+		case `{"tag_name":"park","first":50,"after":"QVF"}`:
+			fmt.Fprintf(w, tagPageTemplate, false, "", "1", "1", 100)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer func(u string) { tagURL = u }(tagURL)
+	tagURL = server.URL + "/graphql/query/?query_hash=1&variables="
+
+	var got []Page
+	cursor := ""
+	for {
+		raw, err := Instagram{}.FetchTag(httpFetcher{}, "park", cursor)
+		if err != nil {
+			t.Fatalf("FetchTag() error = %v", err)
+		}
+		page, err := Instagram{}.ParseTag(raw)
+		if err != nil {
+			t.Fatalf("ParseTag() error = %v", err)
+		}
+		got = append(got, page)
+		if !page.HasNext || len(got) > 10 {
+			break
+		}
+		cursor = page.Cursor
+	}
+	post := func(id string, ts int64) data.Post {
+		return data.Post{ID: id, Shortcode: "c" + id, ImageURL: "http://img", Caption: "#park", CommentsCount: 1,
+			LikesCount: 2, Timestamp: ts, AuthorID: "a"}
+	}
+	want := []Page{
+		{Posts: []data.Post{post("2", 200)}, Cursor: "QVF", HasNext: true, Oldest: 200},
+		{Posts: []data.Post{post("1", 100)}, Cursor: "1", Oldest: 100},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %+v, want %+v", got, want)
+	}
+}
+
+func TestInstagram_search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("latitude") != "59.9" || r.URL.Query().Get("longitude") != "30.3" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"venues": [{"external_id": "42", "name": "Park", "lat": 59.91, "lng": 30.31},
+			{"name": "No ID", "lat": 59.9, "lng": 30.3}]}`))
+	}))
+	defer server.Close()
+	defer func(u string) { searchURL = u }(searchURL)
+	searchURL = server.URL + "/location_search/"
+
+	got, err := Instagram{}.SearchLocations(httpFetcher{}, 59.9, 30.3)
+	if err != nil {
+		t.Fatalf("SearchLocations() error = %v", err)
+	}
+	want := []data.Location{{ID: "42", Title: "Park", Lat: 59.91, Lon: 30.31}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchLocations() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package source
+
+import (
+	"errors"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+// Kinds of sources.
+const (
+	InstagramKind = "instagram"
+	FeedKind      = "feed"
+)
+
+var ErrUnknownSource = errors.New("unknown source kind")
+
+// Fetcher makes GET requests on behalf of a source. Anonymous requests are routed through the
+// proxies of the worker.
+type Fetcher interface {
+	Get(url string, anonymous bool) ([]byte, error)
+}
+
+// Page is a page of the feed of an entity. Entity is nil if the page doesn't describe the entity.
+// Cursor is passed to Fetch to get the next page. Oldest is the timestamp of the oldest post of
+// the page, pagination stops when it is before the finish timestamp of the session. Sources which
+// don't order posts from newest to oldest leave it zero.
+type Page struct {
+	Posts   []data.Post
+	Entity  data.Entity
+	Cursor  string
+	HasNext bool
+	Oldest  int64
+}
+
+// Source is a social network or a feed the crawler collects posts from.
+type Source interface {
+	// Fetch returns the raw page of the feed of the entity after the cursor, the first page is
+	// requested with the empty cursor.
+	Fetch(f Fetcher, id, cursor string) ([]byte, error)
+	// Parse extracts posts, the entity and the cursor of the next page from the raw page.
+	Parse(raw []byte) (Page, error)
+}
+
+// Tailer is implemented by sources whose feeds are only appended to, e.g. file drops. The next
+// pass over an entity of such a source continues from the cursor where the previous pass ended,
+// and reading doesn't stop at pages without new posts.
+type Tailer interface {
+	Tails() bool
+}
+
+// ProfileSource is implemented by sources which can page through feeds of profiles. Profiles are
+// identified by user names, the first page describes the profile.
+type ProfileSource interface {
+	FetchProfile(f Fetcher, username, cursor string) ([]byte, error)
+	ParseProfile(raw []byte) (Page, error)
+}
+
+// TagSource is implemented by sources which can page through feeds of hashtags. Tags are passed
+// without the leading "#".
+type TagSource interface {
+	FetchTag(f Fetcher, tag, cursor string) ([]byte, error)
+	ParseTag(raw []byte) (Page, error)
+}
+
+// LocationSearcher is implemented by sources which can search for locations near a point.
+type LocationSearcher interface {
+	SearchLocations(f Fetcher, lat, lon float64) ([]data.Location, error)
+}
+
+// PostDetailer is implemented by sources which can load detailed versions of posts.
+type PostDetailer interface {
+	DetailedPost(f Fetcher, post data.Post) (data.Post, error)
+}
+
+// New returns the source of the kind, Instagram is used if the kind is empty. The feed source
+// reads pages from feedURL.
+func New(kind, feedURL string) (Source, error) {
+	switch kind {
+	case "", InstagramKind:
+		return Instagram{}, nil
+	case FeedKind:
+		return NewFeed(feedURL)
+	default:
+		return nil, ErrUnknownSource
+	}
+}
@@ -0,0 +1,25 @@
+package source
+
+import (
+	"math"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+)
+
+// Stub is a location searcher over a fixed list of locations, it replaces location search
+// endpoints in tests. SearchLocations returns locations within Radius degrees of the point on
+// both axes.
+type Stub struct {
+	Locations []data.Location
+	Radius    float64
+}
+
+func (s Stub) SearchLocations(_ Fetcher, lat, lon float64) ([]data.Location, error) {
+	res := []data.Location{}
+	for _, l := range s.Locations {
+		if math.Abs(l.Lat-lat) <= s.Radius && math.Abs(l.Lon-lon) <= s.Radius {
+			res = append(res, l)
+		}
+	}
+	return res, nil
+}
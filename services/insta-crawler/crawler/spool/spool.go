@@ -0,0 +1,367 @@
+package spool
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+var (
+	batchesBucket = []byte("batches")
+	deadBucket    = []byte("dead")
+)
+
+var ErrFull = errors.New("spool is full")
+
+// Batch of posts of a session which is sent to the data storage. ID is the sequence number of the
+// batch in the spool, Created is the time when the batch was appended. Error is the error of the
+// push of a dead batch. A batch without posts may carry the Watermark of the city instead, it is
+// pushed after all batches appended before it.
+type Batch struct {
+	ID        uint64
+	Session   string
+	CityID    string
+	Posts     []protodata.Post
+	Watermark int64 `json:",omitempty"`
+	Created   int64
+	Error     string `json:",omitempty"`
+}
+
+// Stats of the spool. Age is the age of the oldest batch in seconds, Dead is the number of batches
+// which were rejected by the data storage.
+type Stats struct {
+	Batches int
+	Posts   int
+	Age     int64
+	Dead    int
+}
+
+// permanentError is the error of a push which fails again if the batch is pushed again.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+// Permanent marks the error of a push as permanent, Replay moves such batches to dead batches
+// instead of pushing them again.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+type meta struct {
+	posts   int
+	created int64
+}
+
+// Spool is a write-ahead log of batches sent to the data storage, which is kept in a bbolt
+// database. A batch is appended before it is sent and removed when it is acknowledged, so batches
+// which were not pushed are replayed after failures and restarts. Batches which failed with
+// permanent errors are kept in the dead bucket. The spool holds at most maxPosts posts if it is
+// positive.
+type Spool struct {
+	db       *bolt.DB
+	mu       sync.Mutex
+	meta     map[uint64]meta
+	posts    int
+	dead     int
+	maxPosts int
+	notify   chan struct{}
+	now      func() time.Time
+}
+
+func Open(path string, maxPosts int) (*Spool, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		unilog.Logger().Error("unable to open spool", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	s := &Spool{
+		db:       db,
+		meta:     map[uint64]meta{},
+		maxPosts: maxPosts,
+		notify:   make(chan struct{}, 1),
+		now:      time.Now,
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		d, err := tx.CreateBucketIfNotExists(deadBucket)
+		if err != nil {
+			return err
+		}
+		s.dead = d.Stats().KeyN
+		b, err := tx.CreateBucketIfNotExists(batchesBucket)
+		if err != nil {
+			return err
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var batch Batch
+			if err := json.Unmarshal(v, &batch); err != nil {
+				return err
+			}
+			s.meta[batch.ID] = meta{posts: len(batch.Posts), created: batch.Created}
+			s.posts += len(batch.Posts)
+			return nil
+		})
+	})
+	if err != nil {
+		unilog.Logger().Error("unable to initialize spool", zap.String("path", path), zap.Error(err))
+		db.Close()
+		return nil, err
+	}
+	if len(s.meta) > 0 {
+		s.notify <- struct{}{}
+	}
+	return s, nil
+}
+
+func key(id uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, id)
+	return k
+}
+
+// Append writes the batch to the spool and wakes up Replay. It returns the ID of the batch, or
+// ErrFull if the spool would hold more than the maximum number of posts with the batch.
+func (s *Spool) Append(b Batch) (uint64, error) {
+	s.mu.Lock()
+	full := s.maxPosts > 0 && s.posts+len(b.Posts) > s.maxPosts
+	s.mu.Unlock()
+	if full {
+		return 0, ErrFull
+	}
+	b.Created = s.now().Unix()
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(batchesBucket)
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		b.ID = id
+		d, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key(id), d)
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	s.meta[b.ID] = meta{posts: len(b.Posts), created: b.Created}
+	s.posts += len(b.Posts)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return b.ID, nil
+}
+
+// Ack removes the pushed batch from the spool.
+func (s *Spool) Ack(id uint64) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(batchesBucket).Delete(key(id))
+	})
+	if err != nil {
+		return err
+	}
+	s.remove(id)
+	return nil
+}
+
+// bury moves the batch which failed with the permanent error to dead batches.
+func (s *Spool) bury(b Batch, reason error) error {
+	b.Error = reason.Error()
+	d, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(batchesBucket).Delete(key(b.ID)); err != nil {
+			return err
+		}
+		return tx.Bucket(deadBucket).Put(key(b.ID), d)
+	})
+	if err != nil {
+		return err
+	}
+	s.remove(b.ID)
+	s.mu.Lock()
+	s.dead++
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Spool) remove(id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.posts -= s.meta[id].posts
+	delete(s.meta, id)
+}
+
+// Next returns the first batch which was appended after the batch with the ID and is not
+// acknowledged, ok is false if there is no such batch. Batches are read one by one, so the spool
+// isn't loaded into memory.
+func (s *Spool) Next(after uint64) (b Batch, ok bool, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		k, v := tx.Bucket(batchesBucket).Cursor().Seek(key(after + 1))
+		if k == nil {
+			return nil
+		}
+		ok = true
+		return json.Unmarshal(v, &b)
+	})
+	return
+}
+
+// Dead returns batches which were rejected by the data storage in the order of appending.
+func (s *Spool) Dead() ([]Batch, error) {
+	res := []Batch{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(deadBucket).ForEach(func(k, v []byte) error {
+			var b Batch
+			if err := json.Unmarshal(v, &b); err != nil {
+				return err
+			}
+			res = append(res, b)
+			return nil
+		})
+	})
+	return res, err
+}
+
+// Requeue moves the dead batches with the IDs back to pending batches and wakes up Replay, so they
+// are pushed again in the order of appending, e.g. after the data storage is fixed. Unknown IDs
+// are skipped. It returns the number of requeued batches.
+func (s *Spool) Requeue(ids []uint64) (int, error) {
+	var res []meta
+	var requeued []uint64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		dead, pending := tx.Bucket(deadBucket), tx.Bucket(batchesBucket)
+		for _, id := range ids {
+			v := dead.Get(key(id))
+			if v == nil {
+				continue
+			}
+			var b Batch
+			if err := json.Unmarshal(v, &b); err != nil {
+				return err
+			}
+			b.Error = ""
+			d, err := json.Marshal(b)
+			if err != nil {
+				return err
+			}
+			if err := dead.Delete(key(id)); err != nil {
+				return err
+			}
+			if err := pending.Put(key(id), d); err != nil {
+				return err
+			}
+			res = append(res, meta{posts: len(b.Posts), created: b.Created})
+			requeued = append(requeued, id)
+		}
+		return nil
+	})
+	if err != nil || len(requeued) == 0 {
+		return 0, err
+	}
+	s.mu.Lock()
+	for i, id := range requeued {
+		s.meta[id] = res[i]
+		s.posts += res[i].posts
+	}
+	s.dead -= len(requeued)
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return len(requeued), nil
+}
+
+func (s *Spool) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := Stats{Batches: len(s.meta), Posts: s.posts, Dead: s.dead}
+	var oldest int64
+	for _, m := range s.meta {
+		if oldest == 0 || m.created < oldest {
+			oldest = m.created
+		}
+	}
+	if oldest != 0 {
+		st.Age = s.now().Unix() - oldest
+	}
+	return st
+}
+
+// Replay pushes pending batches in the order of appending until the context is done. A batch is
+// acknowledged after it is pushed. If a push fails, the batches are pushed again after the delay
+// of the backoff, so the data storage gets them when it is available again. Batches which failed
+// with errors marked by Permanent are moved to dead batches, so they don't block the spool.
+func (s *Spool) Replay(ctx context.Context, push func(Batch) error, b *limit.Backoff) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.notify:
+		}
+		for !s.replay(push) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(b.Next()):
+			}
+		}
+		b.Reset()
+	}
+}
+
+// replay pushes pending batches, it returns false if a batch wasn't pushed.
+func (s *Spool) replay(push func(Batch) error) bool {
+	var after uint64
+	for {
+		b, ok, err := s.Next(after)
+		if err != nil {
+			unilog.Logger().Error("unable to read spool", zap.Error(err))
+			return false
+		}
+		if !ok {
+			return true
+		}
+		after = b.ID
+		err = push(b)
+		if pe, ok := err.(permanentError); ok {
+			unilog.Logger().Error("batch is rejected", zap.Uint64("id", b.ID), zap.String("session", b.Session),
+				zap.Error(pe.err))
+			if err := s.bury(b, pe.err); err != nil {
+				unilog.Logger().Error("unable to move batch to dead batches", zap.Uint64("id", b.ID), zap.Error(err))
+				return false
+			}
+			continue
+		}
+		if err != nil {
+			return false
+		}
+		if err := s.Ack(b.ID); err != nil {
+			unilog.Logger().Error("unable to acknowledge batch", zap.Uint64("id", b.ID), zap.Error(err))
+			return false
+		}
+	}
+}
+
+func (s *Spool) Close() error {
+	return s.db.Close()
+}
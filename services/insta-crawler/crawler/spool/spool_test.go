@@ -0,0 +1,227 @@
+package spool
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func openSpool(t *testing.T) (*Spool, string) {
+	dir, err := ioutil.TempDir("", "spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "spool.db")
+	s, err := Open(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, path
+}
+
+func TestSpool(t *testing.T) {
+	s, path := openSpool(t)
+	defer os.RemoveAll(filepath.Dir(path))
+	now := time.Unix(1000, 0)
+	s.now = func() time.Time { return now }
+	posts := []protodata.Post{{ID: "1"}, {ID: "2"}}
+	id1, err := s.Append(Batch{Session: "s1", CityID: "spb", Posts: posts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(10 * time.Second)
+	id2, err := s.Append(Batch{Session: "s1", CityID: "spb", Posts: posts[:1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := s.Stats(); st != (Stats{Batches: 2, Posts: 3, Age: 10}) {
+		t.Errorf("Stats() = %+v, want 2 batches, 3 posts and age 10", st)
+	}
+	if err := s.Ack(id1); err != nil {
+		t.Fatal(err)
+	}
+
+	// batches which are not acknowledged are kept after the spool is reopened
+	s.Close()
+	s, err = Open(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	s.now = func() time.Time { return now }
+	b, ok, err := s.Next(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || b.ID != id2 || b.CityID != "spb" || len(b.Posts) != 1 {
+		t.Errorf("Next() = %+v, %v, want the second batch", b, ok)
+	}
+	if _, ok, err := s.Next(id2); ok || err != nil {
+		t.Errorf("Next() after the last batch = %v, %v, want no batch", ok, err)
+	}
+	if st := s.Stats(); st != (Stats{Batches: 1, Posts: 1, Age: 0}) {
+		t.Errorf("Stats() = %+v, want 1 batch, 1 post and age 0", st)
+	}
+}
+
+func TestSpool_Replay(t *testing.T) {
+	s, path := openSpool(t)
+	defer os.RemoveAll(filepath.Dir(path))
+	defer s.Close()
+	for _, id := range []string{"1", "2", "3"} {
+		if _, err := s.Append(Batch{CityID: "spb", Posts: []protodata.Post{{ID: id}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pushed := make(chan string, 10)
+	fails := 2
+	push := func(b Batch) error {
+		// the storage is unavailable for the first attempts
+		if fails > 0 {
+			fails--
+			return errors.New("unavailable")
+		}
+		pushed <- b.Posts[0].ID
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Replay(ctx, push, &limit.Backoff{Min: time.Millisecond, Max: 5 * time.Millisecond})
+	for _, want := range []string{"1", "2", "3"} {
+		select {
+		case got := <-pushed:
+			if got != want {
+				t.Errorf("pushed batch %v, want %v", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("batch %v was not pushed", want)
+		}
+	}
+	for i := 0; i < 100 && s.Stats().Batches > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if st := s.Stats(); st.Batches != 0 {
+		t.Errorf("Stats() = %+v, want no batches after replay", st)
+	}
+	// batches appended later are replayed too
+	s.Append(Batch{CityID: "spb", Posts: []protodata.Post{{ID: "4"}}})
+	select {
+	case got := <-pushed:
+		if got != "4" {
+			t.Errorf("pushed batch %v, want 4", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("appended batch was not pushed")
+	}
+}
+
+func TestSpool_Replay_permanent(t *testing.T) {
+	s, path := openSpool(t)
+	defer os.RemoveAll(filepath.Dir(path))
+	defer s.Close()
+	for _, id := range []string{"1", "2"} {
+		if _, err := s.Append(Batch{CityID: "spb", Posts: []protodata.Post{{ID: id}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pushed := make(chan string, 10)
+	push := func(b Batch) error {
+		// the data storage rejects the first batch, which must not block the second one
+		if b.Posts[0].ID == "1" {
+			return Permanent(errors.New("rejected"))
+		}
+		pushed <- b.Posts[0].ID
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Replay(ctx, push, &limit.Backoff{Min: time.Millisecond, Max: 5 * time.Millisecond})
+	select {
+	case got := <-pushed:
+		if got != "2" {
+			t.Errorf("pushed batch %v, want 2", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch after the rejected one was not pushed")
+	}
+	for i := 0; i < 100 && s.Stats().Batches > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if st := s.Stats(); st.Batches != 0 || st.Posts != 0 || st.Dead != 1 {
+		t.Errorf("Stats() = %+v, want no batches and 1 dead batch", st)
+	}
+	dead, err := s.Dead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dead) != 1 || dead[0].Posts[0].ID != "1" || dead[0].Error != "rejected" {
+		t.Errorf("Dead() = %+v, want the first batch with its error", dead)
+	}
+}
+
+func TestSpool_Requeue(t *testing.T) {
+	s, path := openSpool(t)
+	defer os.RemoveAll(filepath.Dir(path))
+	defer s.Close()
+	id, err := s.Append(Batch{CityID: "spb", Posts: []protodata.Post{{ID: "1"}, {ID: "2"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _, err := s.Next(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.bury(b, errors.New("rejected")); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := s.Requeue([]uint64{id, id + 1})
+	if err != nil || n != 1 {
+		t.Fatalf("Requeue() = %v, %v, want 1 batch", n, err)
+	}
+	if st := s.Stats(); st.Batches != 1 || st.Posts != 2 || st.Dead != 0 {
+		t.Errorf("Stats() = %+v, want 1 pending batch and no dead ones", st)
+	}
+	got, ok, err := s.Next(0)
+	if err != nil || !ok || got.ID != id || got.Error != "" || len(got.Posts) != 2 {
+		t.Errorf("Next() = %+v, %v, %v, want the requeued batch without its error", got, ok, err)
+	}
+	if n, err := s.Requeue([]uint64{id}); err != nil || n != 0 {
+		t.Errorf("Requeue() = %v, %v for a pending batch, want 0", n, err)
+	}
+}
+
+func TestSpool_Append_full(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := Open(filepath.Join(dir, "spool.db"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	posts := []protodata.Post{{ID: "1"}, {ID: "2"}}
+	id, err := s.Append(Batch{CityID: "spb", Posts: posts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Append(Batch{CityID: "spb", Posts: posts}); err != ErrFull {
+		t.Errorf("Append() error = %v, want %v", err, ErrFull)
+	}
+	// acknowledged posts free the spool
+	if err := s.Ack(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Append(Batch{CityID: "spb", Posts: posts}); err != nil {
+		t.Errorf("Append() error = %v after the batch was acknowledged", err)
+	}
+}
@@ -1,7 +1,15 @@
 package crawler
 
-import "sync"
+import (
+	"sync"
 
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
+)
+
+// Status of a session. LocationPosts are numbers of collected posts by location IDs, LastError is
+// the last error of the session. A deleted session isn't written to the store anymore.
 type Status struct {
 	mu              sync.Mutex
 	Status          StatusType
@@ -9,22 +17,106 @@ type Status struct {
 	PostsCollected  int
 	PostsTotal      int
 	FinishTimestamp int64
+	PassStart       int64
+	LocationPosts   map[string]int
+	LastError       string
+	deleted         bool
 }
 
+// OutStatus is the status of the session reported to users. Proxies are statistics of proxies of
+// the group of workers which crawls the session, Spool is the size and the age of the spool of
+// posts of the group which are not sent to the data storage yet and the number of its batches
+// which the data storage rejected.
 type OutStatus struct {
+	ID              string
+	CityID          string
+	Type            string
+	Priority        int
 	Status          string
+	EntitiesLeft    int
 	PostsCollected  int
 	FinishTimestamp int64
+	LocationPosts   map[string]int
+	LastError       string
+	Proxies         []proxy.Stats
+	Spool           spool.Stats
 }
 
 func (s *Status) get() OutStatus {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	lp := make(map[string]int, len(s.LocationPosts))
+	for id, n := range s.LocationPosts {
+		lp[id] = n
+	}
 	return OutStatus{
 		Status:          s.Status.String(),
+		EntitiesLeft:    s.EntitiesLeft,
 		PostsCollected:  s.PostsTotal,
 		FinishTimestamp: s.FinishTimestamp,
+		LocationPosts:   lp,
+		LastError:       s.LastError,
+	}
+}
+
+func (s *Status) status() StatusType {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Status
+}
+
+func (s *Status) counters() store.Counters {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lp := make(map[string]int, len(s.LocationPosts))
+	for id, n := range s.LocationPosts {
+		lp[id] = n
+	}
+	return store.Counters{
+		EntitiesLeft:    s.EntitiesLeft,
+		PostsCollected:  s.PostsCollected,
+		PostsTotal:      s.PostsTotal,
+		FinishTimestamp: s.FinishTimestamp,
+		PassStart:       s.PassStart,
+		LocationPosts:   lp,
+	}
+}
+
+func (s *Status) running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Status == RunningStatus
+}
+
+// transit changes the status to the new one if the current status is from, it returns false
+// otherwise.
+func (s *Status) transit(from, to StatusType) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Status != from {
+		return false
 	}
+	s.Status = to
+	return true
+}
+
+func (s *Status) delete() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Status = FinishedStatus
+	s.deleted = true
+}
+
+func (s *Status) removed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deleted
+}
+
+func (s *Status) setError(msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.LastError = msg
 }
 
 func (s *Status) updateEntities(num int) {
@@ -45,3 +137,12 @@ func (s *Status) updatePostsCollected(inc int) {
 	s.PostsTotal += inc
 	s.PostsCollected += inc
 }
+
+func (s *Status) updateLocationPosts(locationID string, inc int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.LocationPosts == nil {
+		s.LocationPosts = map[string]int{}
+	}
+	s.LocationPosts[locationID] += inc
+}
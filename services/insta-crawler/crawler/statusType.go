@@ -12,6 +12,7 @@ const (
 	RunningStatus
 	FinishedStatus
 	FailedStatus
+	PausedStatus
 )
 
 func parseStatusType(s string) StatusType {
@@ -22,6 +23,8 @@ func parseStatusType(s string) StatusType {
 		return FinishedStatus
 	case "failed":
 		return FailedStatus
+	case "paused":
+		return PausedStatus
 	default:
 		return InvalidStatus
 	}
@@ -35,6 +38,8 @@ func (s StatusType) String() string {
 		return "finished"
 	case FailedStatus:
 		return "failed"
+	case PausedStatus:
+		return "paused"
 	default:
 		return ""
 	}
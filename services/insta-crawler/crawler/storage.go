@@ -0,0 +1,144 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	storagesvc "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	spoolPath = "spool-%d.db"
+)
+
+var ErrStorage = errors.New("data storage is unavailable")
+
+func dialStorage(url string) (storagesvc.Service, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, url, grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(storagesvc.MaxMsgSize)))
+	if err != nil {
+		return nil, err
+	}
+	return storagesvc.NewGRPCClient(conn), nil
+}
+
+// storage returns the client of the data storage. If the crawler couldn't connect to the storage
+// at start, it connects again, nil is returned if it fails.
+func (th *thread) storage() storagesvc.Service {
+	th.storageMu.Lock()
+	defer th.storageMu.Unlock()
+	if th.dataStorage != nil {
+		return th.dataStorage
+	}
+	ds, err := dialStorage(th.storageURL)
+	if err != nil {
+		unilog.Logger().Error("unable to connect to storage service", zap.Error(err))
+		return nil
+	}
+	th.dataStorage = ds
+	return ds
+}
+
+// sendPostsToDataStorage appends posts to the spool, they are sent by the replay routine of the
+// spool. Posts are sent directly if they can't be written to the spool, e.g. because it is full.
+// If that fails too, the thread waits with backoff until the spool has room or the data storage
+// takes the posts, so workers don't load pages which can't be kept. An error is returned only if
+// the crawler is closed before that.
+func (th *thread) sendPostsToDataStorage(posts []data.Post, sessionID, cityID string) error {
+	if len(posts) == 0 {
+		unilog.Logger().Info("attempt to send an empty array of posts to data-storage")
+		return nil
+	}
+	var protoPosts []protodata.Post
+	for _, post := range posts {
+		protoPosts = append(protoPosts, convertToProtoPost(post))
+	}
+	b := spool.Batch{Session: sessionID, CityID: cityID, Posts: protoPosts}
+	backoff := th.limits.backoff()
+	for {
+		_, err := th.spool.Append(b)
+		if err == nil {
+			return nil
+		}
+		unilog.Logger().Error("unable to append posts to spool", zap.String("sess", sessionID), zap.Error(err))
+		err = th.pushBatch(b)
+		if err == nil || th.ctx.Err() != nil {
+			return err
+		}
+		wait(th.ctx, backoff.Next())
+	}
+}
+
+// sendWatermark appends the watermark of the city to the spool, so it is pushed after the posts
+// which were collected before it. Posts of the city published before the watermark are collected.
+func (th *thread) sendWatermark(sessionID, cityID string, watermark int64) {
+	b := spool.Batch{Session: sessionID, CityID: cityID, Watermark: watermark}
+	_, err := th.spool.Append(b)
+	if err != nil {
+		unilog.Logger().Error("unable to append watermark to spool", zap.String("sess", sessionID), zap.Error(err))
+	}
+}
+
+// pushBatch sends the batch of posts or the watermark to the data storage. Errors of batches which
+// the data storage rejected are permanent, see permanent.
+func (th *thread) pushBatch(b spool.Batch) error {
+	ds := th.storage()
+	if ds == nil {
+		th.setError(b.Session, ErrStorage)
+		return ErrStorage
+	}
+	if b.Watermark != 0 {
+		err := ds.PushWatermark(context.Background(), b.CityID, b.Watermark)
+		if err != nil {
+			unilog.Logger().Error("unable to push watermark", zap.Error(err))
+			th.setError(b.Session, err)
+		}
+		return err
+	}
+	err := ds.PushPosts(context.Background(), b.CityID, b.Posts)
+	if err != nil {
+		unilog.Logger().Error("error while sending to data storage", zap.Error(err))
+		th.setError(b.Session, err)
+		if permanent(err) {
+			return spool.Permanent(err)
+		}
+		return err
+	}
+	unilog.Logger().Info("uploaded posts", zap.Int("num", len(b.Posts)), zap.String("sess", b.Session))
+	return nil
+}
+
+// setError sets the last error of the session with the ID.
+func (th *thread) setError(id string, err error) {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	for _, s := range th.sessions {
+		if s.ID == id {
+			s.Status.setError(err.Error())
+			return
+		}
+	}
+}
+
+// permanent checks if the data storage rejected the pushed posts, so pushing them again fails too.
+// Only rejections of the posts themselves are permanent: invalid posts and invalid requests. Other
+// errors, e.g. of connections to the data storage or to its databases, are temporary.
+func permanent(err error) bool {
+	// errors of the data storage are passed as messages, other errors come from gRPC
+	if st, ok := status.FromError(err); ok {
+		return st.Code() == codes.InvalidArgument
+	}
+	return err.Error() == storage.ErrInvalidPosts.Error()
+}
@@ -0,0 +1,189 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/data-storage/storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestThread_pushBatch replays the spool to the data storage which fails pushes with errors of
+// every class. Batches are kept in the spool after temporary errors and are moved to dead
+// batches after rejections.
+func TestThread_pushBatch(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		dead bool
+	}{
+		{name: "failed exec", err: errors.New(storage.ErrPushPosts.Error())},
+		{name: "failed transaction", err: errors.New(storage.ErrDBTransaction.Error())},
+		{name: "city database is down", err: errors.New("failed to connect to `host=localhost user=postgres database=spb`: dial error")},
+		{name: "unknown city", err: errors.New("specified city does not exist in the database")},
+		{name: "storage is unavailable", err: status.Error(codes.Unavailable, "connection refused")},
+		{name: "deadline", err: status.Error(codes.DeadlineExceeded, "context deadline exceeded")},
+		{name: "invalid posts", err: errors.New(storage.ErrInvalidPosts.Error()), dead: true},
+		{name: "invalid request", err: status.Error(codes.InvalidArgument, "invalid request"), dead: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, dir := openStore(t)
+			defer os.RemoveAll(dir)
+			defer st.Close()
+			ds := &storageStub{err: tt.err}
+			th := newTestThread(st, ds, dir)
+			sp, err := spool.Open(filepath.Join(dir, "spool.db"), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer sp.Close()
+			th.spool = sp
+			if err := th.sendPostsToDataStorage(testPosts(3), "s1", "spb"); err != nil {
+				t.Fatalf("sendPostsToDataStorage() error = %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				sp.Replay(ctx, th.pushBatch, testLimits.backoff())
+				close(done)
+			}()
+			// temporary errors are retried, rejected batches are pushed once
+			for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+				if sp.Stats().Dead > 0 || pushes(ds) >= 3 {
+					break
+				}
+			}
+			cancel()
+			<-done
+			stats := sp.Stats()
+			if tt.dead && (stats.Dead != 1 || stats.Batches != 0 || pushes(ds) != 1) {
+				t.Errorf("Stats() = %+v after %v pushes, want the batch in dead batches after one push", stats, pushes(ds))
+			}
+			if !tt.dead && (stats.Dead != 0 || stats.Batches != 1 || pushes(ds) < 3) {
+				t.Errorf("Stats() = %+v after %v pushes, want the batch retried in the spool", stats, pushes(ds))
+			}
+		})
+	}
+}
+
+func testPosts(n int) []data.Post {
+	posts := make([]data.Post, n)
+	for i := range posts {
+		posts[i] = data.Post{ID: strconv.Itoa(i), Shortcode: "c" + strconv.Itoa(i), Lat: 59.9, Lon: 30.3}
+	}
+	return posts
+}
+
+func pushes(ds *storageStub) int {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	return ds.pushes
+}
+
+// TestThread_sendPostsToDataStorage_full sends posts which don't fit into the spool while the data
+// storage is unavailable. The thread waits until the data storage takes them or the crawler is
+// closed.
+func TestThread_sendPostsToDataStorage_full(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	ds := &storageStub{err: errors.New(storage.ErrPushPosts.Error())}
+	th := newTestThread(st, ds, dir)
+	th.limits = testLimits
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	th.ctx = ctx
+	sp, err := spool.Open(filepath.Join(dir, "spool.db"), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sp.Close()
+	th.spool = sp
+
+	done := make(chan error, 1)
+	go func() {
+		done <- th.sendPostsToDataStorage(testPosts(3), "s1", "spb")
+	}()
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("sendPostsToDataStorage() = %v while posts are neither spooled nor pushed", err)
+	default:
+	}
+	ds.mu.Lock()
+	ds.err = nil
+	ds.mu.Unlock()
+	if err := <-done; err != nil || len(ds.posts) != 3 {
+		t.Errorf("sendPostsToDataStorage() = %v with %v pushed posts, want 3 posts pushed", err, len(ds.posts))
+	}
+
+	// the closed crawler doesn't wait for the data storage
+	ds.mu.Lock()
+	ds.err = errors.New(storage.ErrPushPosts.Error())
+	ds.mu.Unlock()
+	go func() {
+		done <- th.sendPostsToDataStorage(testPosts(3), "s1", "spb")
+	}()
+	cancel()
+	if err := <-done; err == nil {
+		t.Errorf("sendPostsToDataStorage() = nil after the crawler is closed, want the push error")
+	}
+}
+
+// TestThread_sendWatermark checks that the watermark is pushed only after the posts which were
+// spooled before it.
+func TestThread_sendWatermark(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	ds := &storageStub{err: errors.New(storage.ErrPushPosts.Error())}
+	th := newTestThread(st, ds, dir)
+	sp, err := spool.Open(filepath.Join(dir, "spool.db"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sp.Close()
+	th.spool = sp
+	if err := th.sendPostsToDataStorage(testPosts(3), "s1", "spb"); err != nil {
+		t.Fatalf("sendPostsToDataStorage() error = %v", err)
+	}
+	th.sendWatermark("s1", "spb", 1000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		sp.Replay(ctx, th.pushBatch, testLimits.backoff())
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	for deadline := time.Now().Add(5 * time.Second); pushes(ds) < 2 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	ds.mu.Lock()
+	if len(ds.watermarks) != 0 {
+		t.Errorf("watermarks = %v are pushed before the posts", ds.watermarks)
+	}
+	ds.err = nil
+	ds.mu.Unlock()
+	for deadline := time.Now().Add(5 * time.Second); sp.Stats().Batches > 0 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if len(ds.posts) != 3 || len(ds.watermarks) != 1 || ds.watermarks[0] != 1000 {
+		t.Errorf("pushed %v posts and watermarks %v, want 3 posts and the watermark", len(ds.posts), ds.watermarks)
+	}
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/visheratin/unilog"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+var (
+	sessionsBucket    = []byte("sessions")
+	checkpointsBucket = []byte("checkpoints")
+)
+
+var ErrSessionNotFound = errors.New("session was not found")
+
+// Session is a persisted state of a crawling session. Params are the encoded parameters of the
+// session, checkpoints of entities are stored separately in the nested bucket of
+// checkpointsBucket with the session ID as a name.
+type Session struct {
+	ID       string
+	Params   json.RawMessage
+	Status   int
+	Counters Counters
+}
+
+// Counters of a crawling session. PassStart is the time when the current pass over entities of
+// the session was started, LocationPosts are numbers of collected posts by location IDs.
+type Counters struct {
+	EntitiesLeft    int
+	PostsCollected  int
+	PostsTotal      int
+	FinishTimestamp int64
+	PassStart       int64
+	LocationPosts   map[string]int
+}
+
+// Checkpoint of an entity in the current pass over entities of a session. Cursor is the cursor of
+// the next page of the entity feed, Finished is true if the entity feed is read to the end.
+type Checkpoint struct {
+	Cursor   string
+	Finished bool
+}
+
+// Store keeps crawling sessions in a bbolt database. Every change is a separate transaction, so
+// the store contains the state after the last committed page if the crawler crashes.
+type Store struct {
+	db *bolt.DB
+}
+
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		unilog.Logger().Error("unable to open session store", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{sessionsBucket, checkpointsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		unilog.Logger().Error("unable to initialize session store", zap.String("path", path), zap.Error(err))
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// PutSession writes the session, its checkpoints are kept.
+func (st *Store) PutSession(s Session) error {
+	d, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Put([]byte(s.ID), d)
+	})
+}
+
+// Session returns the session with the ID.
+func (st *Store) Session(id string) (Session, error) {
+	var s Session
+	err := st.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(sessionsBucket).Get([]byte(id))
+		if v == nil {
+			return ErrSessionNotFound
+		}
+		return json.Unmarshal(v, &s)
+	})
+	return s, err
+}
+
+// Sessions returns all sessions of the store.
+func (st *Store) Sessions() ([]Session, error) {
+	res := []Session{}
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).ForEach(func(k, v []byte) error {
+			var s Session
+			if err := json.Unmarshal(v, &s); err != nil {
+				return err
+			}
+			res = append(res, s)
+			return nil
+		})
+	})
+	return res, err
+}
+
+// CommitPage writes the checkpoint of the entity and counters of the session in one transaction.
+func (st *Store) CommitPage(id, entity string, cp Checkpoint, c Counters) error {
+	d, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		err := updateCounters(tx, id, c)
+		if err != nil {
+			return err
+		}
+		b, err := tx.Bucket(checkpointsBucket).CreateBucketIfNotExists([]byte(id))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(entity), d)
+	})
+}
+
+func updateCounters(tx *bolt.Tx, id string, c Counters) error {
+	b := tx.Bucket(sessionsBucket)
+	v := b.Get([]byte(id))
+	if v == nil {
+		return ErrSessionNotFound
+	}
+	var s Session
+	err := json.Unmarshal(v, &s)
+	if err != nil {
+		return err
+	}
+	s.Counters = c
+	d, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(id), d)
+}
+
+// Checkpoints returns checkpoints of entities of the session.
+func (st *Store) Checkpoints(id string) (map[string]Checkpoint, error) {
+	res := map[string]Checkpoint{}
+	err := st.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(checkpointsBucket).Bucket([]byte(id))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var cp Checkpoint
+			if err := json.Unmarshal(v, &cp); err != nil {
+				return err
+			}
+			res[string(k)] = cp
+			return nil
+		})
+	})
+	return res, err
+}
+
+// FinishPass writes the session and removes its checkpoints in one transaction, it is called when
+// all entities of the session are read to the end.
+func (st *Store) FinishPass(s Session) error {
+	d, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(sessionsBucket).Put([]byte(s.ID), d); err != nil {
+			return err
+		}
+		return deleteCheckpoints(tx, s.ID)
+	})
+}
+
+// RemoveEntities writes the session and removes checkpoints of the entities in one transaction, it
+// is called when entities are removed from the session.
+func (st *Store) RemoveEntities(s Session, entities []string) error {
+	d, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(sessionsBucket).Put([]byte(s.ID), d); err != nil {
+			return err
+		}
+		b := tx.Bucket(checkpointsBucket).Bucket([]byte(s.ID))
+		if b == nil {
+			return nil
+		}
+		for _, e := range entities {
+			if err := b.Delete([]byte(e)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteSession removes the session and its checkpoints.
+func (st *Store) DeleteSession(id string) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(sessionsBucket).Delete([]byte(id)); err != nil {
+			return err
+		}
+		return deleteCheckpoints(tx, id)
+	})
+}
+
+func deleteCheckpoints(tx *bolt.Tx, id string) error {
+	err := tx.Bucket(checkpointsBucket).DeleteBucket([]byte(id))
+	if err == bolt.ErrBucketNotFound {
+		return nil
+	}
+	return err
+}
+
+func (st *Store) Close() error {
+	return st.db.Close()
+}
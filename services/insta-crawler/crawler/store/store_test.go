@@ -0,0 +1,97 @@
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sessions.db")
+	st, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := Session{ID: "s1", Params: json.RawMessage(`{"CityID":"spb"}`), Status: 1, Counters: Counters{EntitiesLeft: 2}}
+	if err := st.PutSession(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CommitPage("s1", "42", Checkpoint{Cursor: "c1"}, Counters{EntitiesLeft: 2, PostsCollected: 50, PostsTotal: 50}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CommitPage("s1", "43", Checkpoint{Finished: true}, Counters{EntitiesLeft: 1, PostsCollected: 60, PostsTotal: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CommitPage("s2", "42", Checkpoint{Cursor: "c1"}, Counters{}); err != ErrSessionNotFound {
+		t.Errorf("CommitPage() error = %v, want %v", err, ErrSessionNotFound)
+	}
+
+	// the state is read back after the store is reopened
+	st.Close()
+	st, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer st.Close()
+	got, err := st.Session("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Counters = Counters{EntitiesLeft: 1, PostsCollected: 60, PostsTotal: 60}
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf("Session() = %+v, want %+v", got, s)
+	}
+	cps, err := st.Checkpoints("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Checkpoint{"42": {Cursor: "c1"}, "43": {Finished: true}}
+	if !reflect.DeepEqual(cps, want) {
+		t.Errorf("Checkpoints() = %v, want %v", cps, want)
+	}
+
+	s.Counters = Counters{EntitiesLeft: 0, PostsCollected: 60, PostsTotal: 60}
+	if err := st.RemoveEntities(s, []string{"42"}); err != nil {
+		t.Fatal(err)
+	}
+	cps, err = st.Checkpoints("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]Checkpoint{"43": {Finished: true}}
+	if !reflect.DeepEqual(cps, want) {
+		t.Errorf("Checkpoints() after RemoveEntities() = %v, want %v", cps, want)
+	}
+	if got, err := st.Session("s1"); err != nil || !reflect.DeepEqual(got, s) {
+		t.Errorf("Session() after RemoveEntities() = %+v, %v, want %+v", got, err, s)
+	}
+
+	s.Counters = Counters{EntitiesLeft: 2, PostsTotal: 60, FinishTimestamp: 100}
+	if err := st.FinishPass(s); err != nil {
+		t.Fatal(err)
+	}
+	cps, err = st.Checkpoints("s1")
+	if err != nil || len(cps) != 0 {
+		t.Errorf("Checkpoints() after FinishPass() = %v, %v, want no checkpoints", cps, err)
+	}
+	sessions, err := st.Sessions()
+	if err != nil || !reflect.DeepEqual(sessions, []Session{s}) {
+		t.Errorf("Sessions() = %+v, %v, want %+v", sessions, err, []Session{s})
+	}
+
+	if err := st.DeleteSession("s1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.Session("s1"); err != ErrSessionNotFound {
+		t.Errorf("Session() error = %v, want %v", err, ErrSessionNotFound)
+	}
+}
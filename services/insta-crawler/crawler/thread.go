@@ -4,6 +4,9 @@ import (
 	"context"
 	storagesvc "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
 	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/spool"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
 	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
 	"github.com/google/uuid"
 	"github.com/visheratin/unilog"
@@ -20,72 +23,243 @@ type thread struct {
 	mu          sync.Mutex
 	sessions    []*Session
 	workers     []*worker
+	searcher    *worker
 	inCh        chan entity
 	outCh       chan entity
 	postsCh     chan []data.Post
 	entitiesCh  chan data.Entity
 	mediaCh     chan []data.Media
 	checkpoints map[string]string
+	storageMu   sync.Mutex
 	dataStorage storagesvc.Service
+	storageURL  string
+	spool       *spool.Spool
 	cl          *client
+	pool        *proxy.Pool
+	store       *store.Store
 	rootDir     string
+	discovery   DiscoveryConfig
+	limits      Limits
+	active      *Session
+	crawled     map[string]bool
+	ctx         context.Context
+	quit        chan struct{}
 }
 
 func (th *thread) NewSession(p Parameters, rootDir string) (string, error) {
 	id := uuid.New().String()
+	err := p.validate()
+	if err != nil {
+		return "", err
+	}
 	th.mu.Lock()
 	defer th.mu.Unlock()
-	sess, err := newSession(id, p, rootDir)
+	sess, err := newSession(id, p, rootDir, th.store)
 	if err != nil {
 		return "", err
 	}
 	if p.InitCity {
+		ds := th.storage()
+		if ds == nil {
+			return "", ErrStorage
+		}
 		area := protodata.Area{TopLeft: &p.TopLeft, BotRight: &p.BottomRight}
 		city := protodata.City{Title: p.Description, Code: p.CityID, Area: area}
-		err = th.dataStorage.InsertCity(context.Background(), city, true)
+		err = ds.InsertCity(context.Background(), city, true)
 		if err != nil {
 			unilog.Logger().Error("unable to insert city", zap.Any("city", city), zap.Error(err))
 			return "", err
 		}
 	}
 	th.sessions = append(th.sessions, &sess)
+	if p.Discover {
+		go th.discover(sess.discovered.begin(), &sess)
+	}
 	unilog.Logger().Info("created session", zap.String("id", sess.ID), zap.Int("thread", th.id))
 	return id, nil
 }
 
+// start crawls sessions until the context of the thread is done, workers of the thread are
+// stopped after that.
 func (th *thread) start() {
-	for {
-		if len(th.sessions) == 0 {
-			time.Sleep(10 * time.Second)
+	defer close(th.quit)
+	for th.ctx.Err() == nil {
+		sess := th.next()
+		if sess == nil {
+			wait(th.ctx, 10*time.Second)
+			continue
 		}
-		for i := range th.sessions {
-			if th.sessions[i].Status.Status == FinishedStatus {
+		th.proceedSession(sess)
+	}
+}
+
+// next returns the running session with the highest priority which wasn't crawled in the current
+// cycle over sessions of the thread, so sessions with lower priorities are crawled in every cycle
+// too. A new cycle is started when all running sessions are crawled.
+func (th *thread) next() *Session {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	var res *Session
+	for i := 0; i < 2 && res == nil; i++ {
+		for _, s := range th.sessions {
+			if !s.Status.running() || th.crawled[s.ID] {
 				continue
 			}
-			th.proceedSession(th.sessions[i])
-			if i == (len(th.sessions) - 1) {
-				i = 0
+			if res == nil || s.Params.Priority > res.Params.Priority {
+				res = s
 			}
 		}
+		if res == nil {
+			th.crawled = map[string]bool{}
+		}
+	}
+	if res != nil {
+		if th.crawled == nil {
+			th.crawled = map[string]bool{}
+		}
+		th.crawled[res.ID] = true
+		th.active = res
 	}
+	return res
 }
 
-func (th *thread) proceedSession(sess *Session) {
+// release is called when the thread stops crawling the session. Queued edits are applied, so
+// checkpoints of removed locations are removed, and the deleted session is removed from the store.
+func (th *thread) release(sess *Session) {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.active = nil
+	if sess.Status.removed() {
+		err := th.store.DeleteSession(sess.ID)
+		if err != nil {
+			unilog.Logger().Error("unable to delete session", zap.String("id", sess.ID), zap.Error(err))
+		}
+		return
+	}
+	if len(sess.edits) == 0 {
+		return
+	}
+	var rms []string
+	for _, e := range sess.edits {
+		ls, rm := e.apply(sess)
+		th.pushLocations(sess, ls)
+		rms = append(rms, rm...)
+	}
+	sess.edits = nil
+	sess.removeEntities(th.store, rms)
+}
+
+// edit applies the edit to the session if the thread doesn't crawl it now, otherwise the edit is
+// queued until the next round of the session.
+func (th *thread) edit(sess *Session, e Edit) error {
+	err := e.validate(sess.Params)
+	if err != nil {
+		return err
+	}
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.active == sess {
+		sess.edits = append(sess.edits, e)
+		return nil
+	}
+	ls, rm := e.apply(sess)
+	th.pushLocations(sess, ls)
+	return sess.removeEntities(th.store, rm)
+}
+
+// applyEdits applies queued edits of the session at the start of the round. Added locations are
+// appended to the entities of the round and removed ones are dropped from them.
+func (th *thread) applyEdits(sess *Session, entities []string) []string {
+	th.mu.Lock()
+	edits := sess.edits
+	sess.edits = nil
+	var added []data.Location
+	var rms []string
+	removed := map[string]bool{}
+	for _, e := range edits {
+		ls, rm := e.apply(sess)
+		added = append(added, ls...)
+		rms = append(rms, rm...)
+		for _, id := range rm {
+			removed[id] = true
+		}
+	}
+	th.mu.Unlock()
+	if len(edits) == 0 {
+		return entities
+	}
+	res := make([]string, 0, len(entities)+len(added))
+	for _, id := range entities {
+		if !removed[id] {
+			res = append(res, id)
+		}
+	}
+	for _, l := range added {
+		res = append(res, l.ID)
+	}
+	th.pushLocations(sess, added)
 	for j := range th.workers {
 		th.workers[j].paramsCh <- sess.Params
 	}
-	resEntities := make([]string, len(sess.Params.Locations))
-	for i := range sess.Params.Locations {
-		resEntities[i] = sess.Params.Locations[i].ID
+	sess.removeEntities(th.store, rms)
+	unilog.Logger().Info("applied session edits", zap.String("id", sess.ID), zap.Int("edits", len(edits)))
+	return res
+}
+
+// saveIdle saves the session if the thread doesn't crawl it now, otherwise the session is saved by
+// the thread at the end of the round.
+func (th *thread) saveIdle(sess *Session) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.active == sess {
+		return nil
+	}
+	return sess.save(th.store)
+}
+
+// status returns the status of the session, statistics of proxies and the spool of the thread.
+func (th *thread) status(sess *Session) OutStatus {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	st := sess.status()
+	st.Proxies = th.pool.Stats()
+	st.Spool = th.spool.Stats()
+	return st
+}
+
+// proceedSession crawls entities of the session in rounds until all of them are read to the end.
+// The session is left at the start of a round if it isn't running anymore or the crawler is
+// closed, its checkpoints are kept, so the pass continues when the session is resumed.
+func (th *thread) proceedSession(sess *Session) {
+	defer th.release(sess)
+	for j := range th.workers {
+		th.workers[j].paramsCh <- sess.Params
 	}
+	resEntities := sess.pending()
+	tails := sess.Params.tails()
 	num := 0
-	s := time.Now().Unix()
+	// the start of the pass is kept, so the next pass doesn't skip posts after a restart
+	if sess.Status.PassStart == 0 {
+		sess.Status.PassStart = time.Now().Unix()
+	}
+	s := sess.Status.PassStart
 	l := len(resEntities)
+	sess.Status.updateEntities(l)
 	sess.Status.FinishTimestamp = sess.Params.FinishTimestamp
+	// lost is set if posts were neither spooled nor pushed, checkpoints aren't committed after that,
+	// so pages of the posts are loaded again after a restart
+	lost := false
 	for len(resEntities) > 0 {
+		if !sess.Status.running() || th.ctx.Err() != nil {
+			sess.save(th.store)
+			return
+		}
+		resEntities = th.applyEdits(sess, resEntities)
+		resEntities = th.addDiscovered(sess, resEntities)
+		l = len(resEntities)
 		c := 0
-		go th.putEntities(sess, resEntities)
-		resEntities = make([]string, 0, len(sess.Params.Locations))
+		go th.putEntities(th.entities(sess, resEntities))
+		resEntities = make([]string, 0, len(resEntities))
 		for c < l {
 			select {
 			case e := <-th.outCh:
@@ -98,7 +272,20 @@ func (th *thread) proceedSession(sess *Session) {
 						sess.Params.Checkpoints[e.id] = e.checkpoint
 					}
 				} else {
+					sess.markFinished(e.id)
 					sess.Status.updateEntitiesLeft(-1)
+					if tails && e.checkpoint != "" {
+						if sess.Params.Offsets == nil {
+							sess.Params.Offsets = map[string]string{}
+						}
+						sess.Params.Offsets[e.id] = e.checkpoint
+					}
+				}
+				if e.err != "" {
+					sess.Status.setError(e.err)
+				}
+				if (e.finished || e.checkpoint != "") && !lost {
+					th.commitPage(sess, e)
 				}
 				c++
 			case d := <-th.postsCh:
@@ -106,38 +293,85 @@ func (th *thread) proceedSession(sess *Session) {
 					num += len(d)
 					if len(d) > 0 {
 						sess.Status.updatePostsCollected(len(d))
-						if th.dataStorage != nil {
-							th.sendPostsToDataStorage(d, sess.ID, sess.Params.CityID)
+						for _, p := range d {
+							if p.LocationID != "" {
+								sess.Status.updateLocationPosts(p.LocationID, 1)
+							}
+						}
+						err := th.sendPostsToDataStorage(d, sess.ID, sess.Params.CityID)
+						if err != nil {
+							sess.Status.setError(err.Error())
+							lost = true
 						}
 					}
 				}
-			case l := <-th.entitiesCh:
-				th.dataStorage.PushLocations(context.Background(), sess.Params.CityID,
-					[]protodata.Location{convertToProtoLocation(l.(*data.Location))})
+			case e := <-th.entitiesCh:
+				switch e := e.(type) {
+				case *data.Location:
+					th.pushLocations(sess, []data.Location{*e})
+				case *data.Profile:
+					saveProfile(sess.ID, e, th.rootDir)
+				}
 			case d := <-th.mediaCh:
 				saveMedia(sess.ID, d, th.rootDir)
 			default:
 				time.Sleep(5 * time.Second)
 			}
 		}
-		sess.dump(th.rootDir)
+		sess.save(th.store)
 		l = len(resEntities)
 		unilog.Logger().Info("session processed", zap.String("id", sess.ID),
 			zap.Int("entities left", sess.Status.EntitiesLeft),
 			zap.Int("posts collected", sess.Status.PostsCollected),
 			zap.Int("posts total", sess.Status.PostsTotal))
+		if lost {
+			return
+		}
 	}
 	sess.Status.FinishTimestamp = s
 	sess.Params.FinishTimestamp = s
 	sess.Params.Checkpoints = map[string]string{}
 	sess.Status.PostsCollected = 0
-	sess.dump(th.rootDir)
+	sess.Status.PassStart = 0
+	sess.finishPass(th.store)
+	// posts of the city published before the pass are collected, if the session reads its locations
+	if sess.Params.crawlingType() == data.LocationsType && sess.Params.CityID != "" {
+		th.sendWatermark(sess.ID, sess.Params.CityID, s)
+	}
 }
 
-func (th *thread) putEntities(sess *Session, cur []string) {
-	for _, id := range cur {
-		cp := sess.Params.Checkpoints[id]
-		th.inCh <- entity{id: id, checkpoint: cp}
+// commitPage stores the checkpoint of the entity after its page is processed, so the session
+// continues from the next page after a restart.
+func (th *thread) commitPage(sess *Session, e entity) {
+	if sess.Status.removed() {
+		return
+	}
+	cp := store.Checkpoint{Cursor: e.checkpoint, Finished: e.finished}
+	err := th.store.CommitPage(sess.ID, e.id, cp, sess.Status.counters())
+	if err != nil {
+		unilog.Logger().Error("unable to commit checkpoint", zap.String("session", sess.ID),
+			zap.String("entity", e.id), zap.Error(err))
+	}
+}
+
+// entities returns entities with their checkpoints, an entity without a checkpoint in the current
+// pass starts from its offset. Checkpoints are read before entities are sent to workers, because
+// they are changed while workers process the round.
+func (th *thread) entities(sess *Session, ids []string) []entity {
+	res := make([]entity, 0, len(ids))
+	for _, id := range ids {
+		cp, ok := sess.Params.Checkpoints[id]
+		if !ok {
+			cp = sess.Params.Offsets[id]
+		}
+		res = append(res, entity{id: id, checkpoint: cp})
+	}
+	return res
+}
+
+func (th *thread) putEntities(es []entity) {
+	for _, e := range es {
+		th.inCh <- e
 	}
 }
 
@@ -159,22 +393,24 @@ func saveMedia(sessionID string, media []data.Media, dir string) {
 	}
 }
 
-func (th *thread) sendPostsToDataStorage(posts []data.Post, sessionID, cityID string) error {
-	if len(posts) == 0 {
-		unilog.Logger().Info("attempt to send an empty array of posts to data-storage")
-		return nil
+// saveProfile writes the profile to the profiles directory of the session.
+func saveProfile(sessionID string, profile *data.Profile, dir string) {
+	profilesPath := path.Join(dir, sessionID, "profiles")
+	err := os.MkdirAll(profilesPath, 0777)
+	if err != nil {
+		unilog.Logger().Error("unable to create profiles directory", zap.String("path", profilesPath), zap.Error(err))
+		return
 	}
-	var protoPosts []protodata.Post
-	for _, post := range posts {
-		protoPosts = append(protoPosts, convertToProtoPost(post))
+	b, err := profile.Marshal()
+	if err != nil {
+		unilog.Logger().Error("unable to encode profile", zap.String("id", profile.ID), zap.Error(err))
+		return
 	}
-	err := th.dataStorage.PushPosts(context.Background(), cityID, protoPosts)
+	pp := path.Join(profilesPath, profile.ID+".json")
+	err = ioutil.WriteFile(pp, b, 0644)
 	if err != nil {
-		unilog.Logger().Error("error while sending to data storage", zap.Error(err))
-		return err
+		unilog.Logger().Error("unable to write profile", zap.String("path", pp), zap.Error(err))
 	}
-	unilog.Logger().Info("uploaded posts", zap.Int("num", len(posts)), zap.String("sess", sessionID))
-	return nil
 }
 
 func convertToProtoPost(post data.Post) protodata.Post {
@@ -0,0 +1,198 @@
+package crawler
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	storagesvc "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/store"
+	protodata "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// storageStub is the data storage which keeps pushed posts, locations and watermarks, pushes of
+// posts fail with err if it is set. Methods which aren't overridden panic.
+type storageStub struct {
+	storagesvc.Service
+	mu         sync.Mutex
+	posts      []protodata.Post
+	locations  []protodata.Location
+	watermarks []int64
+	pushes     int
+	err        error
+}
+
+func (s *storageStub) PushPosts(_ context.Context, _ string, posts []protodata.Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pushes++
+	if s.err != nil {
+		return s.err
+	}
+	s.posts = append(s.posts, posts...)
+	return nil
+}
+
+func (s *storageStub) PushLocations(_ context.Context, _ string, ls []protodata.Location) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.locations = append(s.locations, ls...)
+	return nil
+}
+
+func (s *storageStub) PushWatermark(_ context.Context, _ string, t int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.watermarks = append(s.watermarks, t)
+	return nil
+}
+
+// openStore opens the store in a new temporary directory, which is returned too.
+func openStore(t *testing.T) (*store.Store, string) {
+	dir, err := ioutil.TempDir("", "crawler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := store.Open(filepath.Join(dir, storePath))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return st, dir
+}
+
+// newTestThread returns the thread without workers with the store and the data storage.
+func newTestThread(st *store.Store, ds storagesvc.Service, dir string) *thread {
+	return &thread{
+		ctx:         context.Background(),
+		store:       st,
+		dataStorage: ds,
+		rootDir:     dir,
+	}
+}
+
+func TestThread_next(t *testing.T) {
+	th := newTestThread(nil, nil, "")
+	session := func(id string, priority int, status StatusType) *Session {
+		return &Session{ID: id, Params: Parameters{Priority: priority}, Status: &Status{Status: status}}
+	}
+	th.sessions = []*Session{
+		session("low", 0, RunningStatus),
+		session("high", 5, RunningStatus),
+		session("paused", 10, PausedStatus),
+		session("middle", 1, RunningStatus),
+	}
+	var got []string
+	for i := 0; i < 6; i++ {
+		got = append(got, th.next().ID)
+	}
+	// sessions with lower priorities are crawled in every cycle too
+	want := []string{"high", "middle", "low", "high", "middle", "low"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("next() = %v, want %v", got, want)
+	}
+
+	for _, s := range th.sessions {
+		s.Status.Status = PausedStatus
+	}
+	if s := th.next(); s != nil {
+		t.Errorf("next() = %v, want nil without running sessions", s.ID)
+	}
+}
+
+func TestThread_applyEdits(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	ds := &storageStub{}
+	th := newTestThread(st, ds, dir)
+	sess, err := newSession("s1", Parameters{
+		CityID:    "spb",
+		Locations: []data.Location{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+	}, dir, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"1", "2"} {
+		if err := st.CommitPage("s1", id, store.Checkpoint{Cursor: "c" + id}, sess.Status.counters()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sess.edits = []Edit{
+		{RemoveLocations: []string{"2"}},
+		{AddLocations: []data.Location{{ID: "4", Title: "new"}, {ID: "1"}}},
+	}
+
+	got := th.applyEdits(&sess, []string{"1", "2", "3"})
+	if want := []string{"1", "3", "4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("applyEdits() = %v, want %v", got, want)
+	}
+	if len(sess.edits) != 0 {
+		t.Errorf("edits = %v, want none after they are applied", sess.edits)
+	}
+	if len(ds.locations) != 1 || ds.locations[0].ID != "4" {
+		t.Errorf("pushed locations = %v, want the added one", ds.locations)
+	}
+	cps, err := st.Checkpoints("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]store.Checkpoint{"1": {Cursor: "c1"}}; !reflect.DeepEqual(cps, want) {
+		t.Errorf("Checkpoints() = %v, want %v", cps, want)
+	}
+	if sess.Status.EntitiesLeft != 3 {
+		t.Errorf("EntitiesLeft = %v, want 3", sess.Status.EntitiesLeft)
+	}
+}
+
+// TestThread_release edits the session during its round, the edit is applied when the thread
+// releases the session.
+func TestThread_release(t *testing.T) {
+	st, dir := openStore(t)
+	defer os.RemoveAll(dir)
+	defer st.Close()
+	ds := &storageStub{}
+	th := newTestThread(st, ds, dir)
+	sess, err := newSession("s1", Parameters{
+		CityID:    "spb",
+		Locations: []data.Location{{ID: "1"}, {ID: "2"}},
+	}, dir, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"1", "2"} {
+		if err := st.CommitPage("s1", id, store.Checkpoint{Cursor: "c" + id}, sess.Status.counters()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	th.active = &sess
+	if err := th.edit(&sess, Edit{RemoveLocations: []string{"2"}, AddLocations: []data.Location{{ID: "3"}}}); err != nil {
+		t.Fatalf("edit() error = %v", err)
+	}
+	if len(sess.edits) != 1 {
+		t.Fatalf("edits = %v, want the edit queued during the round", sess.edits)
+	}
+
+	th.release(&sess)
+	if th.active != nil || len(sess.edits) != 0 {
+		t.Errorf("release() left active = %v, edits = %v", th.active, sess.edits)
+	}
+	if want := []string{"1", "3"}; !reflect.DeepEqual(sess.Params.entities(), want) {
+		t.Errorf("entities() = %v, want %v", sess.Params.entities(), want)
+	}
+	cps, err := st.Checkpoints("s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]store.Checkpoint{"1": {Cursor: "c1"}}; !reflect.DeepEqual(cps, want) {
+		t.Errorf("Checkpoints() = %v, want %v", cps, want)
+	}
+	if len(ds.locations) != 1 || ds.locations[0].ID != "3" {
+		t.Errorf("pushed locations = %v, want the added one", ds.locations)
+	}
+}
@@ -5,17 +5,18 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"net/url"
-	"strconv"
 	"sync"
 	"time"
 
 	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
-	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/parser"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/fixture"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/limit"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/source"
 	"github.com/corpix/uarand"
+	"github.com/sony/gobreaker"
 	"github.com/visheratin/unilog"
 	"go.uber.org/zap"
-	"golang.org/x/net/proxy"
 )
 
 type worker struct {
@@ -30,99 +31,245 @@ type worker struct {
 	fixer      Fixer
 	mu         sync.Mutex
 	params     Parameters
+	source     source.Source
+	locations  map[string]data.Location
 	agent      string
 	http       http.Client
-	tor        http.Client
+	pool       *proxy.Pool
+	proxy      *proxy.Proxy
 	cl         *client
+	limits     Limits
+	bucket     *limit.Bucket
+	breaker    *gobreaker.CircuitBreaker
+	tape       *fixture.Tape
+	transport  func(http.RoundTripper) http.RoundTripper
+	quit       chan struct{}
 }
 
-func (w *worker) init(port int) {
+var ErrNoProxy = errors.New("there is no proxy for anonymous requests")
+
+func (w *worker) init(limits Limits) {
+	w.limits = limits
+	w.bucket = limit.NewBucket(limits.WorkerRate, limits.WorkerBurst)
+	w.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    fmt.Sprintf("worker %d of thread %d", w.id, w.tid),
+		Timeout: time.Duration(limits.ParkTime) * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= limits.BreakerFailures
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			unilog.Logger().Info("worker breaker changed state", zap.String("worker", name),
+				zap.String("from", from.String()), zap.String("to", to.String()))
+		},
+	})
 	w.http = http.Client{
 		Timeout: 30 * time.Second,
 	}
-	tbProxyURL, err := url.Parse("socks5://127.0.0.1:" + strconv.Itoa(port))
-	if err != nil {
-		return
-	}
-	tbDialer, err := proxy.FromURL(tbProxyURL, proxy.Direct)
-	if err != nil {
-		return
-	}
-	tbTransport := &http.Transport{
-		Dial:                tbDialer.Dial,
-		MaxIdleConnsPerHost: 1,
-	}
-	w.tor = http.Client{
-		Transport: tbTransport,
-		Timeout:   30 * time.Second,
-	}
+	w.proxy = w.pool.Acquire(nil)
 	w.agent = uarand.GetRandom()
-	go w.paramsEdit()
+	w.source = source.Instagram{}
+	w.locations = map[string]data.Location{}
 	unilog.Logger().Info("started worker", zap.Int("id", w.id))
 }
 
-func (w *worker) paramsEdit() {
-	for p := range w.paramsCh {
-		w.mu.Lock()
-		w.params = p
-		fixer, err := NewFixer(p.Locations)
-		if err == nil {
-			w.fixer = fixer
-		}
-		w.mu.Unlock()
+func (w *worker) setParams(p Parameters) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.params = p
+	fixer, err := NewFixer(p.Locations)
+	if err == nil {
+		w.fixer = fixer
+	}
+	src, err := source.New(p.Source, p.FeedURL)
+	if err != nil {
+		unilog.Logger().Error("unable to create source", zap.String("source", p.Source),
+			zap.String("feed", p.FeedURL), zap.Error(err))
+	} else {
+		w.source = src
 	}
 }
 
+// start processes entities of the thread until the thread quits. Parameters are changed between
+// entities, so an entity is processed with the same parameters from start to end.
 func (w *worker) start() {
-	for e := range w.inCh {
-		w.proceedLocation(e)
-		time.Sleep(2500 * time.Millisecond)
+	for {
+		select {
+		case p := <-w.paramsCh:
+			w.setParams(p)
+		case e := <-w.inCh:
+			w.proceedEntity(e)
+		case <-w.quit:
+			return
+		}
 	}
 }
 
-func (w *worker) proceedLocation(e entity) {
+// proceedEntity loads the next page of the entity feed with the routine of the crawling type.
+func (w *worker) proceedEntity(e entity) {
+	var err error
 	defer func() {
+		e.err = ""
+		if err != nil {
+			e.err = err.Error()
+		}
 		w.outCh <- e
 	}()
-	e.id = url.QueryEscape(e.id)
-	var req string
-	loadEntity := false
-	if e.checkpoint == "" {
-		req = "https://www.instagram.com/graphql/query/?query_hash=1b84447a4d8b6d6d0426fefb34514485&variables=%7B%22id%22%3A%22" +
-			e.id + "%22%2C%22first%22%3A50%7D"
-		loadEntity = true
-	} else {
-		req = "https://www.instagram.com/graphql/query/?query_hash=1b84447a4d8b6d6d0426fefb34514485&variables=%7B%22id%22%3A%22" +
-			e.id + "%22%2C%22first%22%3A50%2C%22after%22%3A%22" + e.checkpoint + "%22%7D"
+	switch w.params.crawlingType() {
+	case data.ProfilesType:
+		e.finished, e.checkpoint, err = w.proceedProfile(e)
+	case data.HashtagsType:
+		e.finished, e.checkpoint, err = w.proceedTag(e)
+	default:
+		e.finished, e.checkpoint, err = w.proceedLocation(e)
+	}
+}
+
+func (w *worker) proceedLocation(e entity) (bool, string, error) {
+	return w.extractData(w.source.Fetch, w.source.Parse, e.id, e.checkpoint)
+}
+
+// proceedProfile loads the page of the profile feed. Only geotagged posts of the profile are
+// collected, the profile is saved with the first page.
+func (w *worker) proceedProfile(e entity) (bool, string, error) {
+	ps, ok := w.source.(source.ProfileSource)
+	if !ok {
+		unilog.Logger().Error("source doesn't support profiles", zap.String("source", w.params.Source))
+		return true, "", errors.New("source doesn't support profiles")
+	}
+	return w.extractData(ps.FetchProfile, ps.ParseProfile, e.id, e.checkpoint)
+}
+
+// proceedTag loads the page of the tag feed. Only posts inside the area of the session are
+// collected.
+func (w *worker) proceedTag(e entity) (bool, string, error) {
+	ts, ok := w.source.(source.TagSource)
+	if !ok {
+		unilog.Logger().Error("source doesn't support tags", zap.String("source", w.params.Source))
+		return true, "", errors.New("source doesn't support tags")
 	}
-	f, cp := w.extractData(req, loadEntity, e.id)
-	e.finished = f
-	e.checkpoint = cp
+	return w.extractData(ts.FetchTag, ts.ParseTag, e.id, e.checkpoint)
 }
 
-func (w *worker) extractData(req string, loadEntity bool, entityID string) (bool, string) {
-	rawData, err := w.makeRequest(req, true)
+// extractData loads the page of the entity feed after the cursor. It returns true if the feed is
+// read to the end, the cursor of the next page and the error of the page.
+func (w *worker) extractData(fetch fetchFunc, parse parseFunc, entityID, cursor string) (bool, string, error) {
+	rawData, err := fetch(w, entityID, cursor)
 	if err != nil {
-		return false, ""
+		return false, "", err
 	}
-	cursor, hasNext, timestamp, zeroPosts, err := w.proceedResponse(rawData, loadEntity, entityID)
+	page, zeroPosts, err := w.proceedResponse(rawData, parse, cursor == "", entityID)
 	if err != nil {
-		return true, ""
+		return true, "", err
 	}
-	if zeroPosts {
-		//unilog.Logger().Info("zero posts", zap.String("req", req))
-		return true, ""
+	t, tails := w.source.(source.Tailer)
+	if zeroPosts && !(tails && t.Tails()) {
+		//unilog.Logger().Info("zero posts", zap.String("entity", entityID))
+		return true, "", nil
 	}
-	cp := cursor
+	cp := page.Cursor
 	f := false
-	if timestamp < w.params.FinishTimestamp {
-		//unilog.Logger().Info("before finish", zap.String("req", req))
+	if page.Oldest != 0 && page.Oldest < w.params.FinishTimestamp {
+		//unilog.Logger().Info("before finish", zap.String("entity", entityID))
 		f = true
 	}
-	if !hasNext {
+	if !page.HasNext {
 		f = true
 	}
-	return f, cp
+	return f, cp, nil
+}
+
+type fetchFunc func(f source.Fetcher, id, cursor string) ([]byte, error)
+
+type parseFunc func(raw []byte) (source.Page, error)
+
+func geotagged(posts []data.Post) []data.Post {
+	res := make([]data.Post, 0, len(posts))
+	for _, p := range posts {
+		if p.Lat != 0 || p.Lon != 0 {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// maxLookups is the maximum number of requests made to locate posts of a page, posts without
+// coordinates which are left after that are skipped.
+const maxLookups = 20
+
+// anonymousFetcher makes all requests of a source through the proxy pool of the worker.
+type anonymousFetcher struct {
+	w *worker
+}
+
+func (f anonymousFetcher) Get(url string, _ bool) ([]byte, error) {
+	return f.w.makeRequest(url, true)
+}
+
+// areaPosts returns posts inside the area of the session. Tag feeds mostly don't contain
+// coordinates of posts, so locations of posts are loaded if the source can do it.
+func (w *worker) areaPosts(posts []data.Post) []data.Post {
+	res := make([]data.Post, 0, len(posts))
+	lookups := 0
+	for _, p := range posts {
+		if p.Lat == 0 && p.Lon == 0 {
+			p = w.locate(p, &lookups)
+		}
+		if (p.Lat != 0 || p.Lon != 0) && w.params.inArea(p.Lat, p.Lon) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// locate sets coordinates of the post from its location. The location ID is taken from the
+// detailed post if it is unknown, coordinates are taken from locations of the session or from the
+// location feed. Requests are counted in lookups and aren't made after maxLookups of them.
+func (w *worker) locate(p data.Post, lookups *int) data.Post {
+	if p.LocationID == "" {
+		detailer, ok := w.source.(source.PostDetailer)
+		if !ok || *lookups >= maxLookups {
+			return p
+		}
+		*lookups++
+		detailedPost, err := detailer.DetailedPost(anonymousFetcher{w}, p)
+		if err != nil || detailedPost.LocationID == "" {
+			return p
+		}
+		p.LocationID = detailedPost.LocationID
+	}
+	l, ok := w.fixer.loc[p.LocationID]
+	if !ok {
+		l, ok = w.location(p.LocationID, lookups)
+	}
+	if ok {
+		p.Lat, p.Lon = l.Lat, l.Lon
+	}
+	return p
+}
+
+// location returns the location loaded from the first page of its feed. Locations are cached by
+// the worker by ID, including the ones which couldn't be loaded.
+func (w *worker) location(id string, lookups *int) (data.Location, bool) {
+	if l, ok := w.locations[id]; ok {
+		return l, l.ID != ""
+	}
+	var l data.Location
+	if *lookups >= maxLookups {
+		return l, false
+	}
+	*lookups++
+	raw, err := w.source.Fetch(anonymousFetcher{w}, id, "")
+	if err == nil {
+		page, err := w.source.Parse(raw)
+		if err == nil {
+			if pl, ok := page.Entity.(*data.Location); ok {
+				l = *pl
+			}
+		}
+	}
+	// missing locations are cached too to not request them again
+	w.locations[id] = l
+	return l, l.ID != ""
 }
 
 func filterPosts(posts []data.Post, finish int64) []data.Post {
@@ -135,63 +282,148 @@ func filterPosts(posts []data.Post, finish int64) []data.Post {
 	return res
 }
 
+// Get makes requests of the source, anonymous requests are made through Tor.
+func (w *worker) Get(url string, anonymous bool) ([]byte, error) {
+	return w.makeRequest(url, anonymous)
+}
+
+// makeRequest makes the request within the limits of the worker. Requests which got 429 or 5xx
+// responses are retried with backoff, the worker is parked while its breaker is open. A request
+// which got 500 responses on every attempt is made by the authorized client of the group.
 func (w *worker) makeRequest(request string, useTor bool) ([]byte, error) {
+	backoff := w.limits.backoff()
+	for attempt := 0; ; attempt++ {
+		w.park()
+		w.bucket.Wait()
+		code := 0
+		res, err := w.breaker.Execute(func() (interface{}, error) {
+			body, c, err := w.do(request, useTor)
+			code = c
+			if err == nil && limit.Retryable(c) {
+				err = fmt.Errorf("response status %d", c)
+			}
+			return body, err
+		})
+		if err == ErrNoProxy {
+			return nil, err
+		}
+		if err == nil {
+			if code == 404 {
+				msg := "entity page was not found"
+				unilog.Logger().Error(msg, zap.String("URL", request))
+				return nil, errors.New(msg)
+			}
+			if code != 200 {
+				unilog.Logger().Error("unexpected response status", zap.String("URL", request), zap.Int("code", code))
+				return nil, fmt.Errorf("unexpected response status %d", code)
+			}
+			return res.([]byte), nil
+		}
+		if attempt >= w.limits.Retries {
+			if code == 429 {
+				return nil, fmt.Errorf("too many requests from worker %d", w.id)
+			}
+			if code == 500 {
+				// the authorized client is tried once after all attempts of the worker failed
+				return w.cl.makeRequest(request, w.tid)
+			}
+			return nil, err
+		}
+		time.Sleep(backoff.Next())
+	}
+}
+
+// park waits while the breaker of the worker is open, the worker is moved to the best proxy of the
+// pool after that.
+func (w *worker) park() {
+	if w.breaker.State() != gobreaker.StateOpen {
+		return
+	}
+	unilog.Logger().Warn("worker is parked", zap.Int("id", w.id), zap.Int("thread", w.tid),
+		zap.Int64("seconds", w.limits.ParkTime))
+	for w.breaker.State() == gobreaker.StateOpen {
+		time.Sleep(time.Second)
+	}
+	w.mu.Lock()
+	w.proxy = w.pool.Acquire(w.proxy)
+	w.mu.Unlock()
+}
+
+// assignedProxy returns the proxy of the worker, the worker is moved to another proxy if its proxy
+// is unhealthy.
+func (w *worker) assignedProxy() *proxy.Proxy {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.proxy != nil && !w.pool.Healthy(w.proxy) {
+		w.proxy = w.pool.Acquire(w.proxy)
+	}
+	return w.proxy
+}
+
+// do makes the request once and returns the body and the status code of the response.
+func (w *worker) do(request string, useTor bool) ([]byte, int, error) {
 	req, err := http.NewRequest("GET", request, nil)
 	if err != nil {
 		unilog.Logger().Error("unable to create request", zap.String("URL", request), zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
 	req.Header.Set("user-agent", w.agent)
 
-	var resp *http.Response
+	cl := &w.http
+	var px *proxy.Proxy
 	if useTor {
-		resp, err = w.tor.Do(req)
-	} else {
-		resp, err = w.http.Do(req)
+		// anonymous requests are never made directly
+		px = w.assignedProxy()
+		if px == nil {
+			return nil, 0, ErrNoProxy
+		}
+		cl = px.Client()
+	}
+	resp, err := w.tape.Client(wrapClient(cl, w.transport)).Do(req)
+	if px != nil {
+		code := 0
+		if resp != nil {
+			code = resp.StatusCode
+		}
+		w.pool.Report(px, code, err)
 	}
 	if err != nil {
 		unilog.Logger().Error("unable to make request", zap.String("URL", request), zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode == 429 {
-		msg := fmt.Sprintf("too many requests from worker %d", w.id)
-		// unilog.Logger().Error(msg)
-		err = errors.New(msg)
-		//time.Sleep(10 * time.Second)
-		return nil, err
-	}
-	if resp.StatusCode == 500 {
-		return w.cl.makeRequest(request, w.tid)
-	}
-	if resp.StatusCode == 404 {
-		msg := "entity page was not found"
-		unilog.Logger().Error(msg, zap.String("URL", request))
-		err = errors.New(msg)
-		return nil, err
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, nil
 	}
 	body, err := ioutil.ReadAll(resp.Body)
-	return body, nil
+	return body, resp.StatusCode, err
 }
 
-func (w *worker) proceedResponse(d []byte, loadEntity bool, entityID string) (endCursor string, hasNext bool, timestamp int64,
+func (w *worker) proceedResponse(d []byte, parse parseFunc, loadEntity bool, entityID string) (page source.Page,
 	zeroPosts bool, err error) {
-	var posts []data.Post
-	var location data.Location
-	posts, location, endCursor, hasNext, timestamp, err = parser.ParseFromLocationRequest(d)
+	page, err = parse(d)
 	if err != nil {
 		unilog.Logger().Error("error during parsing response",
 			zap.String("data", string(d)), zap.String("entity", entityID), zap.Error(err))
 		return
 	}
-	if loadEntity {
-		w.entitiesCh <- &location
+	if loadEntity && page.Entity != nil {
+		w.entitiesCh <- page.Entity
+	}
+	posts := filterPosts(page.Posts, w.params.FinishTimestamp)
+	// the feed ends when there are no new posts, so posts out of the session are removed after the check
+	zeroPosts = len(posts) == 0
+	if w.params.crawlingType() == data.HashtagsType {
+		posts = w.areaPosts(posts)
 	}
-	if w.params.DetailedPosts {
+	detailer, ok := w.source.(source.PostDetailer)
+	if w.params.DetailedPosts && ok {
 		for i := 0; i < len(posts); i++ {
-			detailedPost, err := w.detailedPost(posts[i])
-			time.Sleep(50 * time.Millisecond)
+			detailedPost, err := detailer.DetailedPost(w, posts[i])
 			if err == nil {
+				if detailedPost.Lat == 0 && detailedPost.Lon == 0 {
+					detailedPost.Lat, detailedPost.Lon = posts[i].Lat, posts[i].Lon
+				}
 				posts[i] = detailedPost
 			}
 		}
@@ -200,7 +432,6 @@ func (w *worker) proceedResponse(d []byte, loadEntity bool, entityID string) (en
 		media := make([]data.Media, len(posts))
 		for i := 0; i < len(posts); i++ {
 			imgData, err := w.makeRequest(posts[i].ImageURL, false)
-			time.Sleep(50 * time.Millisecond)
 			if err != nil {
 				continue
 			}
@@ -214,21 +445,9 @@ func (w *worker) proceedResponse(d []byte, loadEntity bool, entityID string) (en
 	if w.fixer.Init {
 		posts = w.fixer.Fix(posts)
 	}
-	posts = filterPosts(posts, w.params.FinishTimestamp)
+	if w.params.crawlingType() == data.ProfilesType {
+		posts = geotagged(posts)
+	}
 	w.postsCh <- posts
-	zeroPosts = len(posts) == 0
 	return
 }
-
-func (w worker) detailedPost(post data.Post) (data.Post, error) {
-	request := "https://www.instagram.com/Params/" + post.Shortcode + "?__a=1"
-	rawData, err := w.makeRequest(request, false)
-	if err != nil {
-		return data.Post{}, err
-	}
-	detailedPost, err := parser.ParseFromPostRequest(rawData)
-	if err != nil {
-		return data.Post{}, err
-	}
-	return detailedPost, nil
-}
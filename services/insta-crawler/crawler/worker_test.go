@@ -0,0 +1,94 @@
+package crawler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/instatest"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/proxy"
+)
+
+var testLimits = Limits{
+	WorkerRate:      100,
+	WorkerBurst:     10,
+	ClientRate:      100,
+	ClientBurst:     10,
+	MinBackoff:      10,
+	MaxBackoff:      100,
+	Retries:         3,
+	BreakerFailures: 10,
+	ParkTime:        1,
+}
+
+// newTestWorker returns the worker which reads the fake Instagram through it as through a proxy.
+// Channels of the worker are buffered, so a page can be processed without the thread.
+func newTestWorker(t *testing.T, s *instatest.Server, p Parameters) *worker {
+	pool, err := proxy.NewPool([]proxy.Config{{URL: s.URL}}, "", 10, time.Second, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &worker{
+		postsCh:    make(chan []data.Post, 10),
+		entitiesCh: make(chan data.Entity, 10),
+		mediaCh:    make(chan []data.Media, 10),
+		pool:       pool,
+		cl:         newClient("", "", testLimits, nil, s.Wrap),
+		transport:  s.Wrap,
+	}
+	w.init(testLimits)
+	w.setParams(p)
+	return w
+}
+
+func TestWorker_extractData(t *testing.T) {
+	s := instatest.NewServer(map[string]int{"1": 120})
+	defer s.Close()
+	tests := []struct {
+		name     string
+		finish   int64
+		cursor   string
+		throttle int
+		finished bool
+		next     string
+		posts    int
+	}{
+		{name: "first page", next: "1_70", posts: 50},
+		{name: "throttled page", cursor: "1_70", throttle: 2, next: "1_20", posts: 50},
+		{name: "last page", cursor: "1_20", finished: true, next: "1_0", posts: 20},
+		{name: "finish timestamp", finish: instatest.FirstTimestamp + 60*100, finished: true, next: "1_70", posts: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWorker(t, s, Parameters{FinishTimestamp: tt.finish})
+			s.Throttle(tt.throttle)
+			finished, next, err := w.extractData(w.source.Fetch, w.source.Parse, "1", tt.cursor)
+			if err != nil {
+				t.Fatalf("extractData() error = %v", err)
+			}
+			if finished != tt.finished || next != tt.next {
+				t.Errorf("extractData() = %v, %q, want %v, %q", finished, next, tt.finished, tt.next)
+			}
+			if posts := <-w.postsCh; len(posts) != tt.posts {
+				t.Errorf("extractData() sent %v posts, want %v", len(posts), tt.posts)
+			}
+			// the location is sent with the first page only
+			if got := len(w.entitiesCh); (got == 1) != (tt.cursor == "") {
+				t.Errorf("extractData() sent %v entities for the cursor %q", got, tt.cursor)
+			}
+		})
+	}
+	if s.Proxied() != s.Requests() {
+		t.Errorf("Proxied() = %v, want all %v requests through the proxy", s.Proxied(), s.Requests())
+	}
+}
+
+func TestWorker_extractData_notFound(t *testing.T) {
+	s := instatest.NewServer(map[string]int{})
+	defer s.Close()
+	w := newTestWorker(t, s, Parameters{})
+	finished, _, err := w.extractData(w.source.Fetch, w.source.Parse, "1", "")
+	if err == nil || finished {
+		t.Errorf("extractData() = %v, %v, want an unfinished entity with an error", finished, err)
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"go.uber.org/zap"
 )
 
+// Config of the service. Sessions are managed over gRPC at GRPCAddress if it is set.
 type Config struct {
-	Address  string
-	LogPath  string
-	User     string
-	Password string
+	Address     string
+	GRPCAddress string
+	LogPath     string
+	User        string
+	Password    string
 }
 
 func readConfig(path string) (cfg Config, err error) {
@@ -22,3 +22,43 @@ func (s *crawlerService) Stop(id string) (ok bool, err error) {
 	ok, err = s.crawler.Stop(id)
 	return
 }
+
+func (s *crawlerService) List(f crawler.ListFilter) (sessions []crawler.OutStatus, err error) {
+	sessions = s.crawler.List(f)
+	return
+}
+
+func (s *crawlerService) Pause(id string) (ok bool, err error) {
+	ok, err = s.crawler.Pause(id)
+	return
+}
+
+func (s *crawlerService) Resume(id string) (ok bool, err error) {
+	ok, err = s.crawler.Resume(id)
+	return
+}
+
+func (s *crawlerService) Delete(id string) (ok bool, err error) {
+	ok, err = s.crawler.Delete(id)
+	return
+}
+
+func (s *crawlerService) Edit(id string, e crawler.Edit) (ok bool, err error) {
+	ok, err = s.crawler.Edit(id, e)
+	return
+}
+
+func (s *crawlerService) SetPriority(id string, priority int) (ok bool, err error) {
+	ok, err = s.crawler.SetPriority(id, priority)
+	return
+}
+
+func (s *crawlerService) DeadBatches(id string) (batches []crawler.DeadBatch, err error) {
+	batches, err = s.crawler.DeadBatches(id)
+	return
+}
+
+func (s *crawlerService) Requeue(id string, batches []uint64) (n int, err error) {
+	n, err = s.crawler.Requeue(id, batches)
+	return
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler/data"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/proto"
+)
+
+func decodeGRPCIDRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.IDRequest)
+	return IDEpRequest{ID: req.Id}, nil
+}
+
+func decodeGRPCListRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.ListRequest)
+	return listEpRequest{
+		Filter: crawler.ListFilter{
+			Status: req.Status,
+			CityID: req.CityId,
+			Type:   req.Type,
+		},
+	}, nil
+}
+
+func decodeGRPCEditRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.EditRequest)
+	e := crawler.Edit{
+		RemoveLocations: req.RemoveLocations,
+	}
+	for _, l := range req.AddLocations {
+		e.AddLocations = append(e.AddLocations, data.Location{
+			ID:    l.Id,
+			Title: l.Title,
+			Lat:   l.Lat,
+			Lon:   l.Lon,
+			Slug:  l.Slug,
+		})
+	}
+	if req.SetDetailedPosts {
+		e.DetailedPosts = &req.DetailedPosts
+	}
+	if req.SetLoadMedia {
+		e.LoadMedia = &req.LoadMedia
+	}
+	return editEpRequest{ID: req.Id, Edit: e}, nil
+}
+
+func decodeGRPCPriorityRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.PriorityRequest)
+	return priorityEpRequest{ID: req.Id, Priority: int(req.Priority)}, nil
+}
+
+func decodeGRPCRequeueRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.RequeueRequest)
+	return requeueEpRequest{ID: req.Id, Batches: req.Batches}, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler"
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/proto"
+)
+
+func encodeGRPCStatusResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(StatusEpResponse)
+	return &proto.StatusResponse{Status: convertStatus(resp.Status), Err: resp.Error}, nil
+}
+
+func encodeGRPCListResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListEpResponse)
+	res := &proto.ListResponse{Err: resp.Error}
+	for _, s := range resp.Sessions {
+		res.Sessions = append(res.Sessions, convertStatus(s))
+	}
+	return res, nil
+}
+
+// encodeGRPCOkResponse encodes responses of endpoints which change the state of a session.
+func encodeGRPCOkResponse(_ context.Context, response interface{}) (interface{}, error) {
+	switch resp := response.(type) {
+	case StopEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	case PauseEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	case ResumeEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	case DeleteEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	case EditEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	case PriorityEpResponse:
+		return &proto.OkResponse{Ok: resp.Ok, Err: resp.Error}, nil
+	}
+	return &proto.OkResponse{}, nil
+}
+
+func encodeGRPCDeadBatchesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(DeadBatchesEpResponse)
+	res := &proto.DeadBatchesResponse{Err: resp.Error}
+	for _, b := range resp.Batches {
+		res.Batches = append(res.Batches, &proto.DeadBatch{
+			Id:      b.ID,
+			CityId:  b.CityID,
+			Posts:   int64(b.Posts),
+			Created: b.Created,
+			Err:     b.Error,
+		})
+	}
+	return res, nil
+}
+
+func encodeGRPCRequeueResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(RequeueEpResponse)
+	return &proto.RequeueResponse{Requeued: int64(resp.Requeued), Err: resp.Error}, nil
+}
+
+func convertStatus(s crawler.OutStatus) *proto.SessionStatus {
+	res := &proto.SessionStatus{
+		Id:              s.ID,
+		CityId:          s.CityID,
+		Type:            s.Type,
+		Priority:        int64(s.Priority),
+		Status:          s.Status,
+		EntitiesLeft:    int64(s.EntitiesLeft),
+		PostsCollected:  int64(s.PostsCollected),
+		FinishTimestamp: s.FinishTimestamp,
+		LocationPosts:   make(map[string]int64, len(s.LocationPosts)),
+		LastError:       s.LastError,
+		SpoolBatches:    int64(s.Spool.Batches),
+		SpoolPosts:      int64(s.Spool.Posts),
+		SpoolAge:        s.Spool.Age,
+		SpoolDead:       int64(s.Spool.Dead),
+	}
+	for id, n := range s.LocationPosts {
+		res.LocationPosts[id] = int64(n)
+	}
+	for _, px := range s.Proxies {
+		res.Proxies = append(res.Proxies, &proto.ProxyStats{
+			Url:       px.URL,
+			Healthy:   px.Healthy,
+			Workers:   int64(px.Workers),
+			Requests:  px.Requests,
+			Successes: px.Successes,
+			Throttled: px.Throttled,
+			Failures:  px.Failures,
+			Renewals:  px.Renewals,
+		})
+	}
+	return res
+}
@@ -38,3 +38,91 @@ func makeStopEndpoint(svc CrawlerService) endpoint.Endpoint {
 		return StopEpResponse{ok, ""}, nil
 	}
 }
+
+func makeListEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(listEpRequest)
+		sessions, err := svc.List(req.Filter)
+		if err != nil {
+			return ListEpResponse{nil, err.Error()}, nil
+		}
+		return ListEpResponse{sessions, ""}, nil
+	}
+}
+
+func makePauseEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDEpRequest)
+		ok, err := svc.Pause(req.ID)
+		if err != nil {
+			return PauseEpResponse{false, err.Error()}, nil
+		}
+		return PauseEpResponse{ok, ""}, nil
+	}
+}
+
+func makeResumeEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDEpRequest)
+		ok, err := svc.Resume(req.ID)
+		if err != nil {
+			return ResumeEpResponse{false, err.Error()}, nil
+		}
+		return ResumeEpResponse{ok, ""}, nil
+	}
+}
+
+func makeDeleteEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDEpRequest)
+		ok, err := svc.Delete(req.ID)
+		if err != nil {
+			return DeleteEpResponse{false, err.Error()}, nil
+		}
+		return DeleteEpResponse{ok, ""}, nil
+	}
+}
+
+func makeEditEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(editEpRequest)
+		ok, err := svc.Edit(req.ID, req.Edit)
+		if err != nil {
+			return EditEpResponse{false, err.Error()}, nil
+		}
+		return EditEpResponse{ok, ""}, nil
+	}
+}
+
+func makePriorityEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(priorityEpRequest)
+		ok, err := svc.SetPriority(req.ID, req.Priority)
+		if err != nil {
+			return PriorityEpResponse{false, err.Error()}, nil
+		}
+		return PriorityEpResponse{ok, ""}, nil
+	}
+}
+
+func makeDeadBatchesEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDEpRequest)
+		batches, err := svc.DeadBatches(req.ID)
+		if err != nil {
+			return DeadBatchesEpResponse{nil, err.Error()}, nil
+		}
+		return DeadBatchesEpResponse{batches, ""}, nil
+	}
+}
+
+func makeRequeueEndpoint(svc CrawlerService) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req := request.(requeueEpRequest)
+		n, err := svc.Requeue(req.ID, req.Batches)
+		if err != nil {
+			return RequeueEpResponse{0, err.Error()}, nil
+		}
+		return RequeueEpResponse{n, ""}, nil
+	}
+}
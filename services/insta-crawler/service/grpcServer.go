@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/proto"
+	"github.com/go-kit/kit/auth/basic"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/visheratin/unilog"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type server struct {
+	status      grpctransport.Handler
+	list        grpctransport.Handler
+	stop        grpctransport.Handler
+	pause       grpctransport.Handler
+	resume      grpctransport.Handler
+	delete      grpctransport.Handler
+	edit        grpctransport.Handler
+	setPriority grpctransport.Handler
+	deadBatches grpctransport.Handler
+	requeue     grpctransport.Handler
+}
+
+// Server returns the gRPC server of the session management API, it uses the same endpoints as
+// the HTTP transport. Requests are authorized with basic credentials of the user in the
+// "authorization" metadata, as HTTP requests are.
+func Server(svc CrawlerService, user, password string) proto.CrawlerServer {
+	auth := basic.AuthMiddleware(user, password, "realm")
+	before := grpctransport.ServerBefore(populateAuthorization)
+	return &server{
+		status: grpctransport.NewServer(
+			auth(makeStatusEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCStatusResponse,
+			before,
+		),
+		list: grpctransport.NewServer(
+			auth(makeListEndpoint(svc)),
+			decodeGRPCListRequest,
+			encodeGRPCListResponse,
+			before,
+		),
+		stop: grpctransport.NewServer(
+			auth(makeStopEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		pause: grpctransport.NewServer(
+			auth(makePauseEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		resume: grpctransport.NewServer(
+			auth(makeResumeEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		delete: grpctransport.NewServer(
+			auth(makeDeleteEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		edit: grpctransport.NewServer(
+			auth(makeEditEndpoint(svc)),
+			decodeGRPCEditRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		setPriority: grpctransport.NewServer(
+			auth(makePriorityEndpoint(svc)),
+			decodeGRPCPriorityRequest,
+			encodeGRPCOkResponse,
+			before,
+		),
+		deadBatches: grpctransport.NewServer(
+			auth(makeDeadBatchesEndpoint(svc)),
+			decodeGRPCIDRequest,
+			encodeGRPCDeadBatchesResponse,
+			before,
+		),
+		requeue: grpctransport.NewServer(
+			auth(makeRequeueEndpoint(svc)),
+			decodeGRPCRequeueRequest,
+			encodeGRPCRequeueResponse,
+			before,
+		),
+	}
+}
+
+// populateAuthorization puts the authorization metadata of the request to the context, where the
+// basic auth middleware looks for the Authorization header of HTTP requests.
+func populateAuthorization(ctx context.Context, md metadata.MD) context.Context {
+	if auth := md.Get("authorization"); len(auth) > 0 {
+		return context.WithValue(ctx, httptransport.ContextKeyRequestAuthorization, auth[0])
+	}
+	return ctx
+}
+
+func startGRPC(address string, svc CrawlerService, user, password string) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		unilog.Logger().Error("error in transport gRPC Listener", zap.Error(err))
+		return
+	}
+	s := grpc.NewServer(grpc.UnaryInterceptor(grpctransport.Interceptor))
+	proto.RegisterCrawlerServer(s, Server(svc, user, password))
+	err = s.Serve(l)
+	if err != nil {
+		unilog.Logger().Error("error in gRPC server", zap.Error(err))
+	}
+}
+
+func (gs *server) Status(ctx context.Context, req *proto.IDRequest) (*proto.StatusResponse, error) {
+	_, rep, err := gs.status.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.StatusResponse), nil
+}
+
+func (gs *server) List(ctx context.Context, req *proto.ListRequest) (*proto.ListResponse, error) {
+	_, rep, err := gs.list.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.ListResponse), nil
+}
+
+func (gs *server) Stop(ctx context.Context, req *proto.IDRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.stop, req)
+}
+
+func (gs *server) Pause(ctx context.Context, req *proto.IDRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.pause, req)
+}
+
+func (gs *server) Resume(ctx context.Context, req *proto.IDRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.resume, req)
+}
+
+func (gs *server) Delete(ctx context.Context, req *proto.IDRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.delete, req)
+}
+
+func (gs *server) Edit(ctx context.Context, req *proto.EditRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.edit, req)
+}
+
+func (gs *server) SetPriority(ctx context.Context, req *proto.PriorityRequest) (*proto.OkResponse, error) {
+	return serveOk(ctx, gs.setPriority, req)
+}
+
+func (gs *server) DeadBatches(ctx context.Context, req *proto.IDRequest) (*proto.DeadBatchesResponse, error) {
+	_, rep, err := gs.deadBatches.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.DeadBatchesResponse), nil
+}
+
+func (gs *server) Requeue(ctx context.Context, req *proto.RequeueRequest) (*proto.RequeueResponse, error) {
+	_, rep, err := gs.requeue.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.RequeueResponse), nil
+}
+
+func serveOk(ctx context.Context, h grpctransport.Handler, req interface{}) (*proto.OkResponse, error) {
+	_, rep, err := h.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.OkResponse), nil
+}
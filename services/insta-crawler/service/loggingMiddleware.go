@@ -46,3 +46,102 @@ func (mw *loggingMiddleware) Stop(id string) (ok bool, err error) {
 	ok, err = mw.next.Stop(id)
 	return
 }
+
+func (mw *loggingMiddleware) List(f crawler.ListFilter) (sessions []crawler.OutStatus, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("list sessions",
+			zap.Any("filter", f),
+			zap.Int("sessions", len(sessions)),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	sessions, err = mw.next.List(f)
+	return
+}
+
+func (mw *loggingMiddleware) Pause(id string) (ok bool, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session pause",
+			zap.String("id", id),
+			zap.Bool("paused", ok),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	ok, err = mw.next.Pause(id)
+	return
+}
+
+func (mw *loggingMiddleware) Resume(id string) (ok bool, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session resume",
+			zap.String("id", id),
+			zap.Bool("resumed", ok),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	ok, err = mw.next.Resume(id)
+	return
+}
+
+func (mw *loggingMiddleware) Delete(id string) (ok bool, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session delete",
+			zap.String("id", id),
+			zap.Bool("deleted", ok),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	ok, err = mw.next.Delete(id)
+	return
+}
+
+func (mw *loggingMiddleware) Edit(id string, e crawler.Edit) (ok bool, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session edit",
+			zap.String("id", id),
+			zap.Any("edit", e),
+			zap.Bool("edited", ok),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	ok, err = mw.next.Edit(id, e)
+	return
+}
+
+func (mw *loggingMiddleware) SetPriority(id string, priority int) (ok bool, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session priority",
+			zap.String("id", id),
+			zap.Int("priority", priority),
+			zap.Bool("updated", ok),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	ok, err = mw.next.SetPriority(id, priority)
+	return
+}
+
+func (mw *loggingMiddleware) DeadBatches(id string) (batches []crawler.DeadBatch, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session dead batches",
+			zap.String("id", id),
+			zap.Int("batches", len(batches)),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	batches, err = mw.next.DeadBatches(id)
+	return
+}
+
+func (mw *loggingMiddleware) Requeue(id string, batches []uint64) (n int, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Info("session requeue",
+			zap.String("id", id),
+			zap.Uint64s("batches", batches),
+			zap.Int("requeued", n),
+			zap.Error(err),
+			zap.String("took", time.Since(begin).String()))
+	}(time.Now())
+	n, err = mw.next.Requeue(id, batches)
+	return
+}
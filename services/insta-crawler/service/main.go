@@ -42,12 +42,63 @@ func Start(confPath string, cr *crawler.Crawler) {
 		encodeResponse,
 		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	))
+	r.Methods("GET").Path("/sessions").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeListEndpoint(svc)),
+		decodeListRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
 	r.Methods("POST").Path("/stop").Handler(httptransport.NewServer(
 		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeStopEndpoint(svc)),
-		decodeStopRequest,
+		decodeIDRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/pause").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makePauseEndpoint(svc)),
+		decodeIDRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/resume").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeResumeEndpoint(svc)),
+		decodeIDRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/delete").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeDeleteEndpoint(svc)),
+		decodeIDRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/edit").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeEditEndpoint(svc)),
+		decodeEditRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/priority").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makePriorityEndpoint(svc)),
+		decodePriorityRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("GET").Path("/dead/{id}").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeDeadBatchesEndpoint(svc)),
+		decodeStatusRequest,
+		encodeResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	))
+	r.Methods("POST").Path("/requeue").Handler(httptransport.NewServer(
+		basic.AuthMiddleware(conf.User, conf.Password, "realm")(makeRequeueEndpoint(svc)),
+		decodeRequeueRequest,
 		encodeResponse,
 		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	))
+	if conf.GRPCAddress != "" {
+		go startGRPC(conf.GRPCAddress, svc, conf.User, conf.Password)
+	}
 	http.Handle("/", r)
 	err = http.ListenAndServe(conf.Address, nil)
 	if err != nil {
@@ -102,7 +153,7 @@ func decodePostsRequest(_ context.Context, r *http.Request) (interface{}, error)
 	}, nil
 }
 
-func decodeStopRequest(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var req IDEpRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, err
@@ -110,6 +161,41 @@ func decodeStopRequest(_ context.Context, r *http.Request) (interface{}, error)
 	return req, nil
 }
 
+func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	return listEpRequest{
+		Filter: crawler.ListFilter{
+			Status: q.Get("status"),
+			CityID: q.Get("city"),
+			Type:   q.Get("type"),
+		},
+	}, nil
+}
+
+func decodeEditRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req editEpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodePriorityRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req priorityEpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeRequeueRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req requeueEpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	return json.NewEncoder(w).Encode(response)
 }
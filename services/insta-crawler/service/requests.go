@@ -1,5 +1,9 @@
 package service
 
+import (
+	"github.com/angrymuskrat/event-monitoring-system/services/insta-crawler/crawler"
+)
+
 type IDEpRequest struct {
 	ID string `json:"id"`
 }
@@ -9,3 +13,22 @@ type postsEpRequest struct {
 	Offset string `json:"offset"`
 	Num    int    `json:"num"`
 }
+
+type listEpRequest struct {
+	Filter crawler.ListFilter
+}
+
+type editEpRequest struct {
+	ID   string       `json:"id"`
+	Edit crawler.Edit `json:"edit"`
+}
+
+type priorityEpRequest struct {
+	ID       string `json:"id"`
+	Priority int    `json:"priority"`
+}
+
+type requeueEpRequest struct {
+	ID      string   `json:"id"`
+	Batches []uint64 `json:"batches"`
+}
@@ -30,3 +30,43 @@ type postsEpResponse struct {
 	Cursor string      `json:"cursor"`
 	Error  string      `json:"error,omitempty"`
 }
+
+type ListEpResponse struct {
+	Sessions []crawler.OutStatus `json:"sessions"`
+	Error    string              `json:"error,omitempty"`
+}
+
+type PauseEpResponse struct {
+	Ok    bool   `json:"paused"`
+	Error string `json:"error,omitempty"`
+}
+
+type ResumeEpResponse struct {
+	Ok    bool   `json:"resumed"`
+	Error string `json:"error,omitempty"`
+}
+
+type DeleteEpResponse struct {
+	Ok    bool   `json:"deleted"`
+	Error string `json:"error,omitempty"`
+}
+
+type EditEpResponse struct {
+	Ok    bool   `json:"edited"`
+	Error string `json:"error,omitempty"`
+}
+
+type PriorityEpResponse struct {
+	Ok    bool   `json:"updated"`
+	Error string `json:"error,omitempty"`
+}
+
+type DeadBatchesEpResponse struct {
+	Batches []crawler.DeadBatch `json:"batches"`
+	Error   string              `json:"error,omitempty"`
+}
+
+type RequeueEpResponse struct {
+	Requeued int    `json:"requeued"`
+	Error    string `json:"error,omitempty"`
+}
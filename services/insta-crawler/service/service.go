@@ -7,5 +7,13 @@ import (
 type CrawlerService interface {
 	New(p crawler.Parameters) (string, error)
 	Status(id string) (crawler.OutStatus, error)
+	List(f crawler.ListFilter) ([]crawler.OutStatus, error)
 	Stop(id string) (bool, error)
+	Pause(id string) (bool, error)
+	Resume(id string) (bool, error)
+	Delete(id string) (bool, error)
+	Edit(id string, e crawler.Edit) (bool, error)
+	SetPriority(id string, priority int) (bool, error)
+	DeadBatches(id string) ([]crawler.DeadBatch, error)
+	Requeue(id string, batches []uint64) (int, error)
 }
@@ -507,15 +507,25 @@ func (m *Point) GetLon() float64 {
 }
 
 type Event struct {
-	Center               Point    `protobuf:"bytes,1,opt,name=Center,proto3" json:"Center"`
-	PostCodes            []string `protobuf:"bytes,2,rep,name=PostCodes,proto3" json:"PostCodes,omitempty"`
-	Tags                 []string `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	Title                string   `protobuf:"bytes,4,opt,name=Title,proto3" json:"Title,omitempty"`
-	Start                int64    `protobuf:"varint,5,opt,name=Start,proto3" json:"Start,omitempty"`
-	Finish               int64    `protobuf:"varint,6,opt,name=Finish,proto3" json:"Finish,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Center    Point           `protobuf:"bytes,1,opt,name=Center,proto3" json:"Center"`
+	PostCodes []string        `protobuf:"bytes,2,rep,name=PostCodes,proto3" json:"PostCodes,omitempty"`
+	Tags      []string        `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Title     string          `protobuf:"bytes,4,opt,name=Title,proto3" json:"Title,omitempty"`
+	Start     int64           `protobuf:"varint,5,opt,name=Start,proto3" json:"Start,omitempty"`
+	Finish    int64           `protobuf:"varint,6,opt,name=Finish,proto3" json:"Finish,omitempty"`
+	Filtered  FilterStats     `protobuf:"bytes,7,opt,name=Filtered,proto3" json:"Filtered"`
+	Params    DetectionParams `protobuf:"bytes,8,opt,name=Params,proto3" json:"Params"`
+	// TopPosts are shortcodes of the most representative posts by engagement and closeness to the center.
+	TopPosts []string `protobuf:"bytes,9,rep,name=TopPosts,proto3" json:"TopPosts,omitempty"`
+	Authors  int64    `protobuf:"varint,10,opt,name=Authors,proto3" json:"Authors,omitempty"`
+	Likes    int64    `protobuf:"varint,11,opt,name=Likes,proto3" json:"Likes,omitempty"`
+	Comments int64    `protobuf:"varint,12,opt,name=Comments,proto3" json:"Comments,omitempty"`
+	// Location is the title of the most common location of the event posts.
+	Location             string    `protobuf:"bytes,13,opt,name=Location,proto3" json:"Location,omitempty"`
+	Footprint            Footprint `protobuf:"bytes,14,opt,name=Footprint,proto3" json:"Footprint"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
@@ -593,26 +603,88 @@ func (m *Event) GetFinish() int64 {
 	return 0
 }
 
-type AggregatedPost struct {
-	Center               Point    `protobuf:"bytes,1,opt,name=Center,proto3" json:"c"`
-	Count                int64    `protobuf:"varint,2,opt,name=Count,proto3" json:"n"`
+func (m *Event) GetFiltered() FilterStats {
+	if m != nil {
+		return m.Filtered
+	}
+	return FilterStats{}
+}
+
+func (m *Event) GetParams() DetectionParams {
+	if m != nil {
+		return m.Params
+	}
+	return DetectionParams{}
+}
+
+func (m *Event) GetTopPosts() []string {
+	if m != nil {
+		return m.TopPosts
+	}
+	return nil
+}
+
+func (m *Event) GetAuthors() int64 {
+	if m != nil {
+		return m.Authors
+	}
+	return 0
+}
+
+func (m *Event) GetLikes() int64 {
+	if m != nil {
+		return m.Likes
+	}
+	return 0
+}
+
+func (m *Event) GetComments() int64 {
+	if m != nil {
+		return m.Comments
+	}
+	return 0
+}
+
+func (m *Event) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Event) GetFootprint() Footprint {
+	if m != nil {
+		return m.Footprint
+	}
+	return Footprint{}
+}
+
+// Footprint is the spatial extent of an event. Geometry is the GeoJSON geometry of the convex hull
+// of the event posts: a polygon, or a line or a point if the posts are collinear. BBox is the
+// bounding box of the posts, Radius is the largest distance from the center to a post in meters
+// and Cell holds the bounds of the ConvTree leaf the event was found in.
+type Footprint struct {
+	Geometry             string   `protobuf:"bytes,1,opt,name=Geometry,proto3" json:"Geometry,omitempty"`
+	BBox                 Area     `protobuf:"bytes,2,opt,name=BBox,proto3" json:"BBox"`
+	Radius               float64  `protobuf:"fixed64,3,opt,name=Radius,proto3" json:"Radius,omitempty"`
+	Cell                 Area     `protobuf:"bytes,4,opt,name=Cell,proto3" json:"Cell"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AggregatedPost) Reset()         { *m = AggregatedPost{} }
-func (m *AggregatedPost) String() string { return proto.CompactTextString(m) }
-func (*AggregatedPost) ProtoMessage()    {}
-func (*AggregatedPost) Descriptor() ([]byte, []int) {
+func (m *Footprint) Reset()         { *m = Footprint{} }
+func (m *Footprint) String() string { return proto.CompactTextString(m) }
+func (*Footprint) ProtoMessage()    {}
+func (*Footprint) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{7}
 }
-func (m *AggregatedPost) XXX_Unmarshal(b []byte) error {
+func (m *Footprint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AggregatedPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Footprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AggregatedPost.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Footprint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -622,53 +694,84 @@ func (m *AggregatedPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AggregatedPost) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregatedPost.Merge(m, src)
+func (m *Footprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Footprint.Merge(m, src)
 }
-func (m *AggregatedPost) XXX_Size() int {
+func (m *Footprint) XXX_Size() int {
 	return m.Size()
 }
-func (m *AggregatedPost) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregatedPost.DiscardUnknown(m)
+func (m *Footprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Footprint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregatedPost proto.InternalMessageInfo
+var xxx_messageInfo_Footprint proto.InternalMessageInfo
 
-func (m *AggregatedPost) GetCenter() Point {
+func (m *Footprint) GetGeometry() string {
 	if m != nil {
-		return m.Center
+		return m.Geometry
 	}
-	return Point{}
+	return ""
 }
 
-func (m *AggregatedPost) GetCount() int64 {
+func (m *Footprint) GetBBox() Area {
 	if m != nil {
-		return m.Count
+		return m.BBox
+	}
+	return Area{}
+}
+
+func (m *Footprint) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
 	}
 	return 0
 }
 
-type Timestamp struct {
-	Time                 int64    `protobuf:"varint,1,opt,name=Time,proto3" json:"time"`
-	PostsNumber          int64    `protobuf:"varint,2,opt,name=PostsNumber,proto3" json:"posts"`
-	EventsNumber         int64    `protobuf:"varint,3,opt,name=EventsNumber,proto3" json:"events"`
+func (m *Footprint) GetCell() Area {
+	if m != nil {
+		return m.Cell
+	}
+	return Area{}
+}
+
+// DetectionParams holds parameters of the event detection algorithm. Zero values mean defaults.
+// MaxPoints is the number of posts in a grid cell starting from which it is checked for events,
+// MinXLength, MinYLength, MaxDepth, ConvNumber and ConvGridSize set up the historic ConvTree.
+// An event must have at least MinUsers authors and at least MaxPoints*UsersShare of them.
+// TopPosts and MaxTags limit the numbers of representative posts and tags of an event.
+// Events are searched in windows of WindowLength seconds started every WindowStride seconds.
+// Language is the ISO 639-1 code of the language of the city which selects the stoplist of tags.
+type DetectionParams struct {
+	MaxPoints            int32    `protobuf:"varint,1,opt,name=MaxPoints,proto3" json:"MaxPoints,omitempty"`
+	MinXLength           float64  `protobuf:"fixed64,2,opt,name=MinXLength,proto3" json:"MinXLength,omitempty"`
+	MinYLength           float64  `protobuf:"fixed64,3,opt,name=MinYLength,proto3" json:"MinYLength,omitempty"`
+	MaxDepth             int32    `protobuf:"varint,4,opt,name=MaxDepth,proto3" json:"MaxDepth,omitempty"`
+	ConvNumber           int32    `protobuf:"varint,5,opt,name=ConvNumber,proto3" json:"ConvNumber,omitempty"`
+	ConvGridSize         int32    `protobuf:"varint,6,opt,name=ConvGridSize,proto3" json:"ConvGridSize,omitempty"`
+	MinUsers             int32    `protobuf:"varint,7,opt,name=MinUsers,proto3" json:"MinUsers,omitempty"`
+	UsersShare           float64  `protobuf:"fixed64,8,opt,name=UsersShare,proto3" json:"UsersShare,omitempty"`
+	TopPosts             int32    `protobuf:"varint,9,opt,name=TopPosts,proto3" json:"TopPosts,omitempty"`
+	MaxTags              int32    `protobuf:"varint,10,opt,name=MaxTags,proto3" json:"MaxTags,omitempty"`
+	WindowLength         int64    `protobuf:"varint,11,opt,name=WindowLength,proto3" json:"WindowLength,omitempty"`
+	WindowStride         int64    `protobuf:"varint,12,opt,name=WindowStride,proto3" json:"WindowStride,omitempty"`
+	Language             string   `protobuf:"bytes,13,opt,name=Language,proto3" json:"Language,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Timestamp) Reset()         { *m = Timestamp{} }
-func (m *Timestamp) String() string { return proto.CompactTextString(m) }
-func (*Timestamp) ProtoMessage()    {}
-func (*Timestamp) Descriptor() ([]byte, []int) {
+func (m *DetectionParams) Reset()         { *m = DetectionParams{} }
+func (m *DetectionParams) String() string { return proto.CompactTextString(m) }
+func (*DetectionParams) ProtoMessage()    {}
+func (*DetectionParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{8}
 }
-func (m *Timestamp) XXX_Unmarshal(b []byte) error {
+func (m *DetectionParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Timestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetectionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Timestamp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetectionParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -678,61 +781,132 @@ func (m *Timestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Timestamp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Timestamp.Merge(m, src)
+func (m *DetectionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectionParams.Merge(m, src)
 }
-func (m *Timestamp) XXX_Size() int {
+func (m *DetectionParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *Timestamp) XXX_DiscardUnknown() {
-	xxx_messageInfo_Timestamp.DiscardUnknown(m)
+func (m *DetectionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectionParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Timestamp proto.InternalMessageInfo
+var xxx_messageInfo_DetectionParams proto.InternalMessageInfo
 
-func (m *Timestamp) GetTime() int64 {
+func (m *DetectionParams) GetMaxPoints() int32 {
 	if m != nil {
-		return m.Time
+		return m.MaxPoints
 	}
 	return 0
 }
 
-func (m *Timestamp) GetPostsNumber() int64 {
+func (m *DetectionParams) GetMinXLength() float64 {
 	if m != nil {
-		return m.PostsNumber
+		return m.MinXLength
 	}
 	return 0
 }
 
-func (m *Timestamp) GetEventsNumber() int64 {
+func (m *DetectionParams) GetMinYLength() float64 {
 	if m != nil {
-		return m.EventsNumber
+		return m.MinYLength
 	}
 	return 0
 }
 
-type Location struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title                string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Position             Point    `protobuf:"bytes,3,opt,name=Position,proto3" json:"Position"`
-	Slug                 string   `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+func (m *DetectionParams) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetConvNumber() int32 {
+	if m != nil {
+		return m.ConvNumber
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetConvGridSize() int32 {
+	if m != nil {
+		return m.ConvGridSize
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetMinUsers() int32 {
+	if m != nil {
+		return m.MinUsers
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetUsersShare() float64 {
+	if m != nil {
+		return m.UsersShare
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetTopPosts() int32 {
+	if m != nil {
+		return m.TopPosts
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetMaxTags() int32 {
+	if m != nil {
+		return m.MaxTags
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetWindowLength() int64 {
+	if m != nil {
+		return m.WindowLength
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetWindowStride() int64 {
+	if m != nil {
+		return m.WindowStride
+	}
+	return 0
+}
+
+func (m *DetectionParams) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+// FilterStats holds numbers of posts near an event which were removed before detection by reason.
+type FilterStats struct {
+	Bots                 int64    `protobuf:"varint,1,opt,name=Bots,proto3" json:"Bots,omitempty"`
+	Ads                  int64    `protobuf:"varint,2,opt,name=Ads,proto3" json:"Ads,omitempty"`
+	RateLimited          int64    `protobuf:"varint,3,opt,name=RateLimited,proto3" json:"RateLimited,omitempty"`
+	Duplicates           int64    `protobuf:"varint,4,opt,name=Duplicates,proto3" json:"Duplicates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Location) Reset()         { *m = Location{} }
-func (m *Location) String() string { return proto.CompactTextString(m) }
-func (*Location) ProtoMessage()    {}
-func (*Location) Descriptor() ([]byte, []int) {
+func (m *FilterStats) Reset()         { *m = FilterStats{} }
+func (m *FilterStats) String() string { return proto.CompactTextString(m) }
+func (*FilterStats) ProtoMessage()    {}
+func (*FilterStats) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{9}
 }
-func (m *Location) XXX_Unmarshal(b []byte) error {
+func (m *FilterStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FilterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FilterStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -742,67 +916,67 @@ func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Location) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Location.Merge(m, src)
+func (m *FilterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterStats.Merge(m, src)
 }
-func (m *Location) XXX_Size() int {
+func (m *FilterStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *Location) XXX_DiscardUnknown() {
-	xxx_messageInfo_Location.DiscardUnknown(m)
+func (m *FilterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Location proto.InternalMessageInfo
+var xxx_messageInfo_FilterStats proto.InternalMessageInfo
 
-func (m *Location) GetID() string {
+func (m *FilterStats) GetBots() int64 {
 	if m != nil {
-		return m.ID
+		return m.Bots
 	}
-	return ""
+	return 0
 }
 
-func (m *Location) GetTitle() string {
+func (m *FilterStats) GetAds() int64 {
 	if m != nil {
-		return m.Title
+		return m.Ads
 	}
-	return ""
+	return 0
 }
 
-func (m *Location) GetPosition() Point {
+func (m *FilterStats) GetRateLimited() int64 {
 	if m != nil {
-		return m.Position
+		return m.RateLimited
 	}
-	return Point{}
+	return 0
 }
 
-func (m *Location) GetSlug() string {
+func (m *FilterStats) GetDuplicates() int64 {
 	if m != nil {
-		return m.Slug
+		return m.Duplicates
 	}
-	return ""
+	return 0
 }
 
-type City struct {
-	Title                string   `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
-	Code                 string   `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
-	Area                 Area     `protobuf:"bytes,3,opt,name=Area,proto3" json:"Area"`
+// Bot is an author whose posts are excluded from event detection.
+type Bot struct {
+	AuthorID             string   `protobuf:"bytes,1,opt,name=AuthorID,proto3" json:"AuthorID,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *City) Reset()         { *m = City{} }
-func (m *City) String() string { return proto.CompactTextString(m) }
-func (*City) ProtoMessage()    {}
-func (*City) Descriptor() ([]byte, []int) {
+func (m *Bot) Reset()         { *m = Bot{} }
+func (m *Bot) String() string { return proto.CompactTextString(m) }
+func (*Bot) ProtoMessage()    {}
+func (*Bot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ac8e6d38f431921d, []int{10}
 }
-func (m *City) XXX_Unmarshal(b []byte) error {
+func (m *Bot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *City) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Bot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_City.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Bot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -812,640 +986,724 @@ func (m *City) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *City) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_City.Merge(m, src)
+func (m *Bot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bot.Merge(m, src)
 }
-func (m *City) XXX_Size() int {
+func (m *Bot) XXX_Size() int {
 	return m.Size()
 }
-func (m *City) XXX_DiscardUnknown() {
-	xxx_messageInfo_City.DiscardUnknown(m)
+func (m *Bot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_City proto.InternalMessageInfo
+var xxx_messageInfo_Bot proto.InternalMessageInfo
 
-func (m *City) GetTitle() string {
+func (m *Bot) GetAuthorID() string {
 	if m != nil {
-		return m.Title
+		return m.AuthorID
 	}
 	return ""
 }
 
-func (m *City) GetCode() string {
+func (m *Bot) GetReason() string {
 	if m != nil {
-		return m.Code
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *City) GetArea() Area {
-	if m != nil {
-		return m.Area
-	}
-	return Area{}
+type AggregatedPost struct {
+	Center               Point    `protobuf:"bytes,1,opt,name=Center,proto3" json:"c"`
+	Count                int64    `protobuf:"varint,2,opt,name=Count,proto3" json:"n"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterType((*Post)(nil), "data.Post")
-	proto.RegisterType((*ShortPost)(nil), "data.ShortPost")
-	proto.RegisterType((*Area)(nil), "data.Area")
-	proto.RegisterType((*SpatioTemporalInterval)(nil), "data.SpatioTemporalInterval")
-	proto.RegisterType((*SpatioHourInterval)(nil), "data.SpatioHourInterval")
-	proto.RegisterType((*Point)(nil), "data.Point")
-	proto.RegisterType((*Event)(nil), "data.Event")
-	proto.RegisterType((*AggregatedPost)(nil), "data.AggregatedPost")
-	proto.RegisterType((*Timestamp)(nil), "data.Timestamp")
-	proto.RegisterType((*Location)(nil), "data.Location")
-	proto.RegisterType((*City)(nil), "data.City")
+func (m *AggregatedPost) Reset()         { *m = AggregatedPost{} }
+func (m *AggregatedPost) String() string { return proto.CompactTextString(m) }
+func (*AggregatedPost) ProtoMessage()    {}
+func (*AggregatedPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{11}
 }
-
-func init() { proto.RegisterFile("proto/data.proto", fileDescriptor_ac8e6d38f431921d) }
-
-var fileDescriptor_ac8e6d38f431921d = []byte{
-	// 749 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc7, 0xeb, 0xaf, 0xc4, 0x39, 0x69, 0xab, 0x6a, 0x74, 0x55, 0x8d, 0x7a, 0x7b, 0x93, 0x28,
-	0xea, 0x15, 0x41, 0xa8, 0xa9, 0x54, 0x96, 0xac, 0xf2, 0x01, 0xc2, 0x52, 0xa8, 0x2a, 0x37, 0x74,
-	0xc7, 0x62, 0xda, 0x0c, 0x8e, 0x45, 0xec, 0x89, 0xec, 0x49, 0x05, 0x2f, 0x00, 0xaf, 0xc0, 0x23,
-	0xf0, 0x28, 0x5d, 0xf2, 0x00, 0x28, 0x42, 0x65, 0x97, 0xa7, 0x40, 0x73, 0xc6, 0x71, 0x9c, 0x7e,
-	0xc1, 0xa6, 0x3a, 0xe7, 0xff, 0x9f, 0xce, 0x39, 0xf3, 0x9b, 0x33, 0x0e, 0xec, 0x4c, 0x13, 0x21,
-	0xc5, 0xd1, 0x88, 0x49, 0xd6, 0xc6, 0x90, 0xd8, 0x2a, 0xde, 0xfb, 0x4f, 0xfd, 0x3d, 0x4c, 0xa5,
-	0x48, 0x58, 0xc0, 0x8f, 0xf4, 0xa2, 0x40, 0x04, 0x42, 0x2f, 0x6a, 0xfe, 0x30, 0xc1, 0x3e, 0x15,
-	0xa9, 0x24, 0xdb, 0x60, 0x7a, 0x7d, 0x6a, 0x34, 0x8c, 0x56, 0xc5, 0x37, 0xbd, 0x3e, 0xd9, 0x87,
-	0xca, 0xd9, 0x58, 0x24, 0xf2, 0x52, 0x8c, 0x38, 0x35, 0x51, 0x5e, 0x09, 0x64, 0x0f, 0x5c, 0x2f,
-	0x62, 0x01, 0x7f, 0xeb, 0x0f, 0xa8, 0x85, 0x66, 0x9e, 0x13, 0x0a, 0x65, 0x2f, 0x3d, 0x0f, 0x47,
-	0x5c, 0x50, 0xbb, 0x61, 0xb4, 0x5c, 0x7f, 0x99, 0x2a, 0xa7, 0xc7, 0xa6, 0x32, 0x14, 0x31, 0x75,
-	0xf0, 0x9f, 0x96, 0x29, 0x39, 0x80, 0xad, 0x9e, 0x88, 0x22, 0x1e, 0xcb, 0xb4, 0x27, 0x66, 0xb1,
-	0xa4, 0xa5, 0x86, 0xd1, 0xb2, 0xfc, 0x75, 0x51, 0xf5, 0x34, 0x0c, 0x23, 0x9e, 0x4a, 0x16, 0x4d,
-	0x69, 0x19, 0x57, 0xac, 0x04, 0x52, 0x03, 0x18, 0x84, 0x1f, 0x78, 0xb6, 0x81, 0x8b, 0x76, 0x41,
-	0x21, 0x04, 0x6c, 0x2f, 0xed, 0x8c, 0x68, 0x05, 0x9b, 0xc2, 0x58, 0x9d, 0xa3, 0x33, 0x93, 0x63,
-	0x91, 0x78, 0x7d, 0x0a, 0xfa, 0x1c, 0xcb, 0x1c, 0xf7, 0x13, 0x97, 0x4c, 0xf5, 0xe7, 0xf5, 0x69,
-	0x15, 0xdd, 0x82, 0x42, 0x76, 0xc0, 0x1a, 0x30, 0x49, 0x37, 0x1b, 0x46, 0xcb, 0xf0, 0x55, 0x88,
-	0x8a, 0x88, 0xe9, 0x56, 0xa6, 0x88, 0xb8, 0xf9, 0xc5, 0xcc, 0x30, 0x22, 0xe3, 0x35, 0xa6, 0xc6,
-	0x6d, 0xa6, 0x05, 0x3a, 0xe6, 0x1f, 0xe8, 0x58, 0xf7, 0xd1, 0x59, 0x3f, 0xbf, 0x7d, 0xe7, 0xfc,
-	0x6b, 0xf4, 0x9c, 0xdb, 0xf4, 0x8a, 0x24, 0x4a, 0x8f, 0x92, 0x28, 0x3f, 0x44, 0xc2, 0xbd, 0x43,
-	0xa2, 0xb2, 0x22, 0x71, 0x0e, 0x76, 0x27, 0xe1, 0x8c, 0xfc, 0x0f, 0xe5, 0xa1, 0x98, 0x0e, 0xf8,
-	0x7b, 0x89, 0x04, 0xaa, 0xc7, 0xd5, 0x36, 0xce, 0xec, 0xa9, 0x08, 0x63, 0xe9, 0x2f, 0x3d, 0xf2,
-	0x04, 0xdc, 0xae, 0x90, 0x7e, 0x18, 0x8c, 0x25, 0xd2, 0xb8, 0xb5, 0x2e, 0x37, 0x9b, 0x09, 0xec,
-	0x9e, 0x4d, 0x55, 0x23, 0x43, 0x1e, 0x4d, 0x45, 0xc2, 0x26, 0x5e, 0x2c, 0x79, 0x72, 0xc5, 0x26,
-	0x8a, 0xe7, 0x9b, 0x30, 0x56, 0x27, 0xc4, 0x4a, 0x96, 0xbf, 0x4c, 0xd1, 0x61, 0x1f, 0xd1, 0x31,
-	0x33, 0x47, 0xa7, 0xe4, 0x40, 0x77, 0x89, 0x80, 0xab, 0xc7, 0xa0, 0x4b, 0x2a, 0xa5, 0x6b, 0x5f,
-	0xcf, 0xeb, 0x1b, 0x3e, 0xba, 0xcd, 0x13, 0x20, 0xba, 0xe6, 0x6b, 0x31, 0x4b, 0xf2, 0x7a, 0x04,
-	0x6c, 0x95, 0x67, 0xc5, 0x30, 0xce, 0xf7, 0x33, 0x1f, 0xdd, 0xef, 0x05, 0x38, 0x78, 0x2c, 0x42,
-	0x35, 0x48, 0xb5, 0x83, 0xd1, 0x2d, 0x2d, 0xe6, 0x75, 0x73, 0x22, 0x35, 0x50, 0xaa, 0x81, 0x9a,
-	0x05, 0x27, 0xd6, 0x60, 0xbf, 0x19, 0xe0, 0xbc, 0xbc, 0xe2, 0xb1, 0x24, 0x4f, 0xa1, 0xd4, 0xe3,
-	0xaa, 0x9b, 0x7b, 0xc8, 0x66, 0xf5, 0xb2, 0x05, 0x6a, 0x16, 0xd4, 0x44, 0xf6, 0xc4, 0x88, 0xa7,
-	0xd4, 0x6c, 0x58, 0x6a, 0x12, 0x73, 0x41, 0x9d, 0x64, 0xc8, 0x82, 0x94, 0x5a, 0x68, 0x60, 0x4c,
-	0xfe, 0x01, 0x67, 0x18, 0xca, 0x09, 0xc7, 0xc1, 0xaa, 0xf8, 0x3a, 0x51, 0xea, 0x99, 0x64, 0x89,
-	0xcc, 0xe6, 0x49, 0x27, 0x64, 0x17, 0x4a, 0xaf, 0xc2, 0x38, 0x4c, 0xc7, 0xd9, 0x33, 0xce, 0xb2,
-	0xe6, 0x3b, 0xd8, 0xee, 0x04, 0x41, 0xc2, 0x03, 0x26, 0xf9, 0x08, 0x5f, 0x44, 0xfb, 0xb1, 0x96,
-	0x2b, 0xaa, 0xe5, 0xc5, 0xbc, 0x6e, 0x5c, 0xe6, 0x7d, 0xff, 0x0b, 0x8e, 0x1e, 0x6f, 0xbc, 0xb7,
-	0xae, 0xa3, 0xdc, 0xd8, 0xd7, 0x5a, 0xf3, 0xb3, 0x51, 0x98, 0x70, 0xb2, 0x0f, 0xf6, 0xea, 0xee,
-	0xbb, 0xee, 0x62, 0x5e, 0xb7, 0x65, 0x18, 0x71, 0x1f, 0x55, 0xf2, 0x0c, 0xaa, 0xaa, 0x81, 0xf4,
-	0x64, 0x16, 0x5d, 0xf0, 0x24, 0xdb, 0xae, 0xb2, 0x98, 0xd7, 0x9d, 0xa9, 0x92, 0xfd, 0xa2, 0x4b,
-	0xda, 0xb0, 0x89, 0x84, 0x97, 0xab, 0xf1, 0xf9, 0x75, 0x61, 0x31, 0xaf, 0x97, 0x38, 0xea, 0xfe,
-	0x9a, 0xdf, 0x4c, 0xc1, 0x5d, 0xbe, 0x8e, 0x3b, 0xdf, 0xd5, 0x9c, 0xa3, 0x59, 0xe4, 0x78, 0x08,
-	0xee, 0xa9, 0x48, 0x43, 0x7c, 0xfc, 0xd6, 0x43, 0x97, 0x97, 0x2f, 0x51, 0x17, 0x94, 0x4e, 0x66,
-	0x41, 0x76, 0x17, 0x18, 0xab, 0x07, 0xd6, 0x0b, 0xe5, 0xa7, 0x55, 0x01, 0xa3, 0x58, 0x80, 0x80,
-	0xdd, 0x5b, 0x7d, 0xc9, 0x31, 0xfe, 0xbb, 0x61, 0xef, 0xee, 0x5c, 0xdf, 0xd4, 0x8c, 0xef, 0x37,
-	0x35, 0xe3, 0xe7, 0x4d, 0xcd, 0xf8, 0xfa, 0xab, 0xb6, 0x71, 0x51, 0xc2, 0x9f, 0x8e, 0xe7, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x60, 0xe6, 0x86, 0x73, 0x06, 0x00, 0x00,
+func (m *AggregatedPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Post) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AggregatedPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Post) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AggregatedPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedPost.Merge(m, src)
 }
-
-func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Lon != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
-		i--
-		dAtA[i] = 0x69
+func (m *AggregatedPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedPost proto.InternalMessageInfo
+
+func (m *AggregatedPost) GetCenter() Point {
+	if m != nil {
+		return m.Center
 	}
-	if m.Lat != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
-		i--
-		dAtA[i] = 0x61
+	return Point{}
+}
+
+func (m *AggregatedPost) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.LocationID) > 0 {
-		i -= len(m.LocationID)
-		copy(dAtA[i:], m.LocationID)
-		i = encodeVarintData(dAtA, i, uint64(len(m.LocationID)))
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+type Timestamp struct {
+	Time         int64 `protobuf:"varint,1,opt,name=Time,proto3" json:"time"`
+	PostsNumber  int64 `protobuf:"varint,2,opt,name=PostsNumber,proto3" json:"posts"`
+	EventsNumber int64 `protobuf:"varint,3,opt,name=EventsNumber,proto3" json:"events"`
+	// Alert is the kind of the city-level alert covering the hour, Score is its anomaly score.
+	Alert string  `protobuf:"bytes,4,opt,name=Alert,proto3" json:"alert,omitempty"`
+	Score float64 `protobuf:"fixed64,5,opt,name=Score,proto3" json:"score,omitempty"`
+	// Collected is set if posts of the hour are already collected by the crawler.
+	Collected            bool     `protobuf:"varint,6,opt,name=Collected,proto3" json:"collected"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Timestamp) Reset()         { *m = Timestamp{} }
+func (m *Timestamp) String() string { return proto.CompactTextString(m) }
+func (*Timestamp) ProtoMessage()    {}
+func (*Timestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{12}
+}
+func (m *Timestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Timestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Timestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AuthorID) > 0 {
-		i -= len(m.AuthorID)
-		copy(dAtA[i:], m.AuthorID)
-		i = encodeVarintData(dAtA, i, uint64(len(m.AuthorID)))
-		i--
-		dAtA[i] = 0x52
+}
+func (m *Timestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timestamp.Merge(m, src)
+}
+func (m *Timestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *Timestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timestamp proto.InternalMessageInfo
+
+func (m *Timestamp) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if m.IsAd {
-		i--
-		if m.IsAd {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	return 0
+}
+
+func (m *Timestamp) GetPostsNumber() int64 {
+	if m != nil {
+		return m.PostsNumber
 	}
-	if m.LikesCount != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.LikesCount))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *Timestamp) GetEventsNumber() int64 {
+	if m != nil {
+		return m.EventsNumber
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *Timestamp) GetAlert() string {
+	if m != nil {
+		return m.Alert
 	}
-	if m.CommentsCount != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.CommentsCount))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *Timestamp) GetScore() float64 {
+	if m != nil {
+		return m.Score
 	}
-	if len(m.Caption) > 0 {
-		i -= len(m.Caption)
-		copy(dAtA[i:], m.Caption)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Caption)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *Timestamp) GetCollected() bool {
+	if m != nil {
+		return m.Collected
 	}
-	if m.IsVideo {
-		i--
-		if m.IsVideo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return false
+}
+
+// Alert is a city-level anomaly of the number of posts in [Start, Finish). Kind is "surge" or
+// "drop", Observed and Expected are the numbers of posts in the period and Score is the largest
+// anomaly score of its hours.
+type Alert struct {
+	Start                int64    `protobuf:"varint,1,opt,name=Start,proto3" json:"start"`
+	Finish               int64    `protobuf:"varint,2,opt,name=Finish,proto3" json:"finish"`
+	Kind                 string   `protobuf:"bytes,3,opt,name=Kind,proto3" json:"kind"`
+	Observed             int64    `protobuf:"varint,4,opt,name=Observed,proto3" json:"observed"`
+	Expected             float64  `protobuf:"fixed64,5,opt,name=Expected,proto3" json:"expected"`
+	Score                float64  `protobuf:"fixed64,6,opt,name=Score,proto3" json:"score"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Alert) Reset()         { *m = Alert{} }
+func (m *Alert) String() string { return proto.CompactTextString(m) }
+func (*Alert) ProtoMessage()    {}
+func (*Alert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{13}
+}
+func (m *Alert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Alert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Alert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.ImageURL) > 0 {
-		i -= len(m.ImageURL)
-		copy(dAtA[i:], m.ImageURL)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ImageURL)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Alert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Alert.Merge(m, src)
+}
+func (m *Alert) XXX_Size() int {
+	return m.Size()
+}
+func (m *Alert) XXX_DiscardUnknown() {
+	xxx_messageInfo_Alert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Alert proto.InternalMessageInfo
+
+func (m *Alert) GetStart() int64 {
+	if m != nil {
+		return m.Start
 	}
-	if len(m.Shortcode) > 0 {
-		i -= len(m.Shortcode)
-		copy(dAtA[i:], m.Shortcode)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Shortcode)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Alert) GetFinish() int64 {
+	if m != nil {
+		return m.Finish
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintData(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Alert) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ShortPost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Alert) GetObserved() int64 {
+	if m != nil {
+		return m.Observed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ShortPost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Alert) GetExpected() float64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
 }
 
-func (m *ShortPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Alert) GetScore() float64 {
+	if m != nil {
+		return m.Score
 	}
-	if m.Lon != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
-		i--
-		dAtA[i] = 0x49
+	return 0
+}
+
+// GridTask is a work item of the distributed building of historic grids, it is identified by the
+// session and the grid key. Spec describes the job and is opaque for data storage. A leased task
+// belongs to Owner until LeaseUntil, after that it can be leased by another worker.
+type GridTask struct {
+	Session              string   `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Key                  int64    `protobuf:"varint,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Spec                 []byte   `protobuf:"bytes,3,opt,name=Spec,proto3" json:"Spec,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	Owner                string   `protobuf:"bytes,5,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	LeaseUntil           int64    `protobuf:"varint,6,opt,name=LeaseUntil,proto3" json:"LeaseUntil,omitempty"`
+	Attempts             int32    `protobuf:"varint,7,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	Posts                int64    `protobuf:"varint,8,opt,name=Posts,proto3" json:"Posts,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GridTask) Reset()         { *m = GridTask{} }
+func (m *GridTask) String() string { return proto.CompactTextString(m) }
+func (*GridTask) ProtoMessage()    {}
+func (*GridTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{14}
+}
+func (m *GridTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GridTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GridTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Lat != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
-		i--
-		dAtA[i] = 0x41
-	}
-	if len(m.LocationID) > 0 {
-		i -= len(m.LocationID)
-		copy(dAtA[i:], m.LocationID)
-		i = encodeVarintData(dAtA, i, uint64(len(m.LocationID)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AuthorID) > 0 {
-		i -= len(m.AuthorID)
-		copy(dAtA[i:], m.AuthorID)
-		i = encodeVarintData(dAtA, i, uint64(len(m.AuthorID)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.LikesCount != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.LikesCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.CommentsCount != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.CommentsCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Caption) > 0 {
-		i -= len(m.Caption)
-		copy(dAtA[i:], m.Caption)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Caption)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Shortcode) > 0 {
-		i -= len(m.Shortcode)
-		copy(dAtA[i:], m.Shortcode)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Shortcode)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GridTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GridTask.Merge(m, src)
+}
+func (m *GridTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *GridTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_GridTask.DiscardUnknown(m)
 }
 
-func (m *Area) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GridTask proto.InternalMessageInfo
+
+func (m *GridTask) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Area) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GridTask) GetKey() int64 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
 }
 
-func (m *Area) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BotRight != nil {
-		{
-			size, err := m.BotRight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopLeft != nil {
-		{
-			size, err := m.TopLeft.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintData(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *GridTask) GetSpec() []byte {
+	if m != nil {
+		return m.Spec
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SpatioTemporalInterval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GridTask) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SpatioTemporalInterval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GridTask) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *SpatioTemporalInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
+func (m *GridTask) GetLeaseUntil() int64 {
+	if m != nil {
+		return m.LeaseUntil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.MaxTime != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.MaxTime))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *GridTask) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
 	}
-	if m.MinTime != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.MinTime))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *GridTask) GetPosts() int64 {
+	if m != nil {
+		return m.Posts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SpatioHourInterval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GridTask) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SpatioHourInterval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GridTasksStatus holds the numbers of tasks of a session in every status, the number of posts
+// in the completed tasks and the keys of the completed tasks.
+type GridTasksStatus struct {
+	Pending              int64    `protobuf:"varint,1,opt,name=Pending,proto3" json:"Pending,omitempty"`
+	Leased               int64    `protobuf:"varint,2,opt,name=Leased,proto3" json:"Leased,omitempty"`
+	Done                 int64    `protobuf:"varint,3,opt,name=Done,proto3" json:"Done,omitempty"`
+	Failed               int64    `protobuf:"varint,4,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Posts                int64    `protobuf:"varint,5,opt,name=Posts,proto3" json:"Posts,omitempty"`
+	DoneKeys             []int64  `protobuf:"varint,6,rep,packed,name=DoneKeys,proto3" json:"DoneKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpatioHourInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	{
-		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
+func (m *GridTasksStatus) Reset()         { *m = GridTasksStatus{} }
+func (m *GridTasksStatus) String() string { return proto.CompactTextString(m) }
+func (*GridTasksStatus) ProtoMessage()    {}
+func (*GridTasksStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{15}
+}
+func (m *GridTasksStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GridTasksStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GridTasksStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Hour != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Hour))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GridTasksStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GridTasksStatus.Merge(m, src)
+}
+func (m *GridTasksStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GridTasksStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GridTasksStatus.DiscardUnknown(m)
 }
 
-func (m *Point) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GridTasksStatus proto.InternalMessageInfo
+
+func (m *GridTasksStatus) GetPending() int64 {
+	if m != nil {
+		return m.Pending
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Point) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GridTasksStatus) GetLeased() int64 {
+	if m != nil {
+		return m.Leased
+	}
+	return 0
 }
 
-func (m *Point) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Lon != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if m.Lat != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
-		i--
-		dAtA[i] = 0x9
+func (m *GridTasksStatus) GetDone() int64 {
+	if m != nil {
+		return m.Done
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GridTasksStatus) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GridTasksStatus) GetPosts() int64 {
+	if m != nil {
+		return m.Posts
+	}
+	return 0
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Finish != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Finish))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Start != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Start))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tags[iNdEx])
-			copy(dAtA[i:], m.Tags[iNdEx])
-			i = encodeVarintData(dAtA, i, uint64(len(m.Tags[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PostCodes) > 0 {
-		for iNdEx := len(m.PostCodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PostCodes[iNdEx])
-			copy(dAtA[i:], m.PostCodes[iNdEx])
-			i = encodeVarintData(dAtA, i, uint64(len(m.PostCodes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *GridTasksStatus) GetDoneKeys() []int64 {
+	if m != nil {
+		return m.DoneKeys
 	}
-	{
-		size, err := m.Center.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+type Location struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Position             Point    `protobuf:"bytes,3,opt,name=Position,proto3" json:"Position"`
+	Slug                 string   `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Location) Reset()         { *m = Location{} }
+func (m *Location) String() string { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()    {}
+func (*Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{16}
+}
+func (m *Location) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Location.Merge(m, src)
+}
+func (m *Location) XXX_Size() int {
+	return m.Size()
+}
+func (m *Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_Location.DiscardUnknown(m)
 }
 
-func (m *AggregatedPost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Location proto.InternalMessageInfo
+
+func (m *Location) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AggregatedPost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Location) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *AggregatedPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Location) GetPosition() Point {
+	if m != nil {
+		return m.Position
 	}
-	if m.Count != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	return Point{}
+}
+
+func (m *Location) GetSlug() string {
+	if m != nil {
+		return m.Slug
 	}
-	{
-		size, err := m.Center.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type City struct {
+	Title                string   `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	Area                 Area     `protobuf:"bytes,3,opt,name=Area,proto3" json:"Area"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *City) Reset()         { *m = City{} }
+func (m *City) String() string { return proto.CompactTextString(m) }
+func (*City) ProtoMessage()    {}
+func (*City) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac8e6d38f431921d, []int{17}
+}
+func (m *City) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *City) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_City.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *City) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_City.Merge(m, src)
+}
+func (m *City) XXX_Size() int {
+	return m.Size()
+}
+func (m *City) XXX_DiscardUnknown() {
+	xxx_messageInfo_City.DiscardUnknown(m)
 }
 
-func (m *Timestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_City proto.InternalMessageInfo
+
+func (m *City) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *City) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *Timestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.EventsNumber != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.EventsNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PostsNumber != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.PostsNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Time != 0 {
-		i = encodeVarintData(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x8
+func (m *City) GetArea() Area {
+	if m != nil {
+		return m.Area
 	}
-	return len(dAtA) - i, nil
+	return Area{}
 }
 
-func (m *Location) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterType((*Post)(nil), "data.Post")
+	proto.RegisterType((*ShortPost)(nil), "data.ShortPost")
+	proto.RegisterType((*Area)(nil), "data.Area")
+	proto.RegisterType((*SpatioTemporalInterval)(nil), "data.SpatioTemporalInterval")
+	proto.RegisterType((*SpatioHourInterval)(nil), "data.SpatioHourInterval")
+	proto.RegisterType((*Point)(nil), "data.Point")
+	proto.RegisterType((*Event)(nil), "data.Event")
+	proto.RegisterType((*Footprint)(nil), "data.Footprint")
+	proto.RegisterType((*DetectionParams)(nil), "data.DetectionParams")
+	proto.RegisterType((*FilterStats)(nil), "data.FilterStats")
+	proto.RegisterType((*Bot)(nil), "data.Bot")
+	proto.RegisterType((*AggregatedPost)(nil), "data.AggregatedPost")
+	proto.RegisterType((*Timestamp)(nil), "data.Timestamp")
+	proto.RegisterType((*Alert)(nil), "data.Alert")
+	proto.RegisterType((*GridTask)(nil), "data.GridTask")
+	proto.RegisterType((*GridTasksStatus)(nil), "data.GridTasksStatus")
+	proto.RegisterType((*Location)(nil), "data.Location")
+	proto.RegisterType((*City)(nil), "data.City")
+}
+
+func init() { proto.RegisterFile("proto/data.proto", fileDescriptor_ac8e6d38f431921d) }
+
+var fileDescriptor_ac8e6d38f431921d = []byte{
+	// 1476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcd, 0x6e, 0x1b, 0x47,
+	0x12, 0xf6, 0x70, 0x38, 0x14, 0xa7, 0x29, 0x5b, 0xde, 0xde, 0x5d, 0x63, 0xe0, 0xf5, 0x8a, 0xc2,
+	0xc0, 0x8b, 0x95, 0xe1, 0xb5, 0x0c, 0x48, 0xa7, 0x45, 0x4e, 0x22, 0x69, 0x3b, 0x84, 0x24, 0x5b,
+	0x68, 0xca, 0x4e, 0x72, 0xc8, 0xa1, 0xcd, 0x69, 0x8f, 0x1a, 0x26, 0xa7, 0x89, 0xe9, 0xa6, 0x2c,
+	0xe5, 0x05, 0x82, 0x20, 0x2f, 0x90, 0x6b, 0x0e, 0x79, 0x17, 0x03, 0xb9, 0xe4, 0x01, 0x02, 0xc6,
+	0x70, 0x6e, 0x7c, 0x8a, 0xa0, 0xaa, 0x7b, 0x7e, 0x28, 0xd9, 0x4e, 0x2e, 0x44, 0x7f, 0x5f, 0x55,
+	0xf7, 0x54, 0xd5, 0x7c, 0x55, 0x3d, 0x24, 0x37, 0x67, 0xb9, 0x32, 0xea, 0x61, 0xc2, 0x0d, 0xdf,
+	0xc1, 0x25, 0x6d, 0xc2, 0xfa, 0xf6, 0xbf, 0xe1, 0xf7, 0x81, 0x36, 0x2a, 0xe7, 0xa9, 0x78, 0x68,
+	0x9d, 0x52, 0x95, 0x2a, 0xeb, 0x14, 0xff, 0xda, 0x20, 0xcd, 0x63, 0xa5, 0x0d, 0xbd, 0x41, 0x1a,
+	0xc3, 0x41, 0xe4, 0x6d, 0x79, 0xdb, 0x21, 0x6b, 0x0c, 0x07, 0xf4, 0x0e, 0x09, 0x47, 0xa7, 0x2a,
+	0x37, 0x63, 0x95, 0x88, 0xa8, 0x81, 0x74, 0x45, 0xd0, 0xdb, 0xa4, 0x3d, 0x9c, 0xf2, 0x54, 0x3c,
+	0x67, 0x87, 0x91, 0x8f, 0xc6, 0x12, 0xd3, 0x88, 0xac, 0x0d, 0xf5, 0x0b, 0x99, 0x08, 0x15, 0x35,
+	0xb7, 0xbc, 0xed, 0x36, 0x2b, 0x20, 0x58, 0xfa, 0x7c, 0x66, 0xa4, 0xca, 0xa2, 0x00, 0x37, 0x15,
+	0x90, 0xde, 0x25, 0xd7, 0xfb, 0x6a, 0x3a, 0x15, 0x99, 0xd1, 0x7d, 0x35, 0xcf, 0x4c, 0xd4, 0xda,
+	0xf2, 0xb6, 0x7d, 0xb6, 0x4a, 0x42, 0x4c, 0x27, 0x72, 0x2a, 0xb4, 0xe1, 0xd3, 0x59, 0xb4, 0x86,
+	0x1e, 0x15, 0x41, 0x37, 0x09, 0x39, 0x94, 0xaf, 0x85, 0x3b, 0xa0, 0x8d, 0xe6, 0x1a, 0x43, 0x29,
+	0x69, 0x0e, 0xf5, 0x7e, 0x12, 0x85, 0x18, 0x14, 0xae, 0x21, 0x8f, 0xfd, 0xb9, 0x39, 0x55, 0xf9,
+	0x70, 0x10, 0x11, 0x9b, 0x47, 0x81, 0xf1, 0x3c, 0x35, 0xe6, 0x10, 0xdf, 0x70, 0x10, 0x75, 0xd0,
+	0x5a, 0x63, 0xe8, 0x4d, 0xe2, 0x1f, 0x72, 0x13, 0xad, 0x6f, 0x79, 0xdb, 0x1e, 0x83, 0x25, 0x32,
+	0x2a, 0x8b, 0xae, 0x3b, 0x46, 0x65, 0xf1, 0xb7, 0x0d, 0x57, 0x46, 0xac, 0xf1, 0x4a, 0x4d, 0xbd,
+	0xcb, 0x35, 0xad, 0x55, 0xa7, 0xf1, 0x27, 0xd5, 0xf1, 0x3f, 0x54, 0x9d, 0xd5, 0xfc, 0x9b, 0x57,
+	0xf2, 0x5f, 0xa9, 0x5e, 0x70, 0xb9, 0x7a, 0xf5, 0x4a, 0xb4, 0x3e, 0x59, 0x89, 0xb5, 0x8f, 0x55,
+	0xa2, 0x7d, 0xa5, 0x12, 0x61, 0x55, 0x89, 0x17, 0xa4, 0xb9, 0x9f, 0x0b, 0x4e, 0xff, 0x43, 0xd6,
+	0x4e, 0xd4, 0xec, 0x50, 0xbc, 0x32, 0x58, 0x81, 0xce, 0x6e, 0x67, 0x07, 0x35, 0x7b, 0xac, 0x64,
+	0x66, 0x58, 0x61, 0xa3, 0xff, 0x25, 0xed, 0x9e, 0x32, 0x4c, 0xa6, 0xa7, 0x26, 0x6a, 0x5c, 0xf5,
+	0x2b, 0x8d, 0x71, 0x4e, 0x6e, 0x8d, 0x66, 0x10, 0xc8, 0x89, 0x98, 0xce, 0x54, 0xce, 0x27, 0xc3,
+	0xcc, 0x88, 0xfc, 0x8c, 0x4f, 0xa0, 0x9e, 0x47, 0x32, 0x83, 0x0c, 0xf1, 0x49, 0x3e, 0x2b, 0x20,
+	0x5a, 0xf8, 0x39, 0x5a, 0x1a, 0xce, 0x62, 0x21, 0xbd, 0x6b, 0xa3, 0xc4, 0x02, 0x77, 0x76, 0x89,
+	0x7d, 0x24, 0x30, 0xbd, 0xe6, 0xdb, 0x45, 0xf7, 0x1a, 0x43, 0x6b, 0xfc, 0x94, 0x50, 0xfb, 0xcc,
+	0xcf, 0xd5, 0x3c, 0x2f, 0x9f, 0x47, 0x49, 0x13, 0xb0, 0x7b, 0x18, 0xae, 0xcb, 0xf3, 0x1a, 0x9f,
+	0x3c, 0xef, 0x33, 0x12, 0x60, 0x5a, 0x34, 0xb2, 0x85, 0x84, 0x13, 0xbc, 0x5e, 0x6b, 0xb9, 0xe8,
+	0x36, 0x26, 0xc6, 0x16, 0x34, 0xb2, 0x05, 0x6d, 0xd4, 0x2c, 0x99, 0x2d, 0xec, 0xcf, 0x3e, 0x09,
+	0x1e, 0x9d, 0x89, 0xcc, 0xd0, 0x7b, 0xa4, 0xd5, 0x17, 0x10, 0xcd, 0x07, 0x2a, 0xeb, 0x9e, 0xe7,
+	0x1c, 0x40, 0x0b, 0xa0, 0xc8, 0xbe, 0x4a, 0x84, 0x8e, 0x1a, 0x5b, 0x3e, 0x28, 0xb1, 0x24, 0x20,
+	0x93, 0x13, 0x9e, 0xea, 0xc8, 0x47, 0x03, 0xae, 0xe9, 0x3f, 0x48, 0x70, 0x22, 0xcd, 0x44, 0xa0,
+	0xb0, 0x42, 0x66, 0x01, 0xb0, 0x23, 0xc3, 0x73, 0xe3, 0xf4, 0x64, 0x01, 0xbd, 0x45, 0x5a, 0x8f,
+	0x65, 0x26, 0xf5, 0xa9, 0x6b, 0x63, 0x87, 0xe8, 0x1e, 0x69, 0x3f, 0x96, 0x13, 0x23, 0x72, 0x91,
+	0xa0, 0x8a, 0x3a, 0xbb, 0x7f, 0xb3, 0x21, 0x5a, 0x76, 0x64, 0xb8, 0xd1, 0x2e, 0xd0, 0xd2, 0x91,
+	0xee, 0x91, 0xd6, 0x31, 0xcf, 0xf9, 0x54, 0xa3, 0xbe, 0x3a, 0xbb, 0xff, 0xb4, 0x5b, 0x06, 0xc2,
+	0x88, 0x31, 0xe8, 0xcf, 0x1a, 0x8b, 0xfc, 0x2c, 0x02, 0x35, 0x9f, 0xa8, 0x19, 0x64, 0xa4, 0xa3,
+	0x10, 0xb3, 0x28, 0x31, 0xbc, 0x7d, 0xab, 0x6c, 0x8d, 0x2d, 0xef, 0xb3, 0x02, 0x42, 0x36, 0xd8,
+	0x2f, 0xd8, 0xec, 0x3e, 0xb3, 0x00, 0xce, 0x2a, 0x1a, 0x0d, 0x9b, 0xdd, 0x67, 0x25, 0x06, 0x5b,
+	0xd1, 0x07, 0xd8, 0xf6, 0x21, 0x2b, 0x31, 0xdd, 0x23, 0xe1, 0x63, 0xa5, 0xcc, 0x2c, 0x97, 0x99,
+	0x89, 0x6e, 0x60, 0xec, 0x1b, 0x2e, 0xdd, 0x82, 0x76, 0x51, 0x57, 0x7e, 0xf1, 0xf7, 0x5e, 0x6d,
+	0x17, 0x1c, 0xff, 0x44, 0xa8, 0xa9, 0x30, 0xf9, 0x85, 0x9b, 0x17, 0x25, 0x06, 0x69, 0xf5, 0x7a,
+	0xea, 0xfc, 0xe3, 0xd2, 0x02, 0x2b, 0xbc, 0x0a, 0xc6, 0x13, 0x39, 0xd7, 0x28, 0x69, 0x8f, 0x39,
+	0x04, 0xbb, 0xfb, 0x62, 0x32, 0x89, 0x9a, 0x1f, 0xdb, 0x0d, 0xd6, 0xf8, 0x27, 0x9f, 0x6c, 0x5c,
+	0x2a, 0x34, 0x48, 0xe7, 0x88, 0x9f, 0xa3, 0xa8, 0x34, 0x06, 0x15, 0xb0, 0x8a, 0x80, 0x51, 0x71,
+	0x24, 0xb3, 0x2f, 0x0f, 0x45, 0x96, 0x9a, 0x53, 0x2b, 0x57, 0x56, 0x63, 0x9c, 0xfd, 0x2b, 0x67,
+	0xf7, 0x4b, 0xbb, 0x63, 0x20, 0xe3, 0x23, 0x7e, 0x3e, 0x10, 0x33, 0x73, 0x8a, 0xb1, 0x05, 0xac,
+	0xc4, 0xb0, 0xb7, 0xaf, 0xb2, 0xb3, 0xa7, 0xf3, 0xe9, 0x4b, 0x91, 0xa3, 0xe2, 0x02, 0x56, 0x63,
+	0x68, 0x4c, 0xd6, 0x01, 0x3d, 0xc9, 0x65, 0x32, 0x92, 0xdf, 0x08, 0x14, 0x5f, 0xc0, 0x56, 0x38,
+	0x3c, 0x5f, 0x66, 0xcf, 0xb5, 0xc8, 0x75, 0xb4, 0xe6, 0xce, 0x77, 0x18, 0xce, 0xc7, 0xc5, 0xe8,
+	0x94, 0xe7, 0xc2, 0x4d, 0xb3, 0x1a, 0x73, 0x49, 0x54, 0xb8, 0xb7, 0x2e, 0x2a, 0x98, 0x21, 0x3c,
+	0xb5, 0xa2, 0x0a, 0x58, 0x01, 0x21, 0xaa, 0x2f, 0x64, 0x96, 0xa8, 0x37, 0x2e, 0x67, 0xab, 0xad,
+	0x15, 0xae, 0xf2, 0x19, 0x99, 0x5c, 0x26, 0xc2, 0xc9, 0x6c, 0x85, 0x43, 0xa9, 0xf1, 0x2c, 0x9d,
+	0xf3, 0x54, 0x94, 0x52, 0x73, 0x38, 0x9e, 0x93, 0x4e, 0xad, 0x85, 0xa0, 0x7f, 0x7b, 0xca, 0xbd,
+	0x1d, 0x9f, 0xe1, 0x1a, 0x26, 0xf2, 0x7e, 0xa2, 0xdd, 0xbc, 0x83, 0x25, 0xdd, 0x22, 0x1d, 0xc6,
+	0x8d, 0x38, 0x94, 0x53, 0x69, 0x44, 0xe2, 0xee, 0x94, 0x3a, 0x05, 0x05, 0x19, 0xcc, 0x67, 0x13,
+	0x39, 0xe6, 0x46, 0xe8, 0xe2, 0x46, 0xa9, 0x98, 0xf8, 0xff, 0xc4, 0xef, 0x29, 0xb3, 0x72, 0x75,
+	0x78, 0x97, 0xae, 0x0e, 0xd0, 0x9f, 0xe0, 0xba, 0xbc, 0xd3, 0x1c, 0x8a, 0xbf, 0x26, 0x37, 0xf6,
+	0xd3, 0x34, 0x17, 0x29, 0x37, 0x22, 0xc1, 0xcb, 0x71, 0xe7, 0x53, 0xd3, 0x2b, 0x04, 0x51, 0x2e,
+	0x17, 0x5d, 0x6f, 0x5c, 0x8e, 0xb0, 0x7f, 0x91, 0xc0, 0xde, 0x74, 0x98, 0x52, 0x2f, 0x00, 0x6b,
+	0xc6, 0x2c, 0x17, 0x7f, 0xd7, 0xa8, 0x5d, 0x76, 0xf4, 0x0e, 0x69, 0x56, 0xd7, 0x40, 0xaf, 0xbd,
+	0x5c, 0x74, 0x9b, 0x46, 0x4e, 0x05, 0x43, 0x96, 0xde, 0x27, 0x1d, 0x7c, 0x87, 0x4e, 0x57, 0xf6,
+	0xb8, 0x70, 0xb9, 0xe8, 0x06, 0x33, 0xa0, 0x59, 0xdd, 0x4a, 0x77, 0xc8, 0x3a, 0x0e, 0xdb, 0xc2,
+	0x1b, 0xab, 0xd6, 0x23, 0xcb, 0x45, 0xb7, 0x25, 0x90, 0x67, 0x2b, 0x76, 0x7a, 0x8f, 0x04, 0xfb,
+	0x13, 0x91, 0xdb, 0xfb, 0x38, 0xec, 0xfd, 0x7d, 0xb9, 0xe8, 0x6e, 0x70, 0x20, 0xfe, 0xa7, 0xa0,
+	0xc6, 0xd3, 0x99, 0xb9, 0x60, 0xd6, 0x03, 0x5c, 0x47, 0x63, 0x95, 0x0b, 0x54, 0xb6, 0x67, 0x5d,
+	0x35, 0x10, 0x75, 0x57, 0xf4, 0xa0, 0xf7, 0x49, 0xd8, 0x57, 0x93, 0x89, 0x18, 0xc3, 0x8b, 0x03,
+	0x99, 0xb7, 0x7b, 0xd7, 0x97, 0x8b, 0x6e, 0x38, 0x2e, 0x48, 0x56, 0xd9, 0xe3, 0xdf, 0x3c, 0x17,
+	0x03, 0xed, 0x16, 0xd3, 0xda, 0xab, 0x72, 0xd4, 0x40, 0x14, 0x83, 0x3b, 0x2e, 0x07, 0x77, 0xa3,
+	0xca, 0xeb, 0x15, 0x32, 0xe5, 0x10, 0xbf, 0x43, 0x9a, 0x07, 0x32, 0xb3, 0x7a, 0x09, 0x6d, 0x31,
+	0x5f, 0xcb, 0x2c, 0x61, 0xc8, 0xd2, 0x6d, 0xd2, 0x7e, 0xf6, 0x52, 0x8b, 0xfc, 0x4c, 0x24, 0x56,
+	0x30, 0xbd, 0xf5, 0xe5, 0xa2, 0xdb, 0x56, 0x8e, 0x63, 0xa5, 0x15, 0x3c, 0x1f, 0x9d, 0xcf, 0x6c,
+	0x0a, 0x36, 0x63, 0xf4, 0x14, 0x8e, 0x63, 0xa5, 0x15, 0xc3, 0xc6, 0xc2, 0xb4, 0xd0, 0xcd, 0x86,
+	0x0d, 0x84, 0x2b, 0x47, 0xfc, 0xce, 0x23, 0x6d, 0xe8, 0xf0, 0x13, 0xae, 0x5f, 0x43, 0x27, 0x8e,
+	0x84, 0xd6, 0x30, 0x91, 0xad, 0x18, 0x0b, 0x08, 0x2d, 0x70, 0x20, 0x2e, 0x8a, 0x16, 0x38, 0x10,
+	0x17, 0xd0, 0x28, 0xa3, 0x99, 0x18, 0x63, 0x2e, 0xeb, 0x0c, 0xd7, 0xa0, 0x58, 0xe8, 0xa2, 0xb9,
+	0x76, 0x37, 0x9d, 0x43, 0x70, 0x39, 0x3c, 0x7b, 0x93, 0xb9, 0xc1, 0x13, 0x32, 0x0b, 0xf0, 0xd3,
+	0x48, 0x70, 0x2d, 0x9e, 0x67, 0x46, 0x4e, 0xdc, 0x75, 0x57, 0x63, 0xb0, 0x37, 0x0c, 0xbe, 0xbc,
+	0x72, 0xde, 0x14, 0x18, 0x4e, 0xb4, 0xc3, 0xc4, 0x7e, 0xab, 0x5a, 0x00, 0xec, 0xa3, 0x3c, 0x57,
+	0x39, 0x8e, 0x98, 0x90, 0x59, 0x10, 0xff, 0xe8, 0x91, 0x8d, 0x22, 0x45, 0xed, 0x22, 0x8a, 0xc8,
+	0xda, 0xb1, 0xc8, 0x12, 0x99, 0xa5, 0xc5, 0x07, 0x8e, 0x83, 0x90, 0x03, 0xc6, 0x90, 0xb8, 0x64,
+	0x1d, 0x82, 0x7c, 0x07, 0x2a, 0x13, 0xae, 0xd7, 0x71, 0x8d, 0x97, 0x35, 0x97, 0x93, 0xe2, 0x7d,
+	0x31, 0x87, 0xaa, 0xe8, 0x82, 0x7a, 0x74, 0xb7, 0x49, 0x1b, 0x76, 0x1d, 0x88, 0x0b, 0x1d, 0xb5,
+	0xb6, 0x7c, 0xb8, 0x0c, 0x0b, 0x1c, 0xeb, 0xea, 0x32, 0xbc, 0xf2, 0x77, 0xa2, 0xfc, 0x7c, 0x68,
+	0xd4, 0x3f, 0x1f, 0x1e, 0x90, 0xf6, 0xb1, 0xd2, 0x12, 0x76, 0x44, 0xfe, 0xd5, 0xae, 0x77, 0x9f,
+	0x02, 0x85, 0x0b, 0x84, 0xaf, 0x27, 0xf3, 0xd4, 0xbd, 0x18, 0x5c, 0xc3, 0x77, 0x65, 0x5f, 0x9a,
+	0x8b, 0xea, 0x01, 0x5e, 0xfd, 0x01, 0x94, 0x34, 0xfb, 0xd5, 0x1f, 0x18, 0x5c, 0xff, 0xb5, 0x6f,
+	0xbc, 0xde, 0xcd, 0xb7, 0xef, 0x37, 0xbd, 0x5f, 0xde, 0x6f, 0x7a, 0xef, 0xde, 0x6f, 0x7a, 0x3f,
+	0xfc, 0xbe, 0x79, 0xed, 0x65, 0x0b, 0xff, 0x31, 0xed, 0xfd, 0x31, 0x00, 0x5c, 0x44, 0x75, 0xe7,
+	0x6a, 0x0d, 0x00, 0x00,
+}
+
+func (m *Post) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1455,12 +1713,12 @@ func (m *Location) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+func (m *Post) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1469,27 +1727,85 @@ func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Slug) > 0 {
-		i -= len(m.Slug)
-		copy(dAtA[i:], m.Slug)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Slug)))
+	if m.Lon != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x69
 	}
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Lat != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if len(m.LocationID) > 0 {
+		i -= len(m.LocationID)
+		copy(dAtA[i:], m.LocationID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.LocationID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AuthorID) > 0 {
+		i -= len(m.AuthorID)
+		copy(dAtA[i:], m.AuthorID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.AuthorID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IsAd {
+		i--
+		if m.IsAd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x48
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
+	if m.LikesCount != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.LikesCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommentsCount != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.CommentsCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Caption) > 0 {
+		i -= len(m.Caption)
+		copy(dAtA[i:], m.Caption)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Caption)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsVideo {
+		i--
+		if m.IsVideo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ImageURL) > 0 {
+		i -= len(m.ImageURL)
+		copy(dAtA[i:], m.ImageURL)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ImageURL)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shortcode) > 0 {
+		i -= len(m.Shortcode)
+		copy(dAtA[i:], m.Shortcode)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Shortcode)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1503,7 +1819,7 @@ func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *City) Marshal() (dAtA []byte, err error) {
+func (m *ShortPost) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1513,12 +1829,12 @@ func (m *City) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *City) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShortPost) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *City) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShortPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1527,348 +1843,3268 @@ func (m *City) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	{
-		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintData(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Code)))
+	if m.Lon != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x49
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
+	if m.Lat != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintData(dAtA []byte, offset int, v uint64) int {
-	offset -= sovData(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Post) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	l = len(m.Shortcode)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	l = len(m.ImageURL)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	if m.IsVideo {
-		n += 2
+		dAtA[i] = 0x41
 	}
-	l = len(m.Caption)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if len(m.LocationID) > 0 {
+		i -= len(m.LocationID)
+		copy(dAtA[i:], m.LocationID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.LocationID)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.CommentsCount != 0 {
-		n += 1 + sovData(uint64(m.CommentsCount))
+	if len(m.AuthorID) > 0 {
+		i -= len(m.AuthorID)
+		copy(dAtA[i:], m.AuthorID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.AuthorID)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Timestamp != 0 {
-		n += 1 + sovData(uint64(m.Timestamp))
+		i = encodeVarintData(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.LikesCount != 0 {
-		n += 1 + sovData(uint64(m.LikesCount))
-	}
-	if m.IsAd {
-		n += 2
-	}
-	l = len(m.AuthorID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	l = len(m.LocationID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+		i = encodeVarintData(dAtA, i, uint64(m.LikesCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Lat != 0 {
-		n += 9
+	if m.CommentsCount != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.CommentsCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Lon != 0 {
-		n += 9
+	if len(m.Caption) > 0 {
+		i -= len(m.Caption)
+		copy(dAtA[i:], m.Caption)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Caption)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Shortcode) > 0 {
+		i -= len(m.Shortcode)
+		copy(dAtA[i:], m.Shortcode)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Shortcode)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ShortPost) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Area) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Area) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Area) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Shortcode)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	l = len(m.Caption)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	if m.CommentsCount != 0 {
-		n += 1 + sovData(uint64(m.CommentsCount))
-	}
-	if m.LikesCount != 0 {
-		n += 1 + sovData(uint64(m.LikesCount))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovData(uint64(m.Timestamp))
-	}
-	l = len(m.AuthorID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	l = len(m.LocationID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
-	}
-	if m.Lat != 0 {
-		n += 9
-	}
-	if m.Lon != 0 {
-		n += 9
+	if m.BotRight != nil {
+		{
+			size, err := m.BotRight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TopLeft != nil {
+		{
+			size, err := m.TopLeft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Area) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopLeft != nil {
-		l = m.TopLeft.Size()
-		n += 1 + l + sovData(uint64(l))
-	}
-	if m.BotRight != nil {
-		l = m.BotRight.Size()
-		n += 1 + l + sovData(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SpatioTemporalInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SpatioTemporalInterval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SpatioTemporalInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpatioTemporalInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MinTime != 0 {
-		n += 1 + sovData(uint64(m.MinTime))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.MaxTime != 0 {
-		n += 1 + sovData(uint64(m.MaxTime))
+		i = encodeVarintData(dAtA, i, uint64(m.MaxTime))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Area.Size()
-	n += 1 + l + sovData(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MinTime != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MinTime))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpatioHourInterval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpatioHourInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SpatioHourInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpatioHourInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Hour != 0 {
-		n += 1 + sovData(uint64(m.Hour))
-	}
-	l = m.Area.Size()
-	n += 1 + l + sovData(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	{
+		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Hour != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Point) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Point) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Point) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Point) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Lat != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Lon != 0 {
-		n += 9
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
+		i--
+		dAtA[i] = 0x11
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Lat != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
+		i--
+		dAtA[i] = 0x9
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Center.Size()
-	n += 1 + l + sovData(uint64(l))
-	if len(m.PostCodes) > 0 {
-		for _, s := range m.PostCodes {
-			l = len(s)
-			n += 1 + l + sovData(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovData(uint64(l))
+	{
+		size, err := m.Footprint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.Start != 0 {
-		n += 1 + sovData(uint64(m.Start))
+	if m.Comments != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Comments))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Finish != 0 {
-		n += 1 + sovData(uint64(m.Finish))
+	if m.Likes != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Likes))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Authors != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Authors))
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *AggregatedPost) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TopPosts) > 0 {
+		for iNdEx := len(m.TopPosts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TopPosts[iNdEx])
+			copy(dAtA[i:], m.TopPosts[iNdEx])
+			i = encodeVarintData(dAtA, i, uint64(len(m.TopPosts[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	var l int
-	_ = l
-	l = m.Center.Size()
-	n += 1 + l + sovData(uint64(l))
-	if m.Count != 0 {
-		n += 1 + sovData(uint64(m.Count))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Filtered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x3a
+	if m.Finish != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Finish))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Start != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintData(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PostCodes) > 0 {
+		for iNdEx := len(m.PostCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PostCodes[iNdEx])
+			copy(dAtA[i:], m.PostCodes[iNdEx])
+			i = encodeVarintData(dAtA, i, uint64(len(m.PostCodes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Center.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Timestamp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Footprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Footprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Footprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Time != 0 {
-		n += 1 + sovData(uint64(m.Time))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PostsNumber != 0 {
-		n += 1 + sovData(uint64(m.PostsNumber))
+	{
+		size, err := m.Cell.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
-	if m.EventsNumber != 0 {
-		n += 1 + sovData(uint64(m.EventsNumber))
+	i--
+	dAtA[i] = 0x22
+	if m.Radius != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Radius))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	{
+		size, err := m.BBox.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Geometry) > 0 {
+		i -= len(m.Geometry)
+		copy(dAtA[i:], m.Geometry)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Geometry)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Location) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DetectionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DetectionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetectionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = m.Position.Size()
-	n += 1 + l + sovData(uint64(l))
-	l = len(m.Slug)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.WindowStride != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.WindowStride))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.WindowLength != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.WindowLength))
+		i--
+		dAtA[i] = 0x58
 	}
-	return n
+	if m.MaxTags != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MaxTags))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TopPosts != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.TopPosts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UsersShare != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UsersShare))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.MinUsers != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MinUsers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConvGridSize != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.ConvGridSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConvNumber != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.ConvNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinYLength != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinYLength))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.MinXLength != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinXLength))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.MaxPoints != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.MaxPoints))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *City) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FilterStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovData(uint64(l))
+	if m.Duplicates != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Duplicates))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Area.Size()
-	n += 1 + l + sovData(uint64(l))
+	if m.RateLimited != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.RateLimited))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ads != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Ads))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bots != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Bots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthorID) > 0 {
+		i -= len(m.AuthorID)
+		copy(dAtA[i:], m.AuthorID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.AuthorID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregatedPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Center.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Timestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Timestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Collected {
+		i--
+		if m.Collected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if len(m.Alert) > 0 {
+		i -= len(m.Alert)
+		copy(dAtA[i:], m.Alert)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Alert)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EventsNumber != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.EventsNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PostsNumber != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.PostsNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Alert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Alert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Alert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Expected != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Expected))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Observed != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Observed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Finish != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Finish))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GridTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GridTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GridTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Posts != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LeaseUntil != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.LeaseUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Key != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Session) > 0 {
+		i -= len(m.Session)
+		copy(dAtA[i:], m.Session)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Session)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GridTasksStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GridTasksStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GridTasksStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoneKeys) > 0 {
+		dAtA13 := make([]byte, len(m.DoneKeys)*10)
+		var j12 int
+		for _, num1 := range m.DoneKeys {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintData(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Posts != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Done != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Done))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Leased != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Leased))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pending != 0 {
+		i = encodeVarintData(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Location) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Slug) > 0 {
+		i -= len(m.Slug)
+		copy(dAtA[i:], m.Slug)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Slug)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintData(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *City) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *City) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *City) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Area.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintData(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintData(dAtA []byte, offset int, v uint64) int {
+	offset -= sovData(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Post) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Shortcode)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.ImageURL)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.IsVideo {
+		n += 2
+	}
+	l = len(m.Caption)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.CommentsCount != 0 {
+		n += 1 + sovData(uint64(m.CommentsCount))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovData(uint64(m.Timestamp))
+	}
+	if m.LikesCount != 0 {
+		n += 1 + sovData(uint64(m.LikesCount))
+	}
+	if m.IsAd {
+		n += 2
+	}
+	l = len(m.AuthorID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.LocationID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 9
+	}
+	if m.Lon != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShortPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Shortcode)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Caption)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.CommentsCount != 0 {
+		n += 1 + sovData(uint64(m.CommentsCount))
+	}
+	if m.LikesCount != 0 {
+		n += 1 + sovData(uint64(m.LikesCount))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovData(uint64(m.Timestamp))
+	}
+	l = len(m.AuthorID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.LocationID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 9
+	}
+	if m.Lon != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Area) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopLeft != nil {
+		l = m.TopLeft.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.BotRight != nil {
+		l = m.BotRight.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpatioTemporalInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinTime != 0 {
+		n += 1 + sovData(uint64(m.MinTime))
+	}
+	if m.MaxTime != 0 {
+		n += 1 + sovData(uint64(m.MaxTime))
+	}
+	l = m.Area.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpatioHourInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hour != 0 {
+		n += 1 + sovData(uint64(m.Hour))
+	}
+	l = m.Area.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Point) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lat != 0 {
+		n += 9
+	}
+	if m.Lon != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Center.Size()
+	n += 1 + l + sovData(uint64(l))
+	if len(m.PostCodes) > 0 {
+		for _, s := range m.PostCodes {
+			l = len(s)
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovData(uint64(m.Start))
+	}
+	if m.Finish != 0 {
+		n += 1 + sovData(uint64(m.Finish))
+	}
+	l = m.Filtered.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovData(uint64(l))
+	if len(m.TopPosts) > 0 {
+		for _, s := range m.TopPosts {
+			l = len(s)
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	if m.Authors != 0 {
+		n += 1 + sovData(uint64(m.Authors))
+	}
+	if m.Likes != 0 {
+		n += 1 + sovData(uint64(m.Likes))
+	}
+	if m.Comments != 0 {
+		n += 1 + sovData(uint64(m.Comments))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = m.Footprint.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Footprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Geometry)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = m.BBox.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.Radius != 0 {
+		n += 9
+	}
+	l = m.Cell.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetectionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoints != 0 {
+		n += 1 + sovData(uint64(m.MaxPoints))
+	}
+	if m.MinXLength != 0 {
+		n += 9
+	}
+	if m.MinYLength != 0 {
+		n += 9
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovData(uint64(m.MaxDepth))
+	}
+	if m.ConvNumber != 0 {
+		n += 1 + sovData(uint64(m.ConvNumber))
+	}
+	if m.ConvGridSize != 0 {
+		n += 1 + sovData(uint64(m.ConvGridSize))
+	}
+	if m.MinUsers != 0 {
+		n += 1 + sovData(uint64(m.MinUsers))
+	}
+	if m.UsersShare != 0 {
+		n += 9
+	}
+	if m.TopPosts != 0 {
+		n += 1 + sovData(uint64(m.TopPosts))
+	}
+	if m.MaxTags != 0 {
+		n += 1 + sovData(uint64(m.MaxTags))
+	}
+	if m.WindowLength != 0 {
+		n += 1 + sovData(uint64(m.WindowLength))
+	}
+	if m.WindowStride != 0 {
+		n += 1 + sovData(uint64(m.WindowStride))
+	}
+	l = len(m.Language)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FilterStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bots != 0 {
+		n += 1 + sovData(uint64(m.Bots))
+	}
+	if m.Ads != 0 {
+		n += 1 + sovData(uint64(m.Ads))
+	}
+	if m.RateLimited != 0 {
+		n += 1 + sovData(uint64(m.RateLimited))
+	}
+	if m.Duplicates != 0 {
+		n += 1 + sovData(uint64(m.Duplicates))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthorID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregatedPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Center.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovData(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Timestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovData(uint64(m.Time))
+	}
+	if m.PostsNumber != 0 {
+		n += 1 + sovData(uint64(m.PostsNumber))
+	}
+	if m.EventsNumber != 0 {
+		n += 1 + sovData(uint64(m.EventsNumber))
+	}
+	l = len(m.Alert)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.Collected {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Alert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovData(uint64(m.Start))
+	}
+	if m.Finish != 0 {
+		n += 1 + sovData(uint64(m.Finish))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Observed != 0 {
+		n += 1 + sovData(uint64(m.Observed))
+	}
+	if m.Expected != 0 {
+		n += 9
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GridTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Session)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.Key != 0 {
+		n += 1 + sovData(uint64(m.Key))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.LeaseUntil != 0 {
+		n += 1 + sovData(uint64(m.LeaseUntil))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovData(uint64(m.Attempts))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovData(uint64(m.Posts))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GridTasksStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		n += 1 + sovData(uint64(m.Pending))
+	}
+	if m.Leased != 0 {
+		n += 1 + sovData(uint64(m.Leased))
+	}
+	if m.Done != 0 {
+		n += 1 + sovData(uint64(m.Done))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovData(uint64(m.Failed))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovData(uint64(m.Posts))
+	}
+	if len(m.DoneKeys) > 0 {
+		l = 0
+		for _, e := range m.DoneKeys {
+			l += sovData(uint64(e))
+		}
+		n += 1 + sovData(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Location) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = m.Position.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = len(m.Slug)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *City) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovData(uint64(l))
+	}
+	l = m.Area.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovData(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozData(x uint64) (n int) {
+	return sovData(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Post) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVideo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVideo = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caption", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caption = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+			}
+			m.CommentsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LikesCount", wireType)
+			}
+			m.LikesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LikesCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAd = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lat = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lon = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShortPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShortPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShortPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caption", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caption = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+			}
+			m.CommentsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LikesCount", wireType)
+			}
+			m.LikesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LikesCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lat = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lon = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Area) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Area: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Area: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopLeft == nil {
+				m.TopLeft = &Point{}
+			}
+			if err := m.TopLeft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BotRight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BotRight == nil {
+				m.BotRight = &Point{}
+			}
+			if err := m.BotRight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpatioTemporalInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpatioTemporalInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTime", wireType)
+			}
+			m.MinTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTime", wireType)
+			}
+			m.MaxTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpatioHourInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpatioHourInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpatioHourInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Point) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Point: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Point: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lat = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lon = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Center", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Center.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostCodes = append(m.PostCodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
+			}
+			m.Finish = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finish |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filtered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopPosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopPosts = append(m.TopPosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authors", wireType)
+			}
+			m.Authors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Likes", wireType)
+			}
+			m.Likes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Likes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+			}
+			m.Comments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Comments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Footprint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Footprint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Footprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Footprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Footprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geometry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Geometry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BBox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BBox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radius", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Radius = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovData(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozData(x uint64) (n int) {
-	return sovData(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Post) Unmarshal(dAtA []byte) error {
+func (m *DetectionParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,17 +5127,17 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetectionParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetectionParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
 			}
-			var stringLen uint64
+			m.MaxPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -1911,29 +5147,38 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPoints |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinXLength", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinXLength = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinYLength", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shortcode", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinYLength = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
 			}
-			var stringLen uint64
+			m.MaxDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -1943,29 +5188,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxDepth |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Shortcode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvNumber", wireType)
 			}
-			var stringLen uint64
+			m.ConvNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -1975,29 +5207,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConvNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImageURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVideo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvGridSize", wireType)
 			}
-			var v int
+			m.ConvGridSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2007,17 +5226,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ConvGridSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsVideo = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caption", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUsers", wireType)
 			}
-			var stringLen uint64
+			m.MinUsers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2027,29 +5245,27 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinUsers |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsersShare", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caption = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UsersShare = float64(math.Float64frombits(v))
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopPosts", wireType)
 			}
-			m.CommentsCount = 0
+			m.TopPosts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2059,16 +5275,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommentsCount |= int64(b&0x7F) << shift
+				m.TopPosts |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTags", wireType)
 			}
-			m.Timestamp = 0
+			m.MaxTags = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2078,16 +5294,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.MaxTags |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LikesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLength", wireType)
 			}
-			m.LikesCount = 0
+			m.WindowLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2097,36 +5313,16 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LikesCount |= int64(b&0x7F) << shift
+				m.WindowLength |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAd", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowData
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsAd = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStride", wireType)
 			}
-			var stringLen uint64
+			m.WindowStride = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2136,27 +5332,14 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowStride |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthorID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2184,30 +5367,8 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocationID = string(dAtA[iNdEx:postIndex])
+			m.Language = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Lat = float64(math.Float64frombits(v))
-		case 13:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Lon = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -2230,7 +5391,7 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShortPost) Unmarshal(dAtA []byte) error {
+func (m *FilterStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2253,17 +5414,17 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShortPost: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShortPost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shortcode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bots", wireType)
 			}
-			var stringLen uint64
+			m.Bots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2273,29 +5434,16 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Bots |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Shortcode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caption", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ads", wireType)
 			}
-			var stringLen uint64
+			m.Ads = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2305,29 +5453,16 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Ads |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Caption = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimited", wireType)
 			}
-			m.CommentsCount = 0
+			m.RateLimited = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2337,16 +5472,16 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommentsCount |= int64(b&0x7F) << shift
+				m.RateLimited |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LikesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicates", wireType)
 			}
-			m.LikesCount = 0
+			m.Duplicates = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2356,31 +5491,63 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LikesCount |= int64(b&0x7F) << shift
+				m.Duplicates |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowData
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AuthorID", wireType)
 			}
@@ -2412,9 +5579,9 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2442,30 +5609,8 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocationID = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Lat = float64(math.Float64frombits(v))
-		case 9:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Lon = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -2488,7 +5633,7 @@ func (m *ShortPost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Area) Unmarshal(dAtA []byte) error {
+func (m *AggregatedPost) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,15 +5656,15 @@ func (m *Area) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Area: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedPost: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Area: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedPost: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopLeft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Center", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2546,18 +5691,15 @@ func (m *Area) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TopLeft == nil {
-				m.TopLeft = &Point{}
-			}
-			if err := m.TopLeft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Center.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BotRight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2567,28 +5709,11 @@ func (m *Area) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BotRight == nil {
-				m.BotRight = &Point{}
-			}
-			if err := m.BotRight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -2611,7 +5736,7 @@ func (m *Area) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
+func (m *Timestamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2634,17 +5759,17 @@ func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpatioTemporalInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpatioTemporalInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.MinTime = 0
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2654,16 +5779,16 @@ func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinTime |= int64(b&0x7F) << shift
+				m.Time |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostsNumber", wireType)
 			}
-			m.MaxTime = 0
+			m.PostsNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2673,16 +5798,35 @@ func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTime |= int64(b&0x7F) << shift
+				m.PostsNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsNumber", wireType)
+			}
+			m.EventsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alert", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2692,25 +5836,55 @@ func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Alert = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Collected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -2733,7 +5907,7 @@ func (m *SpatioTemporalInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpatioHourInterval) Unmarshal(dAtA []byte) error {
+func (m *Alert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2756,17 +5930,17 @@ func (m *SpatioHourInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpatioHourInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: Alert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpatioHourInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Alert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.Hour = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2776,16 +5950,16 @@ func (m *SpatioHourInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hour |= int64(b&0x7F) << shift
+				m.Start |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
 			}
-			var msglen int
+			m.Finish = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2795,79 +5969,65 @@ func (m *SpatioHourInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Finish |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Area.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipData(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthData
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Point) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowData
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observed", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Point: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Point: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Observed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Observed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -2875,10 +6035,10 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Lat = float64(math.Float64frombits(v))
-		case 2:
+			m.Expected = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -2886,7 +6046,7 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Lon = float64(math.Float64frombits(v))
+			m.Score = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -2909,7 +6069,7 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *GridTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2932,17 +6092,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: GridTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GridTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Center", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2952,30 +6112,48 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Center.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Session = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostCodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -2985,27 +6163,29 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthData
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthData
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostCodes = append(m.PostCodes, string(dAtA[iNdEx:postIndex]))
+			m.Spec = append(m.Spec[:0], dAtA[iNdEx:postIndex]...)
+			if m.Spec == nil {
+				m.Spec = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3033,11 +6213,11 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3065,13 +6245,13 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUntil", wireType)
 			}
-			m.Start = 0
+			m.LeaseUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3081,16 +6261,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= int64(b&0x7F) << shift
+				m.LeaseUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			m.Finish = 0
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3100,67 +6280,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Finish |= int64(b&0x7F) << shift
+				m.Attempts |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipData(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthData
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregatedPost) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowData
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregatedPost: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregatedPost: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Center", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
 			}
-			var msglen int
+			m.Posts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3170,30 +6299,16 @@ func (m *AggregatedPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Posts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthData
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthData
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Center.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3203,11 +6318,24 @@ func (m *AggregatedPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -3230,7 +6358,7 @@ func (m *AggregatedPost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timestamp) Unmarshal(dAtA []byte) error {
+func (m *GridTasksStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3253,17 +6381,17 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GridTasksStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GridTasksStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			m.Time = 0
+			m.Pending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3273,16 +6401,16 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				m.Pending |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostsNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			m.PostsNumber = 0
+			m.Leased = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3292,16 +6420,16 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PostsNumber |= int64(b&0x7F) << shift
+				m.Leased |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventsNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			m.EventsNumber = 0
+			m.Done = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowData
@@ -3311,11 +6439,125 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventsNumber |= int64(b&0x7F) << shift
+				m.Done |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			m.Posts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Posts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowData
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DoneKeys = append(m.DoneKeys, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowData
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthData
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthData
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DoneKeys) == 0 {
+					m.DoneKeys = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowData
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DoneKeys = append(m.DoneKeys, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoneKeys", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipData(dAtA[iNdEx:])
@@ -0,0 +1,9 @@
+package data
+
+// Statuses of grid tasks.
+const (
+	GridTaskPending = "pending"
+	GridTaskLeased  = "leased"
+	GridTaskDone    = "done"
+	GridTaskFailed  = "failed"
+)
@@ -1,7 +1,24 @@
 package data
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 func (p *Point) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"%.4f,%.4f\"", p.Lat, p.Lon)), nil
 }
+
+// MarshalJSON writes the geometry of the footprint as a GeoJSON object instead of a string.
+func (f Footprint) MarshalJSON() ([]byte, error) {
+	var geometry json.RawMessage
+	if f.Geometry != "" {
+		geometry = json.RawMessage(f.Geometry)
+	}
+	return json.Marshal(struct {
+		Geometry json.RawMessage `json:"geometry,omitempty"`
+		BBox     Area            `json:"bbox"`
+		Radius   float64         `json:"radius"`
+		Cell     Area            `json:"cell"`
+	}{geometry, f.BBox, f.Radius, f.Cell})
+}
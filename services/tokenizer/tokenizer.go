@@ -0,0 +1,151 @@
+// Package tokenizer extracts hashtags and mentions from post captions following the Unicode
+// hashtag rules and normalizes them, so that the same tag written in different forms is counted once.
+// The crawler stores raw captions, tags are extracted by the services which read them.
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	zwnj = '‌'
+	zwj  = '‍'
+)
+
+var folder = cases.Fold()
+
+// Hashtags returns normalized hashtags of the text with the leading '#' in order of appearance.
+func Hashtags(text string) []string {
+	return extract(text, isHashSign, isHashtagRune, "#")
+}
+
+// Mentions returns normalized mentions of the text with the leading '@' in order of appearance.
+func Mentions(text string) []string {
+	return extract(text, isAtSign, isMentionRune, "@")
+}
+
+func extract(text string, isPrefix, isBody func(rune) bool, prefix string) []string {
+	res := []string{}
+	prev := ' '
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if !isPrefix(r) || isWordRune(prev) {
+			prev = r
+			i += size
+			continue
+		}
+		j := i + size
+		for j < len(text) {
+			br, bs := utf8.DecodeRuneInString(text[j:])
+			if !isBody(br) {
+				break
+			}
+			j += bs
+		}
+		body := strings.TrimRight(text[i+size:j], string([]rune{zwnj, zwj, '.'}))
+		if hasLetter(body) {
+			res = append(res, prefix+Normalize(body))
+		}
+		if j == i+size {
+			prev = r
+		} else {
+			prev, _ = utf8.DecodeLastRuneInString(text[:j])
+		}
+		i = j
+	}
+	return res
+}
+
+func isHashSign(r rune) bool {
+	return r == '#' || r == '＃'
+}
+
+func isAtSign(r rune) bool {
+	return r == '@' || r == '＠'
+}
+
+// isWordRune reports whether r can't precede a hashtag or a mention, e.g. in "a#b", "&#39;" or e-mails.
+func isWordRune(r rune) bool {
+	return isHashtagRune(r) || r == '&' || isHashSign(r) || isAtSign(r)
+}
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_' ||
+		r == zwnj || r == zwj || r == '·'
+}
+
+func isMentionRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsMark(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize returns the canonical form of a tag: compatibility characters are replaced (NFKC),
+// case is folded, diacritics are removed from Latin letters and "ё" is replaced by "е".
+// A leading '#' or '@' is kept.
+func Normalize(tag string) string {
+	s := folder.String(norm.NFKC.String(tag))
+	var b strings.Builder
+	b.Grow(len(s))
+	latin := false
+	for _, r := range norm.NFD.String(s) {
+		if unicode.IsMark(r) {
+			if latin {
+				continue
+			}
+			b.WriteRune(r)
+			continue
+		}
+		latin = unicode.Is(unicode.Latin, r)
+		b.WriteRune(r)
+	}
+	return strings.Replace(norm.NFC.String(b.String()), "ё", "е", -1)
+}
+
+// Key returns a key which is equal for transliteration variants of a tag, e.g. "#москва" and
+// "#moskva" have the same key. Only transliterated letters are simplified, so native Latin tags
+// such as "#way" and "#vai" keep different keys. Keys are meant for matching and grouping only.
+func Key(tag string) string {
+	s := norm.NFD.String(Normalize(tag))
+	var b, run strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if r == '_' || r == '·' || r == zwnj || r == zwj || unicode.IsMark(r) {
+			continue
+		}
+		if t, ok := translit[r]; ok {
+			run.WriteString(t)
+			continue
+		}
+		b.WriteString(simplify(run.String()))
+		run.Reset()
+		b.WriteRune(r)
+	}
+	b.WriteString(simplify(run.String()))
+	return b.String()
+}
+
+// simplify merges transliterations of letters which are spelled differently in Cyrillic alphabets
+// and in Greek, e.g. "й", "ы", "і" and "υ" or "кс" and "ξ".
+func simplify(s string) string {
+	return keyReplacer.Replace(s)
+}
+
+// keyReplacer prefers earlier pairs, so longer spellings go first.
+var keyReplacer = strings.NewReplacer(
+	"iy", "i",
+	"ks", "x",
+	"y", "i",
+)
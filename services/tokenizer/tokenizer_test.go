@@ -0,0 +1,92 @@
+package tokenizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHashtags(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"latin", "Sunset at #Brooklyn_Bridge, #NYC!", []string{"#brooklyn_bridge", "#nyc"}},
+		{"russian with yo", "#Ёлка и #ёжик", []string{"#елка", "#ежик"}},
+		{"ukrainian", "#Київ #ґанок", []string{"#київ", "#ґанок"}},
+		{"georgian", "#თბილისი", []string{"#თბილისი"}},
+		{"arabic", "#دبي رائعة", []string{"#دبي"}},
+		{"cjk", "#東京タワー 夜景", []string{"#東京タワー"}},
+		{"diacritics", "#Café and #café", []string{"#cafe", "#cafe"}},
+		{"emoji terminates tag", "#party🎉time", []string{"#party"}},
+		{"fullwidth sign and letters", "＃ＴＯＫＹＯ", []string{"#tokyo"}},
+		{"digits only", "#2019 #2019nyc", []string{"#2019nyc"}},
+		{"not after word", "a#b &#39; c##d", []string{}},
+		{"german sharp s", "#Straße", []string{"#strasse"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hashtags(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Hashtags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"simple", "with @John.Smith and @анна_к.", []string{"@john.smith", "@анна_к"}},
+		{"email", "write to a@b.com", []string{}},
+		{"only digits", "@123", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mentions(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Mentions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"#москва", "#moskva"},
+		{"#new_york", "#newyork"},
+		{"#хабаровск", "#khabarovsk"},
+		{"#Київ", "#Киів"},
+		{"#Αθήνα", "#athina"},
+		{"#Αλεξάνδρα", "#Александра"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			if Key(tt.a) != Key(tt.b) {
+				t.Errorf("Key(%v) = %v, Key(%v) = %v", tt.a, Key(tt.a), tt.b, Key(tt.b))
+			}
+		})
+	}
+}
+
+func TestKey_latin(t *testing.T) {
+	// native Latin tags are not simplified
+	tests := []struct {
+		a, b string
+	}{
+		{"#way", "#vai"},
+		{"#jam", "#iam"},
+		{"#boxing", "#boksing"},
+		{"#khan", "#han"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			if Key(tt.a) == Key(tt.b) {
+				t.Errorf("Key(%v) = Key(%v) = %v", tt.a, tt.b, Key(tt.a))
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/angrymuskrat/event-monitoring-system/utils/rand/scenario"
+)
+
+func main() {
+	cp := flag.String("config", "./config.toml", "path to the config file")
+	flag.Parse()
+	if err := scenario.Run(*cp); err != nil {
+		log.Fatal(err)
+	}
+}
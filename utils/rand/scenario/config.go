@@ -0,0 +1,90 @@
+package scenario
+
+import (
+	"errors"
+
+	"github.com/BurntSushi/toml"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+// Config describes a generated scenario. Timestamps are unix seconds, distances are in meters.
+type Config struct {
+	// Seed of the generator, zero means a random seed.
+	Seed     int64
+	Timezone string
+	Start    int64
+	Finish   int64
+	TopLeft  data.Point
+	BotRight data.Point
+
+	// PostsPerHour is the mean number of background posts in the busiest hour of a weekday.
+	PostsPerHour float64
+	// WeekendFactor scales background activity on Saturdays and Sundays.
+	WeekendFactor float64
+	// Authors is the number of background authors, their activity follows the Zipf law with AuthorExponent.
+	Authors        int
+	AuthorExponent float64
+	// Hotspots is the number of popular places, HotspotShare of background posts are made in them.
+	Hotspots      int
+	HotspotRadius float64
+	HotspotShare  float64
+	// Tags are background hashtags, each post gets up to MaxPostTags of them.
+	Tags        []string
+	MaxPostTags int
+
+	Events []EventConfig
+
+	// PostsPath and TruthPath are NDJSON posts and JSON ground truth output files.
+	PostsPath string
+	TruthPath string
+	// If DataStorageAddress is set, posts are also pushed to the city CityID.
+	DataStorageAddress string
+	CityID             string
+}
+
+// EventConfig describes an injected event. Every author makes PostsPerAuthor posts within
+// Radius from the center during Duration seconds from Start.
+type EventConfig struct {
+	Name           string
+	Lat            float64
+	Lon            float64
+	Radius         float64
+	Start          int64
+	Duration       int64
+	Authors        int
+	PostsPerAuthor int
+	Tags           []string
+}
+
+func readConfig(path string) (cfg Config, err error) {
+	_, err = toml.DecodeFile(path, &cfg)
+	if err != nil {
+		return
+	}
+	err = cfg.validate()
+	return
+}
+
+func (cfg *Config) validate() error {
+	if cfg.Start >= cfg.Finish {
+		return errors.New("scenario period is empty")
+	}
+	if cfg.Authors < 1 {
+		return errors.New("at least one author is required")
+	}
+	if cfg.AuthorExponent <= 1 {
+		cfg.AuthorExponent = 1.5
+	}
+	if cfg.WeekendFactor <= 0 {
+		cfg.WeekendFactor = 1
+	}
+	if cfg.Timezone == "" {
+		cfg.Timezone = "UTC"
+	}
+	for _, e := range cfg.Events {
+		if e.Authors < 1 || e.PostsPerAuthor < 1 || e.Duration <= 0 {
+			return errors.New("event " + e.Name + " must have authors, posts and duration")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package scenario
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+
+	service "github.com/angrymuskrat/event-monitoring-system/services/data-storage"
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/utils/truth"
+	"google.golang.org/grpc"
+)
+
+const pushBatchSize = 10000
+
+// Run generates the scenario described in the config file and writes or pushes its output.
+func Run(confPath string) error {
+	cfg, err := readConfig(confPath)
+	if err != nil {
+		return err
+	}
+	g, err := NewGenerator(cfg)
+	if err != nil {
+		return err
+	}
+	posts, labels := g.Generate()
+	if cfg.PostsPath != "" {
+		if err := WritePosts(cfg.PostsPath, posts); err != nil {
+			return err
+		}
+	}
+	if cfg.TruthPath != "" {
+		if err := WriteTruth(cfg.TruthPath, labels); err != nil {
+			return err
+		}
+	}
+	if cfg.DataStorageAddress != "" {
+		return PushPosts(cfg.DataStorageAddress, cfg.CityID, posts)
+	}
+	return nil
+}
+
+// WritePosts writes posts as NDJSON.
+func WritePosts(path string, posts []data.Post) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, p := range posts {
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// WriteTruth writes the ground truth as a JSON array.
+func WriteTruth(path string, labels []truth.Event) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(labels)
+}
+
+// PushPosts sends posts to data storage in batches.
+func PushPosts(address, cityID string, posts []data.Post) error {
+	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(service.MaxMsgSize)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	cl := service.NewGRPCClient(conn)
+	for i := 0; i < len(posts); i += pushBatchSize {
+		j := i + pushBatchSize
+		if j > len(posts) {
+			j = len(posts)
+		}
+		if err := cl.PushPosts(context.Background(), cityID, posts[i:j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
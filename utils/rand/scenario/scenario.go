@@ -0,0 +1,210 @@
+// Package scenario generates city posts with realistic background activity and injected events
+// with known ground truth for testing event detection.
+package scenario
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+	"github.com/angrymuskrat/event-monitoring-system/utils/truth"
+)
+
+const metersPerDegree = 111320.0
+
+// hourFactors is a relative activity of every hour of a day.
+var hourFactors = [24]float64{
+	0.25, 0.15, 0.1, 0.07, 0.05, 0.07, 0.15, 0.3, 0.45, 0.5, 0.55, 0.6,
+	0.7, 0.7, 0.65, 0.65, 0.7, 0.8, 0.9, 1, 1, 0.9, 0.7, 0.45,
+}
+
+type hotspot struct {
+	center data.Point
+	weight float64
+}
+
+// Generator produces posts and ground truth of a scenario.
+type Generator struct {
+	cfg      Config
+	loc      *time.Location
+	rnd      *rand.Rand
+	authors  *rand.Zipf
+	tags     *rand.Zipf
+	hotspots []hotspot
+	total    float64
+	seq      int
+}
+
+func NewGenerator(cfg Config) (*Generator, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	g := &Generator{cfg: cfg, loc: loc, rnd: rand.New(rand.NewSource(seed))}
+	g.authors = rand.NewZipf(g.rnd, cfg.AuthorExponent, 1, uint64(cfg.Authors-1))
+	if len(cfg.Tags) > 0 {
+		g.tags = rand.NewZipf(g.rnd, 1.2, 1, uint64(len(cfg.Tags)-1))
+	}
+	for i := 0; i < cfg.Hotspots; i++ {
+		// Pareto distributed weights make a few places much more popular than others
+		w := 1 / math.Pow(1-g.rnd.Float64(), 1/1.5)
+		g.hotspots = append(g.hotspots, hotspot{center: g.uniformPoint(), weight: w})
+		g.total += w
+	}
+	return g, nil
+}
+
+// Generate returns posts sorted by timestamp and the ground truth of injected events.
+func (g *Generator) Generate() ([]data.Post, []truth.Event) {
+	posts := []data.Post{}
+	for h := g.cfg.Start; h < g.cfg.Finish; h += 3600 {
+		t := time.Unix(h, 0).In(g.loc)
+		lambda := g.cfg.PostsPerHour * hourFactors[t.Hour()]
+		if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			lambda *= g.cfg.WeekendFactor
+		}
+		finish := h + 3600
+		if finish > g.cfg.Finish {
+			finish = g.cfg.Finish
+		}
+		n := g.poisson(lambda)
+		for i := 0; i < n; i++ {
+			posts = append(posts, g.backgroundPost(h, finish))
+		}
+	}
+	labels := []truth.Event{}
+	for i, e := range g.cfg.Events {
+		posts = append(posts, g.eventPosts(i, e)...)
+		labels = append(labels, truth.Event{
+			Name:   e.Name,
+			Lat:    e.Lat,
+			Lon:    e.Lon,
+			Radius: e.Radius,
+			Start:  e.Start,
+			Finish: e.Start + e.Duration,
+			Tags:   e.Tags,
+		})
+	}
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].Timestamp < posts[j].Timestamp
+	})
+	return posts, labels
+}
+
+func (g *Generator) backgroundPost(start, finish int64) data.Post {
+	var p data.Point
+	if len(g.hotspots) > 0 && g.rnd.Float64() < g.cfg.HotspotShare {
+		p = g.nearPoint(g.pickHotspot().center, g.cfg.HotspotRadius)
+	} else {
+		p = g.uniformPoint()
+	}
+	tags := []string{}
+	if g.tags != nil && g.cfg.MaxPostTags > 0 {
+		n := g.rnd.Intn(g.cfg.MaxPostTags + 1)
+		for i := 0; i < n; i++ {
+			tags = append(tags, g.cfg.Tags[g.tags.Uint64()])
+		}
+	}
+	author := "author" + strconv.FormatUint(g.authors.Uint64(), 10)
+	return g.post(author, start+g.rnd.Int63n(finish-start), p, tags)
+}
+
+func (g *Generator) eventPosts(idx int, e EventConfig) []data.Post {
+	res := []data.Post{}
+	center := data.Point{Lat: e.Lat, Lon: e.Lon}
+	for a := 0; a < e.Authors; a++ {
+		author := "event" + strconv.Itoa(idx) + "_" + strconv.Itoa(a)
+		for i := 0; i < e.PostsPerAuthor; i++ {
+			tags := []string{}
+			if len(e.Tags) > 0 {
+				n := 1 + g.rnd.Intn(len(e.Tags))
+				for _, ti := range g.rnd.Perm(len(e.Tags))[:n] {
+					tags = append(tags, e.Tags[ti])
+				}
+			}
+			res = append(res, g.post(author, e.Start+g.rnd.Int63n(e.Duration), g.nearPoint(center, e.Radius), tags))
+		}
+	}
+	return res
+}
+
+func (g *Generator) post(author string, ts int64, p data.Point, tags []string) data.Post {
+	g.seq++
+	id := strconv.Itoa(g.seq)
+	caption := ""
+	if len(tags) > 0 {
+		caption = "#" + strings.Join(tags, " #")
+	}
+	return data.Post{
+		ID:            id,
+		Shortcode:     "sc" + id,
+		Caption:       caption,
+		CommentsCount: int64(g.rnd.ExpFloat64() * 3),
+		Timestamp:     ts,
+		LikesCount:    int64(g.rnd.ExpFloat64() * 40),
+		AuthorID:      author,
+		Lat:           p.Lat,
+		Lon:           p.Lon,
+	}
+}
+
+func (g *Generator) pickHotspot() hotspot {
+	v := g.rnd.Float64() * g.total
+	for _, h := range g.hotspots {
+		v -= h.weight
+		if v <= 0 {
+			return h
+		}
+	}
+	return g.hotspots[len(g.hotspots)-1]
+}
+
+func (g *Generator) uniformPoint() data.Point {
+	tl, br := g.cfg.TopLeft, g.cfg.BotRight
+	return data.Point{
+		Lat: br.Lat + g.rnd.Float64()*(tl.Lat-br.Lat),
+		Lon: tl.Lon + g.rnd.Float64()*(br.Lon-tl.Lon),
+	}
+}
+
+// nearPoint returns a normally distributed point, radius is the two sigma distance in meters.
+func (g *Generator) nearPoint(c data.Point, radius float64) data.Point {
+	sigma := radius / 2 / metersPerDegree
+	return data.Point{
+		Lat: c.Lat + g.rnd.NormFloat64()*sigma,
+		Lon: c.Lon + g.rnd.NormFloat64()*sigma/math.Cos(c.Lat*math.Pi/180),
+	}
+}
+
+func (g *Generator) poisson(lambda float64) int {
+	if lambda <= 0 {
+		return 0
+	}
+	if lambda > 30 {
+		n := int(math.Round(lambda + g.rnd.NormFloat64()*math.Sqrt(lambda)))
+		if n < 0 {
+			n = 0
+		}
+		return n
+	}
+	l := math.Exp(-lambda)
+	k, p := 0, 1.0
+	for {
+		p *= g.rnd.Float64()
+		if p <= l {
+			return k
+		}
+		k++
+	}
+}
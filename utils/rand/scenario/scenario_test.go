@@ -0,0 +1,66 @@
+package scenario
+
+import (
+	"reflect"
+	"testing"
+
+	data "github.com/angrymuskrat/event-monitoring-system/services/proto"
+)
+
+func testConfig(seed int64) Config {
+	return Config{
+		Seed:          seed,
+		Timezone:      "UTC",
+		Start:         1514764800,
+		Finish:        1514764800 + 2*86400,
+		TopLeft:       data.Point{Lat: 60.1, Lon: 29.9},
+		BotRight:      data.Point{Lat: 59.7, Lon: 30.6},
+		PostsPerHour:  50,
+		Authors:       500,
+		Hotspots:      5,
+		HotspotRadius: 150,
+		HotspotShare:  0.5,
+		Tags:          []string{"spb", "love", "food"},
+		MaxPostTags:   2,
+		Events: []EventConfig{{
+			Name:           "concert",
+			Lat:            59.93,
+			Lon:            30.31,
+			Radius:         200,
+			Start:          1514764800 + 72000,
+			Duration:       10800,
+			Authors:        10,
+			PostsPerAuthor: 2,
+			Tags:           []string{"concert"},
+		}},
+	}
+}
+
+func generate(t *testing.T, cfg Config) ([]data.Post, int) {
+	g, err := NewGenerator(cfg)
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	posts, labels := g.Generate()
+	return posts, len(labels)
+}
+
+func TestGenerator_Seed(t *testing.T) {
+	first, events := generate(t, testConfig(42))
+	if len(first) == 0 || events != 1 {
+		t.Fatalf("Generate() returned %v posts and %v events", len(first), events)
+	}
+	for i := 1; i < len(first); i++ {
+		if first[i].Timestamp < first[i-1].Timestamp {
+			t.Fatalf("posts are not sorted by timestamp at %v", i)
+		}
+	}
+	second, _ := generate(t, testConfig(42))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Generate() with the same seed returned different posts")
+	}
+	other, _ := generate(t, testConfig(43))
+	if reflect.DeepEqual(first, other) {
+		t.Errorf("Generate() with another seed returned the same posts")
+	}
+}
@@ -0,0 +1,15 @@
+// Package truth describes ground-truth events shared by generators of test data and the
+// event-detection evaluation harness.
+package truth
+
+// Event is a labelled event. Radius is in meters, zero means that the match radius of the
+// evaluation is used. If Tags is not empty, a detected event must share at least one of them.
+type Event struct {
+	Name   string
+	Lat    float64
+	Lon    float64
+	Radius float64
+	Start  int64
+	Finish int64
+	Tags   []string
+}